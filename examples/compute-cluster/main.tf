@@ -39,10 +39,21 @@ resource "nscale_compute_cluster" "example" {
         {
           direction = "ingress"
           protocol  = "tcp"
-          ports     = 22
+          from_port = 22
           prefixes  = ["0.0.0.0/0"]
         }
       ]
     }
   ]
 }
+
+# An additional pool managed separately from the cluster resource, as a
+# burst-capacity module would. It must not also be listed in workload_pools.
+resource "nscale_compute_cluster_workload_pool" "burst" {
+  cluster_id       = nscale_compute_cluster.example.id
+  name             = "burst"
+  replicas         = 1
+  image_id         = var.image_id
+  flavor_id        = data.nscale_instance_flavor.g_4_standard_40s.id
+  enable_public_ip = false
+}
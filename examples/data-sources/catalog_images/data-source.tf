@@ -0,0 +1,18 @@
+data "nscale_catalog_images" "pytorch" {
+  architecture = "x86_64"
+  gpu_vendor   = "NVIDIA"
+
+  software_versions = {
+    pytorch = "2.4"
+  }
+}
+
+resource "nscale_instance" "training" {
+  name      = "training"
+  image_id  = data.nscale_catalog_images.pytorch.images[0].id
+  flavor_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+
+  network_interface {
+    network_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  }
+}
@@ -0,0 +1,3 @@
+data "nscale_compute_cluster" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
@@ -0,0 +1,3 @@
+data "nscale_compute_cluster_ssh_key" "example" {
+  cluster_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
@@ -0,0 +1,3 @@
+data "nscale_file_storage_class" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
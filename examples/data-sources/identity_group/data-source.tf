@@ -0,0 +1,3 @@
+data "nscale_identity_group" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
@@ -0,0 +1,3 @@
+data "nscale_instance_flavor" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
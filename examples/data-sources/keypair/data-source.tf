@@ -0,0 +1,11 @@
+data "nscale_keypair" "cluster" {
+  cluster_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+data "nscale_keypair" "instance" {
+  instance_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+output "authorized_keys" {
+  value = join("\n", [data.nscale_keypair.cluster.public_key, data.nscale_keypair.instance.public_key])
+}
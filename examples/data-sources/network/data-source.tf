@@ -0,0 +1,3 @@
+data "nscale_network" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
@@ -0,0 +1,4 @@
+data "nscale_object_storage_access_key" "example" {
+  endpoint_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  id          = "YYYYYYYY-YYYY-YYYY-YYYY-YYYYYYYYYYYY"
+}
@@ -0,0 +1,3 @@
+data "nscale_object_storage_endpoint" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
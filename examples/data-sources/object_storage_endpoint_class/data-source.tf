@@ -0,0 +1,8 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+data "nscale_object_storage_endpoint_class" "standard" {
+  id        = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  region_id = data.nscale_region.glo1.id
+}
@@ -0,0 +1,7 @@
+data "nscale_placement" "workers" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+output "ready_host_count" {
+  value = data.nscale_placement.workers.ready_host_count
+}
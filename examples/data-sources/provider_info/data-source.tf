@@ -0,0 +1,9 @@
+data "nscale_provider_info" "this" {}
+
+output "nscale_versions" {
+  value = {
+    provider = data.nscale_provider_info.this.provider_version
+    apis     = data.nscale_provider_info.this.api_versions
+    services = data.nscale_provider_info.this.service_versions
+  }
+}
@@ -0,0 +1,3 @@
+data "nscale_region" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
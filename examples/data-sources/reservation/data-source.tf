@@ -0,0 +1,7 @@
+data "nscale_reservation" "training" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+output "machine_flavor_id" {
+  value = data.nscale_reservation.training.machine_flavor_id
+}
@@ -0,0 +1,7 @@
+data "nscale_resource_events" "cluster" {
+  resource_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+output "cluster_events" {
+  value = data.nscale_resource_events.cluster.summary
+}
@@ -0,0 +1,19 @@
+data "nscale_security_group_preset" "ssh" {
+  name       = "ssh-only"
+  cidr_block = "203.0.113.0/24"
+}
+
+data "nscale_security_group_preset" "k8s_nodes" {
+  name       = "k8s-nodes"
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "nscale_security_group" "example" {
+  name       = "example"
+  network_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+
+  rules = concat(
+    data.nscale_security_group_preset.ssh.rules,
+    data.nscale_security_group_preset.k8s_nodes.rules,
+  )
+}
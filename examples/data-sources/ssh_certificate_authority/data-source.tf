@@ -0,0 +1,3 @@
+data "nscale_ssh_certificate_authority" "example" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
@@ -0,0 +1,3 @@
+ephemeral "nscale_compute_cluster_ssh_key" "example" {
+  cluster_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
@@ -0,0 +1,3 @@
+ephemeral "nscale_instance_ssh_key" "example" {
+  instance_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
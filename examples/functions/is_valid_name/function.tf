@@ -0,0 +1,8 @@
+variable "instance_name" {
+  type = string
+
+  validation {
+    condition     = provider::nscale::is_valid_name(var.instance_name)
+    error_message = "The instance name must be a valid Nscale resource name."
+  }
+}
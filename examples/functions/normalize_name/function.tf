@@ -0,0 +1,10 @@
+variable "environment" {
+  type    = string
+  default = "Team_A Staging"
+}
+
+resource "nscale_network" "example" {
+  # The underscore and space are removed, giving "teamastaging-network".
+  name       = provider::nscale::normalize_name("${var.environment}-network")
+  cidr_block = "192.168.0.0/24"
+}
@@ -0,0 +1,12 @@
+variable "training_service_token" {
+  type      = string
+  sensitive = true
+}
+
+provider "nscale" {
+  # service_token, here taken from NSCALE_SERVICE_TOKEN, is used for
+  # organization-level requests and for every project not listed below.
+  project_service_tokens = {
+    "<training-project-id>" = var.training_service_token
+  }
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    nscale = {
+      source = "nscaledev/nscale"
+      # Check the Terraform Registry or GitHub Releases for the latest version.
+      # version = "~> 0.0.8"
+    }
+  }
+}
+
+# Configure the Nscale Provider
+provider "nscale" {
+  # Recommended: supply these values via environment variables, not hard-coded here.
+
+  # region_id       = "<your-region-id>"
+  # organization_id = "<your-organization-id>"
+  # project_id      = "<your-project-id>"
+  # service_token   = "<your-service-token>"
+}
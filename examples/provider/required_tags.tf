@@ -0,0 +1,3 @@
+provider "nscale" {
+  required_tags = ["cost-center", "owner"]
+}
@@ -0,0 +1 @@
+terraform import nscale_bastion.example <instance_id>
@@ -0,0 +1,29 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+resource "nscale_network" "example" {
+  name            = "example"
+  cidr_block      = "192.168.0.0/24"
+  dns_nameservers = ["8.8.8.8", "8.8.4.4"]
+  region_id       = data.nscale_region.glo1.id
+}
+
+resource "nscale_ssh_certificate_authority" "example" {
+  name       = "example-ca"
+  public_key = file("/path/to/ca.pub")
+}
+
+resource "nscale_bastion" "example" {
+  name       = "example-bastion"
+  network_id = nscale_network.example.id
+  image_id   = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+
+  allowed_ssh_cidr_blocks = ["203.0.113.0/24"]
+
+  ssh_certificate_authority_id = nscale_ssh_certificate_authority.example.id
+}
+
+output "bastion_address" {
+  value = nscale_bastion.example.public_ip
+}
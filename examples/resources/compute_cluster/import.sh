@@ -0,0 +1 @@
+terraform import nscale_compute_cluster.example <compute_cluster_id>
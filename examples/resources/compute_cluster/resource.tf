@@ -0,0 +1,32 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+data "nscale_instance_flavor" "g_4_standard_40s" {
+  id        = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  region_id = data.nscale_region.glo1.id
+}
+
+resource "nscale_compute_cluster" "example" {
+  name      = "example"
+  region_id = data.nscale_region.glo1.id
+
+  workload_pools = [
+    {
+      name             = "default"
+      replicas         = 1
+      image_id         = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+      flavor_id        = data.nscale_instance_flavor.g_4_standard_40s.id
+      enable_public_ip = true
+
+      firewall_rules = [
+        {
+          direction = "ingress"
+          protocol  = "tcp"
+          from_port = 22
+          prefixes  = ["0.0.0.0/0"]
+        }
+      ]
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import nscale_compute_cluster_workload_pool.burst <cluster_id>/<name>
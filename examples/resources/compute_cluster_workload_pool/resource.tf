@@ -0,0 +1,16 @@
+resource "nscale_compute_cluster_workload_pool" "burst" {
+  cluster_id = nscale_compute_cluster.example.id
+  name       = "burst"
+  replicas   = 4
+  image_id   = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  flavor_id  = data.nscale_instance_flavor.g_4_standard_40s.id
+
+  firewall_rules = [
+    {
+      direction = "ingress"
+      protocol  = "tcp"
+      from_port = 22
+      prefixes  = ["0.0.0.0/0"]
+    }
+  ]
+}
@@ -0,0 +1 @@
+terraform import nscale_file_storage.example <file_storage_id>
@@ -0,0 +1,43 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+resource "nscale_network" "example" {
+  name            = "example"
+  cidr_block      = "192.168.0.0/24"
+  dns_nameservers = ["8.8.8.8", "8.8.4.4"]
+  region_id       = data.nscale_region.glo1.id
+}
+
+data "nscale_file_storage_class" "standard" {
+  id        = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  region_id = data.nscale_region.glo1.id
+}
+
+resource "nscale_file_storage" "example" {
+  name             = "example"
+  storage_class_id = data.nscale_file_storage_class.standard.id
+  capacity         = 20
+  root_squash      = true
+  region_id        = data.nscale_region.glo1.id
+
+  # A custom user-managed snapshot policy: keep the seven most recent snapshots,
+  # each taken daily at 02:00 UTC. Policies are identified by name; ordering is
+  # not significant.
+  snapshot_policies = [
+    {
+      name = "daily"
+      schedule = {
+        interval    = "daily"
+        time_of_day = "02:00Z"
+      }
+      retention = {
+        keep = 7
+      }
+    }
+  ]
+
+  network {
+    id = nscale_network.example.id
+  }
+}
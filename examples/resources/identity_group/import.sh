@@ -0,0 +1 @@
+terraform import nscale_identity_group.example XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX
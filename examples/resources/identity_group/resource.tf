@@ -0,0 +1,17 @@
+resource "nscale_identity_group" "engineers" {
+  name        = "engineers"
+  description = "Engineering staff."
+
+  role_ids = [
+    "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX", # a pre-configured role
+  ]
+
+  # UUIDs of users provisioned by the external identity platform.
+  user_ids = [
+    "YYYYYYYY-YYYY-YYYY-YYYY-YYYYYYYYYYYY",
+  ]
+
+  tags = {
+    team = "platform"
+  }
+}
@@ -0,0 +1 @@
+terraform import nscale_identity_project.example XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX
@@ -0,0 +1,17 @@
+resource "nscale_identity_group" "engineers" {
+  name     = "engineers"
+  role_ids = ["XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"] # a pre-configured role
+}
+
+resource "nscale_identity_project" "example" {
+  name        = "demo-project"
+  description = "Sandbox for Terraform demos."
+
+  group_ids = [
+    nscale_identity_group.engineers.id,
+  ]
+
+  tags = {
+    team = "platform"
+  }
+}
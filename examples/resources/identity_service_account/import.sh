@@ -0,0 +1 @@
+terraform import nscale_identity_service_account.example XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX
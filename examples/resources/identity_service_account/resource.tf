@@ -0,0 +1,34 @@
+# A group granting the pipeline's role in the project it deploys to.
+resource "nscale_identity_group" "deployers" {
+  name = "deployers"
+
+  role_ids = [
+    "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX", # a pre-configured role
+  ]
+}
+
+resource "nscale_identity_project" "production" {
+  name      = "production"
+  group_ids = [nscale_identity_group.deployers.id]
+}
+
+# Rotate the token every 30 days.
+resource "time_rotating" "deploy_pipeline" {
+  rotation_days = 30
+}
+
+resource "nscale_identity_service_account" "deploy_pipeline" {
+  name        = "deploy-pipeline"
+  description = "Deploys to production."
+
+  group_ids = [nscale_identity_group.deployers.id]
+
+  rotate_when_changed = {
+    rotation = time_rotating.deploy_pipeline.id
+  }
+}
+
+output "deploy_pipeline_token" {
+  value     = nscale_identity_service_account.deploy_pipeline.access_token
+  sensitive = true
+}
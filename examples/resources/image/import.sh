@@ -0,0 +1,5 @@
+# An image in the provider's region
+terraform import nscale_image.example <image_id>
+
+# An image in another region
+terraform import nscale_image.example <region_id>/<image_id>
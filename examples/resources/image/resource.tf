@@ -0,0 +1,33 @@
+resource "nscale_image" "example" {
+  name           = "golden-ubuntu-2404"
+  source_url     = "https://images.example.com/golden-ubuntu-2404.qcow2"
+  architecture   = "x86_64"
+  virtualization = "virtualized"
+
+  os = {
+    distro   = "ubuntu"
+    family   = "debian"
+    version  = "24.04"
+    codename = "noble"
+  }
+
+  gpu = {
+    vendor = "NVIDIA"
+    driver = "570"
+  }
+
+  software_versions = {
+    cuda = "12.8"
+  }
+}
+
+resource "nscale_instance" "example" {
+  name = "example"
+
+  network_interface {
+    network_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  }
+
+  image_id  = nscale_image.example.id
+  flavor_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
@@ -0,0 +1 @@
+terraform import nscale_instance.example <instance_id>
@@ -0,0 +1,50 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+resource "nscale_network" "example" {
+  name            = "example"
+  cidr_block      = "192.168.0.0/24"
+  dns_nameservers = ["8.8.8.8", "8.8.4.4"]
+  region_id       = data.nscale_region.glo1.id
+}
+
+resource "nscale_security_group" "example" {
+  name = "example"
+
+  rules = [
+    {
+      type      = "ingress"
+      protocol  = "tcp"
+      from_port = 80
+    }
+  ]
+
+  network_id = nscale_network.example.id
+  region_id  = data.nscale_region.glo1.id
+}
+
+data "nscale_instance_flavor" "g_4_standard_40s" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  region_id = data.nscale_region.glo1.id
+}
+
+resource "nscale_ssh_certificate_authority" "example" {
+  name       = "example-ca"
+  public_key = file("/path/to/ca.pub")
+}
+
+resource "nscale_instance" "example" {
+  name = "example"
+
+  network_interface {
+    network_id         = nscale_network.example.id
+    enable_public_ip   = true
+    security_group_ids = [nscale_security_group.example.id]
+  }
+
+  image_id                     = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  flavor_id                    = data.nscale_instance_flavor.g_4_standard_40s.id
+  ssh_certificate_authority_id = nscale_ssh_certificate_authority.example.id
+  region_id                    = data.nscale_region.glo1.id
+}
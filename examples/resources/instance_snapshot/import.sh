@@ -0,0 +1,5 @@
+# A snapshot in the provider's region
+terraform import nscale_instance_snapshot.example <snapshot_id>
+
+# A snapshot in another region
+terraform import nscale_instance_snapshot.example <region_id>/<snapshot_id>
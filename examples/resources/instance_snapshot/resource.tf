@@ -0,0 +1,17 @@
+resource "nscale_instance_snapshot" "checkpoint" {
+  name        = "training-epoch-40"
+  description = "Checkpoint of the training box before epoch 40"
+  instance_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+# Restore the checkpoint by booting a new instance from it.
+resource "nscale_instance" "restored" {
+  name = "training-restored"
+
+  network_interface {
+    network_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  }
+
+  image_id  = nscale_instance_snapshot.checkpoint.id
+  flavor_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
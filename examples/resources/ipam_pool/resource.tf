@@ -0,0 +1,13 @@
+resource "nscale_ipam_pool" "shared" {
+  name = "shared"
+  cidr = "10.64.0.0/16"
+}
+
+resource "nscale_network" "team_a" {
+  name = "team-a"
+
+  cidr_from_pool = {
+    pool_cidr     = nscale_ipam_pool.shared.cidr
+    prefix_length = 24
+  }
+}
@@ -0,0 +1 @@
+terraform import nscale_kubernetes_cluster.example <kubernetes_cluster_id>
@@ -0,0 +1,32 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+data "nscale_instance_flavor" "g_4_standard_40s" {
+  id        = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  region_id = data.nscale_region.glo1.id
+}
+
+resource "nscale_kubernetes_cluster" "example" {
+  name      = "example"
+  version   = "v1.32.4"
+  region_id = data.nscale_region.glo1.id
+
+  workload_pools = [
+    {
+      name         = "default"
+      flavor_id    = data.nscale_instance_flavor.g_4_standard_40s.id
+      replicas     = 3
+      min_replicas = 1
+
+      labels = {
+        "node-role.example.com/worker" = "true"
+      }
+    }
+  ]
+}
+
+resource "local_sensitive_file" "kubeconfig" {
+  content  = nscale_kubernetes_cluster.example.kubeconfig
+  filename = "${path.module}/kubeconfig"
+}
@@ -0,0 +1 @@
+terraform import nscale_network.example <network_id>
@@ -0,0 +1,10 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+resource "nscale_network" "example" {
+  name            = "example"
+  cidr_block      = "192.168.0.0/24"
+  dns_nameservers = ["8.8.8.8", "8.8.4.4"]
+  region_id       = data.nscale_region.glo1.id
+}
@@ -0,0 +1 @@
+terraform import nscale_object_storage_access_key.writer <endpoint_id>/<access_key_id>
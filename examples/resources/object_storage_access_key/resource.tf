@@ -0,0 +1,33 @@
+resource "nscale_object_storage_endpoint" "example" {
+  name              = "ml-artifacts"
+  endpoint_class_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+
+  identity_policies = [
+    {
+      name = "bucket-admin"
+      document = jsonencode({
+        Version = "2012-10-17"
+        Statement = [{
+          Effect   = "Allow"
+          Action   = ["s3:*"]
+          Resource = ["arn:aws:s3:::ml-artifacts", "arn:aws:s3:::ml-artifacts/*"]
+        }]
+      })
+    },
+  ]
+}
+
+resource "nscale_object_storage_access_key" "writer" {
+  endpoint_id     = nscale_object_storage_endpoint.example.id
+  name            = "writer"
+  identity_policy = "bucket-admin"
+}
+
+output "s3_access_key_id" {
+  value = nscale_object_storage_access_key.writer.access_key_id
+}
+
+output "s3_secret" {
+  value     = nscale_object_storage_access_key.writer.secret
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+resource "nscale_object_storage_access_key" "writer" {
+  # ...
+
+  timeouts {
+    create = "10m"
+    delete = "10m"
+  }
+}
@@ -0,0 +1 @@
+terraform import nscale_object_storage_endpoint.example <endpoint_id>
@@ -0,0 +1,23 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+resource "nscale_object_storage_endpoint" "example" {
+  name              = "ml-artifacts"
+  endpoint_class_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+  region_id         = data.nscale_region.glo1.id
+
+  identity_policies = [
+    {
+      name = "bucket-admin"
+      document = jsonencode({
+        Version = "2012-10-17"
+        Statement = [{
+          Effect   = "Allow"
+          Action   = ["s3:*"]
+          Resource = ["arn:aws:s3:::ml-artifacts", "arn:aws:s3:::ml-artifacts/*"]
+        }]
+      })
+    },
+  ]
+}
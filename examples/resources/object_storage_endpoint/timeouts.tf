@@ -0,0 +1,9 @@
+resource "nscale_object_storage_endpoint" "example" {
+  # ...
+
+  timeouts {
+    create = "10m"
+    update = "10m"
+    delete = "10m"
+  }
+}
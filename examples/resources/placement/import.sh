@@ -0,0 +1 @@
+terraform import nscale_placement.workers XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX
@@ -0,0 +1,47 @@
+resource "nscale_reservation" "training" {
+  name        = "gb300-nvl72"
+  accelerator = "GB300"
+  unit        = "NVL72"
+  unit_count  = 1
+}
+
+resource "nscale_network" "training" {
+  name       = "training"
+  cidr_block = "192.168.0.0/24"
+}
+
+resource "nscale_security_group" "training" {
+  name = "training"
+
+  rules = [
+    {
+      type      = "ingress"
+      protocol  = "tcp"
+      from_port = 22
+    }
+  ]
+
+  network_id = nscale_network.training.id
+}
+
+resource "nscale_placement" "workers" {
+  name           = "training-workers"
+  reservation_id = nscale_reservation.training.id
+  network_id     = nscale_network.training.id
+  host_count     = 8
+
+  constraints = {
+    policy             = "spread"
+    max_skew           = 1
+    min_domains        = 3
+    when_unsatisfiable = "fail"
+  }
+
+  server_spec = {
+    image_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+
+    networking = {
+      security_group_ids = [nscale_security_group.training.id]
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import nscale_reservation.training XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX
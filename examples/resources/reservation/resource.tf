@@ -0,0 +1,11 @@
+resource "nscale_reservation" "training" {
+  name        = "gb300-nvl72"
+  description = "Reserved accelerator units for training."
+  accelerator = "GB300"
+  unit        = "NVL72"
+  unit_count  = 2
+
+  tags = {
+    workload = "training"
+  }
+}
@@ -0,0 +1 @@
+terraform import nscale_security_group.example <security_group_id>
@@ -0,0 +1,27 @@
+data "nscale_region" "glo1" {
+  id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+resource "nscale_network" "example" {
+  name            = "example"
+  cidr_block      = "192.168.0.0/24"
+  dns_nameservers = ["8.8.8.8", "8.8.4.4"]
+  region_id       = data.nscale_region.glo1.id
+}
+
+resource "nscale_security_group" "example" {
+  name = "example"
+
+  rules = [
+    {
+      type      = "ingress"
+      protocol  = "tcp"
+      from_port = 80
+    }
+  ]
+
+  allow_icmp_echo = true
+
+  network_id = nscale_network.example.id
+  region_id  = data.nscale_region.glo1.id
+}
@@ -0,0 +1,5 @@
+# <security_group_id>/<type>/<protocol>/<from_port>/<to_port>/<cidr_block>
+terraform import nscale_security_group_rule.ssh XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX/ingress/tcp/22/22/203.0.113.0/24
+
+# Leave a port empty when it is not set.
+terraform import nscale_security_group_rule.all_egress XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX/egress/any///0.0.0.0/0
@@ -0,0 +1,25 @@
+# A security group shared between stacks, created without inline rules.
+resource "nscale_security_group" "shared" {
+  name       = "shared"
+  network_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX"
+}
+
+# Rules added to it, which may live in other configurations.
+resource "nscale_security_group_rule" "ssh" {
+  security_group_id = nscale_security_group.shared.id
+
+  type       = "ingress"
+  protocol   = "tcp"
+  from_port  = 22
+  to_port    = 22
+  cidr_block = "203.0.113.0/24"
+}
+
+resource "nscale_security_group_rule" "https" {
+  security_group_id = nscale_security_group.shared.id
+
+  type      = "ingress"
+  protocol  = "tcp"
+  from_port = 443
+  to_port   = 443
+}
@@ -0,0 +1 @@
+terraform import nscale_ssh_certificate_authority.example <ssh_certificate_authority_id>
@@ -0,0 +1,4 @@
+resource "nscale_ssh_certificate_authority" "example" {
+  name       = "example-ca"
+  public_key = file("~/.ssh/example_ca.pub")
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apidiag reports the errors the Nscale API returns as diagnostics.
+// Errors are sorted into the categories a user acts on, such as an exceeded
+// quota or a temporary failure, and each category has the same summary and
+// advice whichever resource it comes from.
+package apidiag
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Category is the kind of an API error, which decides what the user can do
+// about it.
+type Category string
+
+const (
+	// CategoryAuth is a request the provider's credentials may not make.
+	CategoryAuth Category = "auth"
+	// CategoryQuota is a request that would exceed the organization's quota.
+	CategoryQuota Category = "quota"
+	// CategoryCapacity is a request the region has no capacity left for.
+	CategoryCapacity Category = "capacity"
+	// CategoryValidation is a request the API rejected as invalid.
+	CategoryValidation Category = "validation"
+	// CategoryConflict is a request that conflicts with the state of another
+	// object, such as one of the same name or one still in use.
+	CategoryConflict Category = "conflict"
+	// CategoryNotFound is a request for, or referring to, an object that does
+	// not exist.
+	CategoryNotFound Category = "not_found"
+	// CategoryTransient is a failure that retrying may get past, such as a
+	// server error or rate limiting.
+	CategoryTransient Category = "transient"
+	// CategoryOther is any other error, including those that are not API
+	// errors, such as a failed connection.
+	CategoryOther Category = "other"
+)
+
+// categoryLabels complete the summary of the errors of each category.
+//
+//nolint:gochecknoglobals // constant lookup table.
+var categoryLabels = map[Category]string{
+	CategoryAuth:       "Not Authorized",
+	CategoryQuota:      "Quota Exceeded",
+	CategoryCapacity:   "Insufficient Capacity",
+	CategoryValidation: "Invalid Request",
+	CategoryConflict:   "Conflict",
+	CategoryNotFound:   "Not Found",
+	CategoryTransient:  "Temporary Error",
+}
+
+// capacityMessages are the phrases the API's descriptions of errors use when
+// a region cannot place a request, whatever status code they come with.
+//
+//nolint:gochecknoglobals // constant lookup table.
+var capacityMessages = []string{"capacity", "no valid host"}
+
+// quotaMessagePattern matches the description the API gives when an allocation
+// would exceed a quota, such as "total gpus allocation of 64 would exceed quota
+// limit of 32".
+var quotaMessagePattern = regexp.MustCompile(`total (\S+) allocation of (\d+) would exceed quota limit of (\d+)`)
+
+// Operation is what a request was doing to a resource when it failed.
+type Operation string
+
+const (
+	Create Operation = "Create"
+	Read   Operation = "Read"
+	Update Operation = "Update"
+	Delete Operation = "Delete"
+)
+
+// gerund returns the operation in its "-ing" form, such as "creating".
+func (o Operation) gerund() string {
+	if o == Read {
+		return "retrieving"
+	}
+
+	verb := strings.ToLower(string(o))
+	return strings.TrimSuffix(verb, "e") + "ing"
+}
+
+// StatusError is an error carrying the response of a failed API request, as
+// nscale.APIError does. It is an interface so that this package does not
+// depend on the client.
+type StatusError interface {
+	error
+
+	// APIStatus returns the response's HTTP status code, its error code, such
+	// as "invalid_request", and its description of the error.
+	APIStatus() (statusCode int, code, message string)
+}
+
+// Classify returns the category of err, which is CategoryOther when err is
+// not an API error.
+func Classify(err error) Category {
+	var statusError StatusError
+	if !errors.As(err, &statusError) {
+		return CategoryOther
+	}
+
+	return classify(statusError.APIStatus())
+}
+
+func classify(statusCode int, code, message string) Category {
+	message = strings.ToLower(message)
+
+	switch {
+	case (statusCode == http.StatusForbidden || statusCode == http.StatusConflict) && strings.Contains(message, "quota"):
+		return CategoryQuota
+	case statusCode >= http.StatusBadRequest && containsAny(message, capacityMessages):
+		return CategoryCapacity
+	// The API refuses to delete an object another still refers to as
+	// forbidden, but it is a conflict the user resolves, not a permission.
+	case statusCode == http.StatusForbidden && strings.Contains(message, "in use"):
+		return CategoryConflict
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden ||
+		code == "access_denied" || code == "forbidden":
+		return CategoryAuth
+	case statusCode == http.StatusNotFound || code == "not_found":
+		return CategoryNotFound
+	case statusCode == http.StatusConflict || code == "conflict":
+		return CategoryConflict
+	case statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError:
+		return CategoryTransient
+	case statusCode >= http.StatusBadRequest:
+		return CategoryValidation
+	default:
+		return CategoryOther
+	}
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Summary returns the summary of an error of category from the operation on
+// the resource titled title, such as "Failed to Create Instance: Quota
+// Exceeded". Errors of CategoryOther have no label.
+func Summary(category Category, operation Operation, title string) string {
+	summary := fmt.Sprintf("Failed to %s %s", operation, title)
+	if label, ok := categoryLabels[category]; ok {
+		summary += ": " + label
+	}
+
+	return summary
+}
+
+// Detail returns the detail of err, of category, from the operation on the
+// resource named name, such as "instance": advice on what to do about the
+// category, followed by err itself.
+func Detail(category Category, operation Operation, name string, err error) string {
+	verb := strings.ToLower(string(operation))
+
+	var advice string
+
+	switch category {
+	case CategoryAuth:
+		advice = fmt.Sprintf(
+			"The provider's credentials are not allowed to %s the %s. "+
+				"Check that the service token has a role granting it in the project, then apply again.",
+			verb, name,
+		)
+	case CategoryQuota:
+		advice = fmt.Sprintf(
+			"%s. %s the %s would exceed the organization's quota, so retrying will fail the same way. "+
+				"Request a quota increase, or free up capacity by removing other resources, then apply again.",
+			quotaExceeded(err), capitalize(operation.gerund()), name,
+		)
+	case CategoryCapacity:
+		advice = fmt.Sprintf(
+			"The region does not have the capacity to %s the %s now. "+
+				"Try another flavor or region, or apply again later.",
+			verb, name,
+		)
+	case CategoryValidation:
+		advice = fmt.Sprintf(
+			"The API rejected the request to %s the %s as invalid. Correct the configuration, then apply again.",
+			verb, name,
+		)
+	case CategoryConflict:
+		advice = fmt.Sprintf(
+			"The request to %s the %s conflicts with another object, such as one of the same name, "+
+				"one still in use, or one with an operation in progress. Resolve the conflict, then apply again.",
+			verb, name,
+		)
+	case CategoryNotFound:
+		advice = fmt.Sprintf(
+			"The %s, or an object it refers to, does not exist. Check the IDs it is configured with, then apply again.",
+			name,
+		)
+	case CategoryTransient:
+		advice = fmt.Sprintf(
+			"The API failed to %s the %s with an error that is likely temporary. Apply again.",
+			verb, name,
+		)
+	default:
+		return fmt.Sprintf("An error occurred while %s the %s: %s", operation.gerund(), name, err)
+	}
+
+	return fmt.Sprintf("%s\n\n%s", advice, err)
+}
+
+// quotaExceeded describes the quota err exceeded, with the quota's limit when
+// the API gave it.
+func quotaExceeded(err error) string {
+	var statusError StatusError
+	if !errors.As(err, &statusError) {
+		return "Quota exceeded"
+	}
+
+	_, _, message := statusError.APIStatus()
+
+	match := quotaMessagePattern.FindStringSubmatch(message)
+	if match == nil {
+		return "Quota exceeded"
+	}
+
+	requested, requestedErr := strconv.ParseInt(match[2], 10, 64)
+	limit, limitErr := strconv.ParseInt(match[3], 10, 64)
+	if requestedErr != nil || limitErr != nil {
+		return "Quota exceeded"
+	}
+
+	return fmt.Sprintf("Quota exceeded: %s requested %d, limit %d", match[1], requested, limit)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// Add reports err, returned by the API for the operation on the resource
+// titled title and named name, such as "Instance" and "instance", to
+// diagnostics, with the summary and detail of its category.
+func Add(diagnostics *diag.Diagnostics, operation Operation, title, name string, err error) {
+	category := Classify(err)
+	diagnostics.AddError(Summary(category, operation, title), Detail(category, operation, name, err))
+}
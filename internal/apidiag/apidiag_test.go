@@ -0,0 +1,196 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apidiag
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testStatusError struct {
+	statusCode int
+	code       string
+	message    string
+}
+
+func (e *testStatusError) Error() string {
+	return fmt.Sprintf("server returned status code %d, message: %s", e.statusCode, e.message)
+}
+
+func (e *testStatusError) APIStatus() (int, string, string) {
+	return e.statusCode, e.code, e.message
+}
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want Category
+	}{
+		{
+			name: "unauthorized",
+			err:  &testStatusError{statusCode: http.StatusUnauthorized, code: "access_denied"},
+			want: CategoryAuth,
+		},
+		{
+			name: "forbidden",
+			err:  &testStatusError{statusCode: http.StatusForbidden, code: "forbidden", message: "access denied"},
+			want: CategoryAuth,
+		},
+		{
+			name: "quota",
+			err:  &testStatusError{statusCode: http.StatusForbidden, message: "project quota exhausted"},
+			want: CategoryQuota,
+		},
+		{
+			name: "capacity",
+			err:  &testStatusError{statusCode: http.StatusInternalServerError, message: "No valid host was found"},
+			want: CategoryCapacity,
+		},
+		{
+			name: "in use",
+			err:  &testStatusError{statusCode: http.StatusForbidden, message: "network is in use"},
+			want: CategoryConflict,
+		},
+		{
+			name: "conflict",
+			err:  &testStatusError{statusCode: http.StatusConflict, code: "conflict"},
+			want: CategoryConflict,
+		},
+		{
+			name: "not found",
+			err:  &testStatusError{statusCode: http.StatusNotFound, code: "not_found"},
+			want: CategoryNotFound,
+		},
+		{
+			name: "invalid request",
+			err:  &testStatusError{statusCode: http.StatusBadRequest, code: "invalid_request"},
+			want: CategoryValidation,
+		},
+		{
+			name: "unprocessable",
+			err:  &testStatusError{statusCode: http.StatusUnprocessableEntity, code: "unprocessable_content"},
+			want: CategoryValidation,
+		},
+		{
+			name: "rate limited",
+			err:  &testStatusError{statusCode: http.StatusTooManyRequests},
+			want: CategoryTransient,
+		},
+		{
+			name: "server error",
+			err:  &testStatusError{statusCode: http.StatusBadGateway, code: "server_error"},
+			want: CategoryTransient,
+		},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("creating: %w", &testStatusError{statusCode: http.StatusConflict}),
+			want: CategoryConflict,
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("connection refused"),
+			want: CategoryOther,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := Classify(testCase.err); got != testCase.want {
+				t.Fatalf("Classify() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	testCases := []struct {
+		name        string
+		operation   Operation
+		err         error
+		wantSummary string
+		wantDetail  string
+	}{
+		{
+			name:      "quota with limits",
+			operation: Create,
+			err: &testStatusError{
+				statusCode: http.StatusForbidden,
+				code:       "forbidden",
+				message:    "total gpus allocation of 64 would exceed quota limit of 32",
+			},
+			wantSummary: "Failed to Create Instance: Quota Exceeded",
+			wantDetail:  "Quota exceeded: gpus requested 64, limit 32. Creating the instance",
+		},
+		{
+			name:        "quota conflict without limits",
+			operation:   Update,
+			err:         &testStatusError{statusCode: http.StatusConflict, message: "project quota exhausted"},
+			wantSummary: "Failed to Update Instance: Quota Exceeded",
+			wantDetail:  "Quota exceeded. Updating the instance",
+		},
+		{
+			name:        "forbidden for another reason",
+			operation:   Create,
+			err:         &testStatusError{statusCode: http.StatusForbidden, message: "access denied"},
+			wantSummary: "Failed to Create Instance: Not Authorized",
+			wantDetail:  "The provider's credentials are not allowed to create the instance",
+		},
+		{
+			name:        "quota mentioned in a server error",
+			operation:   Delete,
+			err:         &testStatusError{statusCode: http.StatusInternalServerError, message: "quota service unavailable"},
+			wantSummary: "Failed to Delete Instance: Temporary Error",
+			wantDetail:  "The API failed to delete the instance",
+		},
+		{
+			name:        "not an API error",
+			operation:   Read,
+			err:         errors.New("connection refused"),
+			wantSummary: "Failed to Read Instance",
+			wantDetail:  "An error occurred while retrieving the instance: connection refused",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+			Add(&diagnostics, testCase.operation, "Instance", "instance", testCase.err)
+
+			if len(diagnostics) != 1 {
+				t.Fatalf("got %d diagnostics, want 1", len(diagnostics))
+			}
+
+			if got := diagnostics[0].Summary(); got != testCase.wantSummary {
+				t.Fatalf("summary = %q, want %q", got, testCase.wantSummary)
+			}
+
+			if got := diagnostics[0].Detail(); !strings.HasPrefix(got, testCase.wantDetail) {
+				t.Fatalf("detail = %q, want it to start with %q", got, testCase.wantDetail)
+			}
+
+			if got := diagnostics[0].Detail(); !strings.HasSuffix(got, testCase.err.Error()) {
+				t.Fatalf("detail = %q, want it to end with the error", got)
+			}
+		})
+	}
+}
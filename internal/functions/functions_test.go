@@ -0,0 +1,76 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFunction(f function.Function, argument string) (attr.Value, *function.FuncError) {
+	request := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(argument)}),
+	}
+
+	var definition function.DefinitionResponse
+	f.Definition(context.Background(), function.DefinitionRequest{}, &definition)
+
+	result, err := definition.Definition.Return.NewResultData(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	response := function.RunResponse{Result: result}
+	f.Run(context.Background(), request, &response)
+
+	return response.Result.Value(), response.Error
+}
+
+func TestIsValidNameFunction(t *testing.T) {
+	for argument, want := range map[string]bool{
+		"my-name": true,
+		"My_Name": false,
+	} {
+		got, err := runFunction(NewIsValidNameFunction(), argument)
+		if err != nil {
+			t.Fatalf("is_valid_name(%q) error = %v", argument, err)
+		}
+
+		if !got.Equal(types.BoolValue(want)) {
+			t.Fatalf("is_valid_name(%q) = %s, want %v", argument, got, want)
+		}
+	}
+}
+
+func TestNormalizeNameFunction(t *testing.T) {
+	got, err := runFunction(NewNormalizeNameFunction(), "My_App Prod")
+	if err != nil {
+		t.Fatalf("normalize_name() error = %v", err)
+	}
+
+	if !got.Equal(types.StringValue("myappprod")) {
+		t.Fatalf("normalize_name() = %s, want myappprod", got)
+	}
+
+	if _, err = runFunction(NewNormalizeNameFunction(), "1234"); err == nil {
+		t.Fatal("normalize_name(\"1234\") succeeded, want an error")
+	}
+}
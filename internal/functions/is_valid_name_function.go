@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var _ function.Function = &IsValidNameFunction{}
+
+// IsValidNameFunction checks a name against the rules the provider validates
+// resource names with.
+type IsValidNameFunction struct{}
+
+func NewIsValidNameFunction() function.Function {
+	return &IsValidNameFunction{}
+}
+
+func (f *IsValidNameFunction) Metadata(
+	ctx context.Context,
+	request function.MetadataRequest,
+	response *function.MetadataResponse,
+) {
+	response.Name = "is_valid_name"
+}
+
+func (f *IsValidNameFunction) Definition(
+	ctx context.Context,
+	request function.DefinitionRequest,
+	response *function.DefinitionResponse,
+) {
+	response.Definition = function.Definition{
+		Summary:             "Check whether a string is a valid Nscale resource name",
+		MarkdownDescription: "Returns whether `name` is accepted as the `name` of an Nscale resource: it must start with a lowercase letter, contain only lowercase letters, digits or hyphens, end with a letter or digit, and be at most 63 characters long.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsValidNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var name string
+
+	response.Error = request.Arguments.Get(ctx, &name)
+	if response.Error != nil {
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, validators.IsValidName(name))
+}
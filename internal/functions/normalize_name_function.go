@@ -0,0 +1,82 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var _ function.Function = &NormalizeNameFunction{}
+
+// NormalizeNameFunction derives a valid resource name from an arbitrary
+// string, such as a module input or another provider's identifier.
+type NormalizeNameFunction struct{}
+
+func NewNormalizeNameFunction() function.Function {
+	return &NormalizeNameFunction{}
+}
+
+func (f *NormalizeNameFunction) Metadata(
+	ctx context.Context,
+	request function.MetadataRequest,
+	response *function.MetadataResponse,
+) {
+	response.Name = "normalize_name"
+}
+
+func (f *NormalizeNameFunction) Definition(
+	ctx context.Context,
+	request function.DefinitionRequest,
+	response *function.DefinitionResponse,
+) {
+	response.Definition = function.Definition{
+		Summary:             "Derive a valid Nscale resource name from a string",
+		MarkdownDescription: "Returns `value` made into a valid Nscale resource name: it is lowercased, characters other than letters, digits and hyphens are removed, anything before the first letter is dropped, and it is truncated to 63 characters without a trailing hyphen. It fails if `value` contains no letters. Different values can normalize to the same name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The string to derive the name from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var value string
+
+	response.Error = request.Arguments.Get(ctx, &value)
+	if response.Error != nil {
+		return
+	}
+
+	name, ok := validators.NormalizeName(value)
+	if !ok {
+		response.Error = function.NewArgumentFuncError(
+			0,
+			fmt.Sprintf("Cannot derive a resource name from %q: it contains no letters to start the name with.", value),
+		)
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, name)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+	kubernetesapi "github.com/nscaledev/nscale-sdk-go/kubernetes"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	storageapi "github.com/nscaledev/nscale-sdk-go/storage"
+	legacycomputeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+)
+
+// APIFeature is a family of API endpoints that a resource relies on and that
+// an Nscale environment may not provide yet, for example because it still
+// runs an older version of a service. The provider mixes API versions, such
+// as the v1 compute cluster and v2 instance endpoints, so an environment can
+// support some resources and not others.
+type APIFeature string
+
+const (
+	RegionAPIV2         APIFeature = "region API v2"
+	ComputeAPIV2        APIFeature = "compute API v2"
+	ComputeClusterAPIV1 APIFeature = "compute API v1 compute clusters"
+	StorageAPIV1        APIFeature = "storage API v1"
+	KubernetesAPIV1     APIFeature = "Kubernetes API v1"
+)
+
+// apiFeatureProbes caches the result of probing each feature, so it is probed
+// at most once however many resources require it.
+type apiFeatureProbes struct {
+	mutex  sync.Mutex
+	probes map[APIFeature]*apiFeatureProbe
+}
+
+type apiFeatureProbe struct {
+	once sync.Once
+
+	// missing describes the request that showed the feature is missing, and is
+	// empty when it is supported or its support could not be determined.
+	missing string
+}
+
+// RequireFeature checks that the environment provides feature, probing it the
+// first time it is required, and returns an error naming title otherwise, in
+// place of the bare not found errors its requests would fail with. Only an
+// endpoint the API does not route is taken to mean the feature is missing; any
+// other failure is left for the resource's own requests to report.
+func (c *Client) RequireFeature(ctx context.Context, feature APIFeature, title string) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	c.features.mutex.Lock()
+	if c.features.probes == nil {
+		c.features.probes = map[APIFeature]*apiFeatureProbe{}
+	}
+	probe, ok := c.features.probes[feature]
+	if !ok {
+		probe = &apiFeatureProbe{}
+		c.features.probes[feature] = probe
+	}
+	c.features.mutex.Unlock()
+
+	probe.once.Do(func() { probe.missing = c.probeFeature(ctx, feature) })
+
+	if probe.missing != "" {
+		diagnostics.AddError(
+			"Unsupported Nscale API",
+			fmt.Sprintf(
+				"%s requires the %s, which the Nscale environment does not provide: %s. "+
+					"Check that the provider's service API endpoints point at an environment that supports it.",
+				title, feature, probe.missing,
+			),
+		)
+	}
+
+	return diagnostics
+}
+
+// probeProjectID is the nil UUID, which no project has, so the project
+// filtered lists that probe a feature are always empty.
+const probeProjectID = "00000000-0000-0000-0000-000000000000"
+
+// probeTag is a tag selector no resource matches, for the probes of features
+// whose lists cannot be filtered by project.
+const probeTag = "terraform-provider-nscale.feature-probe=none"
+
+// probeFeature lists resources from one endpoint of the feature, filtered so
+// that none match, and returns a description of the request if the endpoint
+// does not exist. The filter keeps the probe cheap however many resources the
+// organization has. A method not allowed, or a not found that is not one of the
+// API's error bodies, means the endpoint is not routed; any other failure, such
+// as the API not finding the organization, is left to the resource's requests.
+func (c *Client) probeFeature(ctx context.Context, feature APIFeature) string {
+	var (
+		response *http.Response
+		err      error
+	)
+
+	switch feature {
+	case RegionAPIV2:
+		response, err = c.Region.GetApiV2Networks(ctx, &regionapi.GetApiV2NetworksParams{
+			OrganizationID: &regionapi.OrganizationIDQueryParameter{c.OrganizationID},
+			ProjectID:      &regionapi.ProjectIDQueryParameter{probeProjectID},
+		})
+	case ComputeAPIV2:
+		response, err = c.Compute.GetApiV2Instances(ctx, &computeapi.GetApiV2InstancesParams{
+			OrganizationID: &computeapi.OrganizationIDQueryParameter{c.OrganizationID},
+			ProjectID:      &computeapi.ProjectIDQueryParameter{probeProjectID},
+		})
+	case ComputeClusterAPIV1:
+		response, err = c.LegacyCompute.GetApiV1OrganizationsOrganizationIDClusters(ctx, c.OrganizationID,
+			&legacycomputeapi.GetApiV1OrganizationsOrganizationIDClustersParams{Tag: &[]string{probeTag}},
+		)
+	case KubernetesAPIV1:
+		response, err = c.Kubernetes.GetApiV1OrganizationsOrganizationIDClusters(ctx, c.OrganizationID,
+			&kubernetesapi.GetApiV1OrganizationsOrganizationIDClustersParams{Tag: &[]string{probeTag}},
+		)
+	case StorageAPIV1:
+		response, err = c.Storage.GetApiV1Objectstorageendpoints(ctx, &storageapi.GetApiV1ObjectstorageendpointsParams{
+			OrganizationID: &storageapi.OrganizationIDQueryParameter{c.OrganizationID},
+			ProjectID:      &storageapi.ProjectIDQueryParameter{probeProjectID},
+		})
+	default:
+		return ""
+	}
+
+	if err != nil {
+		return ""
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusMethodNotAllowed &&
+		(response.StatusCode != http.StatusNotFound || !routeNotFound(response)) {
+		return ""
+	}
+
+	if response.Request == nil {
+		return fmt.Sprintf("its endpoints returned %s", response.Status)
+	}
+
+	return fmt.Sprintf("%s %s returned %s", response.Request.Method, response.Request.URL.Path, response.Status)
+}
+
+// routeNotFound reports whether a not found response came from the router
+// rather than from the API, which always explains a not found in its error
+// body.
+func routeNotFound(response *http.Response) bool {
+	bodyBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return false
+	}
+
+	var data errorResponse
+	if err = json.Unmarshal(bodyBytes, &data); err != nil {
+		return true
+	}
+
+	return data.Error == ""
+}
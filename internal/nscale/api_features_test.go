@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+// fakeNetworksClient answers the region API v2 probe with a fixed status.
+type fakeNetworksClient struct {
+	regionapi.ClientInterface
+
+	status int
+	calls  int
+	t      *testing.T
+
+	// routed makes a not found come with the API's error body, as for a
+	// missing organization, rather than the router's.
+	routed bool
+}
+
+func (c *fakeNetworksClient) GetApiV2Networks(
+	_ context.Context,
+	params *regionapi.GetApiV2NetworksParams,
+	_ ...regionapi.RequestEditorFn,
+) (*http.Response, error) {
+	c.calls++
+
+	// The probe must not list the organization's networks.
+	if params == nil || params.ProjectID == nil || !slices.Equal(*params.ProjectID, []string{probeProjectID}) {
+		c.t.Errorf("probed networks with %+v, want them filtered by the nil project", params)
+	}
+
+	if c.status == http.StatusNotFound && !c.routed {
+		body := io.NopCloser(strings.NewReader("404 page not found\n"))
+		return &http.Response{StatusCode: c.status, Header: http.Header{}, Body: body}, nil
+	}
+
+	if c.status != http.StatusOK {
+		return jsonResponse(c.t, c.status, errorResponse{Error: http.StatusText(c.status)}), nil
+	}
+	return jsonResponse(c.t, http.StatusOK, []regionapi.NetworkV2Read{}), nil
+}
+
+func TestRequireFeature(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    int
+		routed    bool
+		wantError bool
+	}{
+		{name: "supported", status: http.StatusOK},
+		{name: "missing", status: http.StatusNotFound, wantError: true},
+		{name: "method not allowed", status: http.StatusMethodNotAllowed, wantError: true},
+		{name: "not found by the API is not taken as missing", status: http.StatusNotFound, routed: true},
+		{name: "unauthorized is not taken as missing", status: http.StatusUnauthorized},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			region := &fakeNetworksClient{status: testCase.status, routed: testCase.routed, t: t}
+			client := &Client{OrganizationID: "organization", Region: region}
+
+			for range 3 {
+				diagnostics := client.RequireFeature(context.Background(), RegionAPIV2, "Network")
+				if got := diagnostics.HasError(); got != testCase.wantError {
+					t.Fatalf("RequireFeature() error = %v, want %v: %v", got, testCase.wantError, diagnostics)
+				}
+
+				if testCase.wantError && !strings.Contains(diagnostics[0].Detail(), "Network requires the region API v2") {
+					t.Fatalf("RequireFeature() detail = %q, want it to name the resource and API", diagnostics[0].Detail())
+				}
+			}
+
+			if region.calls != 1 {
+				t.Fatalf("probed the region API %d times, want once", region.calls)
+			}
+		})
+	}
+}
@@ -17,14 +17,14 @@ limitations under the License.
 package nscale
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"maps"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
 	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
+	kubernetesapi "github.com/nscaledev/nscale-sdk-go/kubernetes"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 	reservationapi "github.com/nscaledev/nscale-sdk-go/reservation"
 	storageapi "github.com/nscaledev/nscale-sdk-go/storage"
@@ -47,12 +47,41 @@ type Client struct {
 	Reservation    reservationapi.ClientInterface
 	LegacyCompute  legacycomputeapi.ClientInterface
 	Storage        storageapi.ClientInterface
+	Kubernetes     kubernetesapi.ClientInterface
+
+	// RequiredTags lists the tag keys every taggable resource must set; see
+	// EnforceRequiredTags.
+	RequiredTags []string
+
+	// WaitForDependencies makes creates retry while the objects they refer to
+	// are not visible yet; see RetryDependencyNotFound.
+	WaitForDependencies bool
+
+	// DisallowSensitiveInState keeps secrets, such as generated SSH private
+	// keys, out of Terraform state; see SensitiveValue.
+	DisallowSensitiveInState bool
+
+	// projectIDs are the projects of project_service_tokens; see
+	// ProjectScopes.
+	projectIDs []string
+
+	instances instanceReader
+	features  apiFeatureProbes
 }
 
 func NewClient(
-	regionServiceBaseURL, computeServiceBaseURL, identityServiceBaseURL, reservationServiceBaseURL, storageServiceBaseURL, serviceToken, organizationID, projectID, regionID, userAgent string,
+	regionServiceBaseURL, computeServiceBaseURL, identityServiceBaseURL, reservationServiceBaseURL, storageServiceBaseURL, kubernetesServiceBaseURL, organizationID, projectID, regionID, userAgent string,
+	credentials Credentials,
 ) (*Client, error) {
-	httpClient := NewHTTPClient(userAgent, serviceToken)
+	httpClient := NewHTTPClient(userAgent, credentials)
+
+	if credentials.OIDC != nil {
+		oidc, err := oidcTokenSources.get(*credentials.OIDC, identityServiceBaseURL, organizationID, userAgent)
+		if err != nil {
+			return nil, err
+		}
+		httpClient.oidc = oidc
+	}
 
 	region, err := regionapi.NewClient(regionServiceBaseURL, regionapi.WithHTTPClient(httpClient))
 	if err != nil {
@@ -90,6 +119,11 @@ func NewClient(
 		return nil, fmt.Errorf("failed to create Nscale storage API client: %w", err)
 	}
 
+	kubernetes, err := kubernetesapi.NewClient(kubernetesServiceBaseURL, kubernetesapi.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Nscale Kubernetes API client: %w", err)
+	}
+
 	client := &Client{
 		RegionID:       regionID,
 		OrganizationID: organizationID,
@@ -100,6 +134,8 @@ func NewClient(
 		Reservation:    reservation,
 		LegacyCompute:  legacyCompute,
 		Storage:        storage,
+		Kubernetes:     kubernetes,
+		projectIDs:     slices.Sorted(maps.Keys(credentials.ProjectServiceTokens)),
 	}
 
 	return client, nil
@@ -129,86 +165,3 @@ func (c *Client) ResolveProjectID(resourceProjectID string) (string, diag.Diagno
 		return "", diagnostics
 	}
 }
-
-type errorResponse struct {
-	Error            string  `json:"error"`
-	ErrorDescription string  `json:"error_description"`
-	TraceID          *string `json:"trace_id"`
-}
-
-func ReadJSONResponsePointer[T any](response *http.Response) (*T, error) {
-	data, err := ReadJSONResponseValue[T](response)
-	if err != nil {
-		return nil, err
-	}
-	return &data, nil
-}
-
-func ReadJSONResponseValue[T any](response *http.Response) (T, error) {
-	var data T
-
-	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		err := readErrorResponse(response)
-		return data, err
-	}
-
-	bodyBytes, err := io.ReadAll(response.Body)
-	if err != nil {
-		err = responseReadError(response, err)
-		return data, err
-	}
-
-	if err = json.Unmarshal(bodyBytes, &data); err != nil {
-		err = responseDecodeError(response, bodyBytes, err)
-		return data, err
-	}
-
-	return data, nil
-}
-
-func ReadEmptyResponse(response *http.Response) error {
-	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		return readErrorResponse(response)
-	}
-	return nil
-}
-
-func readErrorResponse(response *http.Response) error {
-	bodyBytes, err := io.ReadAll(response.Body)
-	if err != nil {
-		return responseReadError(response, err)
-	}
-
-	var data errorResponse
-	if err = json.Unmarshal(bodyBytes, &data); err != nil {
-		return responseDecodeError(response, bodyBytes, err)
-	}
-
-	return &APIError{
-		StatusCode: response.StatusCode,
-		Code:       data.Error,
-		Message:    data.ErrorDescription,
-		TraceID:    data.TraceID,
-	}
-}
-
-func responseReadError(response *http.Response, err error) error {
-	return &APIError{
-		StatusCode: response.StatusCode,
-		Message:    fmt.Sprintf("failed to read response body: %s", err),
-	}
-}
-
-func responseDecodeError(response *http.Response, bodyBytes []byte, err error) error {
-	var endpoint string
-	if response.Request != nil {
-		endpoint = fmt.Sprintf("%s %s", response.Request.Method, response.Request.URL.Path)
-	}
-
-	return &APIError{
-		StatusCode: response.StatusCode,
-		Message:    fmt.Sprintf("failed to decode response: %s", err),
-		Endpoint:   endpoint,
-		BodyBytes:  bodyBytes,
-	}
-}
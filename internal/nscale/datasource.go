@@ -21,6 +21,10 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 )
 
 // DataSourceAdapter captures the per-data-source variation for the read+map
@@ -40,8 +44,26 @@ type DataSourceAdapter[TFModel any, APIRead any] struct {
 	// ToModel maps an API read object into a fresh TF model.
 	ToModel func(api *APIRead) TFModel
 
+	// Enrich, when set, fills in the attributes of dst read from endpoints
+	// other than Get's; see ResourceAdapter.Enrich.
+	Enrich func(ctx context.Context, client *Client, dst *TFModel) diag.Diagnostics
+
 	// IDFromModel reads the configured id off the model.
 	IDFromModel func(m TFModel) string
+
+	// RequiredFeature, when set, is the API feature the data source's
+	// endpoints belong to; see ResourceAdapter.RequiredFeature.
+	RequiredFeature APIFeature
+
+	// SensitiveAttributes are the attributes holding secrets; see
+	// ResourceAdapter.SensitiveAttributes.
+	SensitiveAttributes []path.Path
+
+	// EphemeralAlternative, when set, is the ephemeral resource that reads the
+	// same secret as the data source without storing it. The data source exists
+	// only to read that secret, so it fails instead of reading it when the
+	// provider disallows sensitive values in state.
+	EphemeralAlternative string
 }
 
 // GenericDataSource implements the datasource.DataSource lifecycle once, driven
@@ -62,7 +84,7 @@ func NewGenericDataSource[TFModel, APIRead any](
 }
 
 func (s *GenericDataSource[TFModel, APIRead]) Configure(
-	_ context.Context,
+	ctx context.Context,
 	request datasource.ConfigureRequest,
 	response *datasource.ConfigureResponse,
 ) {
@@ -83,6 +105,10 @@ func (s *GenericDataSource[TFModel, APIRead]) Configure(
 	}
 
 	s.client = client
+
+	if s.adapter.RequiredFeature != "" {
+		response.Diagnostics.Append(client.RequireFeature(ctx, s.adapter.RequiredFeature, s.adapter.Title)...)
+	}
 }
 
 func (s *GenericDataSource[TFModel, APIRead]) Metadata(
@@ -98,6 +124,14 @@ func (s *GenericDataSource[TFModel, APIRead]) Read(
 	request datasource.ReadRequest,
 	response *datasource.ReadResponse,
 ) {
+	if s.adapter.EphemeralAlternative != "" && s.client.DisallowSensitiveInState {
+		AddSensitiveInStateError(&response.Diagnostics, "the "+s.adapter.Name, s.adapter.EphemeralAlternative)
+		return
+	}
+
+	ctx = s.client.WithProjectIDFrom(ctx, request.Config.GetAttribute)
+	ctx = withBatchedReads(ctx)
+
 	data, diagnostics := ReadTerraformState[TFModel](ctx, request.Config.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -107,13 +141,19 @@ func (s *GenericDataSource[TFModel, APIRead]) Read(
 	api, err := s.adapter.Get(ctx, s.client, s.adapter.IDFromModel(data))
 	if err != nil {
 		TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			fmt.Sprintf("Failed to Read %s", s.adapter.Title),
-			fmt.Sprintf("An error occurred while retrieving the %s: %s", s.adapter.Name, err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, s.adapter.Title, s.adapter.Name, err)
 		return
 	}
 
 	data = s.adapter.ToModel(api)
-	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	if s.adapter.Enrich != nil {
+		response.Diagnostics.Append(s.adapter.Enrich(ctx, s.client, &data)...)
+	}
+
+	if diagnostics = response.State.Set(ctx, &data); diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	response.Diagnostics.Append(s.client.omitSensitive(ctx, &response.State, s.adapter.SensitiveAttributes)...)
 }
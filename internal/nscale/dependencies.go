@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+)
+
+// DependencyKind is a kind of object a resource refers to by ID.
+type DependencyKind string
+
+const (
+	NetworkDependency       DependencyKind = "network"
+	SecurityGroupDependency DependencyKind = "security group"
+)
+
+const (
+	dependencyStatePending = "pending"
+	dependencyStateReady   = "ready"
+)
+
+// Dependency is an object a resource refers to by ID, and that must exist
+// before the resource is created.
+type Dependency struct {
+	Kind DependencyKind
+	ID   string
+}
+
+// dependencyPollBackoff is shorter than the state watchers' schedule, since a
+// dependency that is not visible yet normally becomes so within seconds.
+//
+//nolint:gochecknoglobals // shortened by tests; never written outside them.
+var dependencyPollBackoff = pollBackoff{Initial: time.Second, Max: 10 * time.Second}
+
+// RetryDependencyNotFound sends a create request with create and, when the
+// provider is configured with wait_for_dependencies, sends it again while the
+// API answers not found, until the create timeout. The last response is
+// returned for the caller to read as usual.
+//
+// The API is cache-backed, so an object created moments earlier, typically a
+// network created in the same apply, may not be visible yet to the service a
+// dependent resource is created in, and the create then fails with not found.
+// Only that service can tell when it has caught up, so the create itself is
+// retried rather than the dependencies being looked up elsewhere.
+func (c *Client) RetryDependencyNotFound(
+	ctx context.Context,
+	timeouts tftimeouts.Value,
+	create func(ctx context.Context) (*http.Response, error),
+) (*http.Response, error) {
+	response, err := create(ctx)
+	if !c.WaitForDependencies {
+		return response, err
+	}
+
+	// An invalid timeout is reported by the create's state watcher.
+	timeout, diagnostics := timeouts.Create(ctx, defaultStateWatcherTimeout)
+	if diagnostics.HasError() {
+		timeout = defaultStateWatcherTimeout
+	}
+
+	deadline := time.Now().Add(timeout)
+	delay := dependencyPollBackoff.Initial
+
+	for err == nil && response.StatusCode == http.StatusNotFound && time.Now().Add(delay).Before(deadline) {
+		response.Body.Close()
+
+		tflog.Debug(ctx, "Create failed with not found, retrying while its dependencies become visible", map[string]any{
+			"delay": delay.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+
+		delay = min(2*delay, dependencyPollBackoff.Max)
+		response, err = create(ctx)
+	}
+
+	return response, err
+}
+
+// AwaitReady waits, within the create timeout, for each object to be readable
+// from the region service and provisioned. It is for objects a resource
+// creates itself before the objects that refer to them, such as the security
+// group of a bastion.
+func (c *Client) AwaitReady(
+	ctx context.Context,
+	timeouts tftimeouts.Value,
+	dependencies []Dependency,
+) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if len(dependencies) == 0 {
+		return diagnostics
+	}
+
+	timeout, diagnostics := timeouts.Create(ctx, defaultStateWatcherTimeout)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for _, dependency := range dependencies {
+		stateWatcher := retry.StateChangeConf{
+			Timeout: time.Until(deadline),
+			Pending: []string{dependencyStatePending},
+			Target:  []string{dependencyStateReady},
+			Refresh: func() (any, string, error) {
+				status, err := c.dependencyStatus(ctx, dependency)
+				if err != nil {
+					if IsAPIErrorNotFound(err) {
+						return struct{}{}, dependencyStatePending, nil
+					}
+					return nil, "", err
+				}
+
+				switch status.ProvisioningStatus {
+				case coreapi.ResourceProvisioningStatusProvisioned:
+					return struct{}{}, dependencyStateReady, nil
+				case coreapi.ResourceProvisioningStatusError:
+					return nil, "", fmt.Errorf("the %s is in an error state", dependency.Kind)
+				default:
+					return struct{}{}, dependencyStatePending, nil
+				}
+			},
+		}
+
+		waiter := backoffWaiter{backoff: dependencyPollBackoff}
+		if _, err := waiter.WaitForState(ctx, &stateWatcher); err != nil {
+			TerraformDebugLogAPIResponseBody(ctx, err)
+			diagnostics.AddError(
+				"Failed to Wait for Dependency",
+				fmt.Sprintf("An error occurred while waiting for the %s %s to be ready: %s", dependency.Kind, dependency.ID, err),
+			)
+			return diagnostics
+		}
+	}
+
+	return diagnostics
+}
+
+// dependencyStatus reads the status of a dependency from the region service.
+func (c *Client) dependencyStatus(ctx context.Context, dependency Dependency) (ResourceStatus, error) {
+	var (
+		response *http.Response
+		err      error
+	)
+
+	switch dependency.Kind {
+	case NetworkDependency:
+		networkID, parseErr := regionids.ParseNetworkID(dependency.ID)
+		if parseErr != nil {
+			return ResourceStatus{}, parseErr
+		}
+		response, err = c.Region.GetApiV2NetworksNetworkID(ctx, networkID)
+	case SecurityGroupDependency:
+		securityGroupID, parseErr := regionids.ParseSecurityGroupID(dependency.ID)
+		if parseErr != nil {
+			return ResourceStatus{}, parseErr
+		}
+		response, err = c.Region.GetApiV2SecuritygroupsSecurityGroupID(ctx, securityGroupID)
+	default:
+		return ResourceStatus{}, fmt.Errorf("unsupported dependency kind %q", dependency.Kind)
+	}
+
+	if err != nil {
+		return ResourceStatus{}, err
+	}
+	defer response.Body.Close()
+
+	// Networks and security groups share the project-scoped read metadata, and
+	// only it is needed.
+	read, err := ReadJSONResponseValue[struct {
+		Metadata coreapi.ProjectScopedResourceReadMetadata `json:"metadata"`
+	}](response)
+	if err != nil {
+		return ResourceStatus{}, err
+	}
+
+	return StatusFromProjectScoped(&read.Metadata), nil
+}
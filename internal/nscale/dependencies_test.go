@@ -0,0 +1,186 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+// fakeDependencyClient answers network and security group reads with the next
+// of a sequence of statuses, where an empty status is not found.
+type fakeDependencyClient struct {
+	regionapi.ClientInterface
+
+	statuses []coreapi.ResourceProvisioningStatus
+	calls    int
+	t        *testing.T
+}
+
+func (c *fakeDependencyClient) respond() *http.Response {
+	status := c.statuses[min(c.calls, len(c.statuses)-1)]
+	c.calls++
+
+	if status == "" {
+		return jsonResponse(c.t, http.StatusNotFound, errorResponse{Error: "not_found"})
+	}
+
+	return jsonResponse(c.t, http.StatusOK, regionapi.NetworkV2Read{
+		Metadata: coreapi.ProjectScopedResourceReadMetadata{ProvisioningStatus: status},
+	})
+}
+
+func (c *fakeDependencyClient) GetApiV2NetworksNetworkID(
+	_ context.Context,
+	_ regionapi.NetworkIDParameter,
+	_ ...regionapi.RequestEditorFn,
+) (*http.Response, error) {
+	return c.respond(), nil
+}
+
+func (c *fakeDependencyClient) GetApiV2SecuritygroupsSecurityGroupID(
+	_ context.Context,
+	_ regionapi.SecurityGroupIDParameter,
+	_ ...regionapi.RequestEditorFn,
+) (*http.Response, error) {
+	return c.respond(), nil
+}
+
+func TestAwaitReady(t *testing.T) {
+	timeouts := tftimeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})}
+
+	dependencies := []Dependency{
+		{Kind: NetworkDependency, ID: "9d6d7a1e-7a6f-4e5c-9a3a-2f0f1c2d3e4f"},
+		{Kind: SecurityGroupDependency, ID: "0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"},
+	}
+
+	testCases := []struct {
+		name      string
+		statuses  []coreapi.ResourceProvisioningStatus
+		wantCalls int
+		wantError bool
+	}{
+		{
+			name:      "ready",
+			statuses:  []coreapi.ResourceProvisioningStatus{coreapi.ResourceProvisioningStatusProvisioned},
+			wantCalls: 2,
+		},
+		{
+			name: "not visible yet",
+			statuses: []coreapi.ResourceProvisioningStatus{
+				"",
+				coreapi.ResourceProvisioningStatusProvisioning,
+				coreapi.ResourceProvisioningStatusProvisioned,
+			},
+			wantCalls: 4,
+		},
+		{
+			name:      "error",
+			statuses:  []coreapi.ResourceProvisioningStatus{coreapi.ResourceProvisioningStatusError},
+			wantCalls: 1,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			region := &fakeDependencyClient{statuses: testCase.statuses, t: t}
+			client := &Client{Region: region}
+
+			diagnostics := client.AwaitReady(context.Background(), timeouts, dependencies)
+			if got := diagnostics.HasError(); got != testCase.wantError {
+				t.Fatalf("AwaitReady() error = %v, want %v: %v", got, testCase.wantError, diagnostics)
+			}
+
+			if region.calls != testCase.wantCalls {
+				t.Fatalf("made %d reads, want %d", region.calls, testCase.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryDependencyNotFound(t *testing.T) {
+	timeouts := tftimeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType})}
+
+	testCases := []struct {
+		name       string
+		disabled   bool
+		statuses   []int
+		wantCalls  int
+		wantStatus int
+	}{
+		{
+			name:       "disabled",
+			disabled:   true,
+			statuses:   []int{http.StatusNotFound, http.StatusCreated},
+			wantCalls:  1,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "created",
+			statuses:   []int{http.StatusCreated},
+			wantCalls:  1,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "dependency not visible yet",
+			statuses:   []int{http.StatusNotFound, http.StatusNotFound, http.StatusCreated},
+			wantCalls:  3,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "other errors are not retried",
+			statuses:   []int{http.StatusBadRequest, http.StatusCreated},
+			wantCalls:  1,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := &Client{WaitForDependencies: !testCase.disabled}
+
+			calls := 0
+			response, err := client.RetryDependencyNotFound(context.Background(), timeouts,
+				func(context.Context) (*http.Response, error) {
+					status := testCase.statuses[calls]
+					calls++
+					return jsonResponse(t, status, struct{}{}), nil
+				},
+			)
+			if err != nil {
+				t.Fatalf("RetryDependencyNotFound() error = %v", err)
+			}
+			defer response.Body.Close()
+
+			if response.StatusCode != testCase.wantStatus {
+				t.Fatalf("RetryDependencyNotFound() status = %d, want %d", response.StatusCode, testCase.wantStatus)
+			}
+
+			if calls != testCase.wantCalls {
+				t.Fatalf("made %d creates, want %d", calls, testCase.wantCalls)
+			}
+		})
+	}
+}
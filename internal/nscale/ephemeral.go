@@ -0,0 +1,104 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+)
+
+// GenericEphemeralResource implements the ephemeral.EphemeralResource
+// lifecycle once, driven by a DataSourceAdapter, for secrets that a data source
+// built on the same adapter would keep in state. Terraform opens it whenever
+// the secret is needed and stores nothing.
+type GenericEphemeralResource[TFModel any, APIRead any] struct {
+	client  *Client
+	adapter DataSourceAdapter[TFModel, APIRead]
+}
+
+// NewGenericEphemeralResource builds a GenericEphemeralResource for the given
+// adapter. The client is set later, in Configure.
+func NewGenericEphemeralResource[TFModel, APIRead any](
+	adapter DataSourceAdapter[TFModel, APIRead],
+) *GenericEphemeralResource[TFModel, APIRead] {
+	// client is populated later, in Configure.
+	return &GenericEphemeralResource[TFModel, APIRead]{client: nil, adapter: adapter}
+}
+
+func (e *GenericEphemeralResource[TFModel, APIRead]) Configure(
+	ctx context.Context,
+	request ephemeral.ConfigureRequest,
+	response *ephemeral.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	e.client = client
+
+	if e.adapter.RequiredFeature != "" {
+		response.Diagnostics.Append(client.RequireFeature(ctx, e.adapter.RequiredFeature, e.adapter.Title)...)
+	}
+}
+
+func (e *GenericEphemeralResource[TFModel, APIRead]) Metadata(
+	_ context.Context,
+	request ephemeral.MetadataRequest,
+	response *ephemeral.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + e.adapter.TypeNameSuffix
+}
+
+func (e *GenericEphemeralResource[TFModel, APIRead]) Open(
+	ctx context.Context,
+	request ephemeral.OpenRequest,
+	response *ephemeral.OpenResponse,
+) {
+	ctx = e.client.WithProjectIDFrom(ctx, request.Config.GetAttribute)
+
+	data, diagnostics := ReadTerraformState[TFModel](ctx, request.Config.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	api, err := e.adapter.Get(ctx, e.client, e.adapter.IDFromModel(data))
+	if err != nil {
+		TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, e.adapter.Title, e.adapter.Name, err)
+		return
+	}
+
+	data = e.adapter.ToModel(api)
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
@@ -20,17 +20,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 )
 
+var _ apidiag.StatusError = (*APIError)(nil)
+
 type APIError struct {
 	StatusCode int
 	Code       string
 	Message    string
 	TraceID    *string
+	// RequestID is the backend's request or correlation ID for the failed
+	// call, taken from the response headers, for support tickets to quote.
+	RequestID string
+	// RetryAfter is the delay a 503 response asked for in its Retry-After
+	// header, as the API sends during maintenance windows, or zero.
+	RetryAfter time.Duration
 
 	// The following fields are set only when the error is created while parsing an API response body.
 	Endpoint  string
@@ -58,9 +71,27 @@ func (e *APIError) Error() string {
 		builder.WriteString(*e.TraceID)
 	}
 
+	if e.RequestID != "" {
+		builder.WriteString(", request_id: ")
+		builder.WriteString(e.RequestID)
+	}
+
+	if e.RetryAfter > 0 {
+		builder.WriteString(", retry_after: ")
+		builder.WriteString(e.RetryAfter.String())
+	}
+
 	return builder.String()
 }
 
+// APIStatus returns the status code, error code and description of the error,
+// for apidiag to classify it by.
+func (e *APIError) APIStatus() (int, string, string) {
+	return e.StatusCode, e.Code, e.Message
+}
+
+// AsAPIError returns the *APIError in err's chain, as returned by the
+// response readers, if there is one.
 func AsAPIError(err error) (*APIError, bool) {
 	if e := (*APIError)(nil); errors.As(err, &e) {
 		return e, true
@@ -68,6 +99,33 @@ func AsAPIError(err error) (*APIError, bool) {
 	return nil, false
 }
 
+// IsAPIErrorStatus reports whether err is an API error with any of the given
+// status codes.
+func IsAPIErrorStatus(err error, statusCodes ...int) bool {
+	e, ok := AsAPIError(err)
+	return ok && slices.Contains(statusCodes, e.StatusCode)
+}
+
+// IsAPIErrorNotFound reports whether err is the API reporting that the object
+// does not exist, which reads, waits and deletes treat as its absence rather
+// than as a failure.
+func IsAPIErrorNotFound(err error) bool {
+	return IsAPIErrorStatus(err, http.StatusNotFound)
+}
+
+// APIMaintenanceDelay reports whether err is the API turning a request away
+// during a maintenance window, a 503 with a Retry-After header, and how long
+// it asked the caller to wait before trying again.
+func APIMaintenanceDelay(err error) (time.Duration, bool) {
+	if e, ok := AsAPIError(err); ok && e.RetryAfter > 0 {
+		return e.RetryAfter, true
+	}
+	return 0, false
+}
+
+// TerraformDebugLogAPIResponseBody logs, at debug level, the raw body of a
+// response that could not be decoded, so a malformed response can be diagnosed
+// without the body cluttering the user-facing error. Other errors are ignored.
 func TerraformDebugLogAPIResponseBody(ctx context.Context, err error) {
 	if e, ok := AsAPIError(err); ok && len(e.BodyBytes) > 0 {
 		message := "API response could not be parsed"
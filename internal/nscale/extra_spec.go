@@ -0,0 +1,128 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ExtraSpecContentType is the content type of request bodies with an extra
+// spec merged in, sent through the API clients' WithBody methods.
+const ExtraSpecContentType = "application/json"
+
+// JSONObject encodes a request body as a generic JSON object, so extra specs
+// can be merged into it before it is sent. Numbers are kept as json.Number so
+// they are written back exactly.
+func JSONObject(body any) (map[string]any, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	var object map[string]any
+	if err = decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// JSONBody encodes a request body built by JSONObject for sending.
+func JSONBody(object map[string]any) (io.Reader, error) {
+	encoded, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(encoded), nil
+}
+
+// MergeExtraSpec deep-merges extraSpec, the JSON object of an extra_spec_json
+// attribute, into target: objects are merged key by key, and any other value
+// replaces the one in target. The attribute's validator rejects extra specs
+// that would replace a field the provider manages, so only fields the
+// provider does not model are added. An empty extraSpec leaves target as is.
+func MergeExtraSpec(target map[string]any, extraSpec string) error {
+	if extraSpec == "" {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(extraSpec)))
+	decoder.UseNumber()
+
+	var extra map[string]any
+	if err := decoder.Decode(&extra); err != nil {
+		return fmt.Errorf("extra spec is not a JSON object: %w", err)
+	}
+
+	mergeJSONObjects(target, extra)
+
+	return nil
+}
+
+func mergeJSONObjects(target, source map[string]any) {
+	for key, value := range source {
+		sourceObject, sourceIsObject := value.(map[string]any)
+		targetObject, targetIsObject := target[key].(map[string]any)
+
+		if sourceIsObject && targetIsObject {
+			mergeJSONObjects(targetObject, sourceObject)
+			continue
+		}
+
+		target[key] = value
+	}
+}
+
+// KeepUnmanagedFields copies into target, an object built for a write, the
+// fields of current, the same object as the API returned it, that an extra spec
+// could have set: those that are not in managed, the dot-separated paths of the
+// fields the provider sets, and do not replace an object containing one. It
+// keeps the extra spec fields of objects the writer does not configure itself.
+func KeepUnmanagedFields(target, current map[string]any, managed []string) {
+	keepUnmanagedFields(target, current, managed, "")
+}
+
+func keepUnmanagedFields(target, current map[string]any, managed []string, prefix string) {
+	for key, value := range current {
+		field := prefix + key
+
+		if slices.Contains(managed, field) {
+			continue
+		}
+
+		if !slices.ContainsFunc(managed, func(path string) bool { return strings.HasPrefix(path, field+".") }) {
+			target[key] = value
+			continue
+		}
+
+		currentObject, currentIsObject := value.(map[string]any)
+		targetObject, targetIsObject := target[key].(map[string]any)
+
+		if currentIsObject && targetIsObject {
+			keepUnmanagedFields(targetObject, currentObject, managed, field+".")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestMergeExtraSpec(t *testing.T) {
+	body, err := JSONObject(map[string]any{
+		"spec": map[string]any{
+			"flavorId": "flavor",
+			"count":    9007199254740993,
+			"machine":  map[string]any{"replicas": 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("JSONObject() error = %v", err)
+	}
+
+	spec, _ := body["spec"].(map[string]any)
+	if err = MergeExtraSpec(spec, `{"machine": {"disk": {"size": 100}}, "priority": "high"}`); err != nil {
+		t.Fatalf("MergeExtraSpec() error = %v", err)
+	}
+
+	if err = MergeExtraSpec(spec, `[1]`); err == nil {
+		t.Fatal("MergeExtraSpec() accepted a JSON array")
+	}
+
+	reader, err := JSONBody(body)
+	if err != nil {
+		t.Fatalf("JSONBody() error = %v", err)
+	}
+
+	encoded, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("failed to read the body: %v", err)
+	}
+
+	var got, want any
+	if err = json.Unmarshal(encoded, &got); err != nil {
+		t.Fatalf("failed to decode the body: %v", err)
+	}
+
+	_ = json.Unmarshal([]byte(`{"spec": {
+		"flavorId": "flavor",
+		"count": 9007199254740993,
+		"machine": {"replicas": 2, "disk": {"size": 100}},
+		"priority": "high"
+	}}`), &want)
+
+	gotJSON, _ := json.Marshal(got)
+	wantJSON, _ := json.Marshal(want)
+	if string(gotJSON) != string(wantJSON) {
+		t.Fatalf("body = %s, want %s", gotJSON, wantJSON)
+	}
+
+	// Large integers are written back exactly rather than through a float.
+	if !strings.Contains(string(encoded), `"count":9007199254740993`) {
+		t.Fatalf("body = %s, want the count written back exactly", encoded)
+	}
+}
+
+func TestKeepUnmanagedFields(t *testing.T) {
+	target := map[string]any{
+		"name":    "pool",
+		"machine": map[string]any{"replicas": 2},
+	}
+	current := map[string]any{
+		"name":      "renamed",
+		"placement": "spread",
+		"machine":   map[string]any{"replicas": 1, "disk": map[string]any{"size": 100}},
+		"firewall":  map[string]any{"ingress": []any{}},
+	}
+
+	KeepUnmanagedFields(target, current, []string{"name", "machine.replicas", "firewall.ingress"})
+
+	gotJSON, _ := json.Marshal(target)
+	wantJSON := `{"machine":{"disk":{"size":100},"replicas":2},"name":"pool","placement":"spread"}`
+	if string(gotJSON) != wantJSON {
+		t.Fatalf("target = %s, want %s", gotJSON, wantJSON)
+	}
+}
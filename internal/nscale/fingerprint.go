@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SpecFingerprint hashes the JSON encoding of a resource's spec as read from
+// the API, for the spec_fingerprint attribute. The encoding of a spec is
+// deterministic, so the fingerprint changes exactly when the spec does, however
+// the change was made.
+func SpecFingerprint(spec any) types.String {
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		return types.StringNull()
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+// SpecFingerprintDescription describes the spec_fingerprint attribute of a
+// resource or data source named name.
+func SpecFingerprintDescription(name string) string {
+	return "A hash of the " + name + "'s specification as last read from the API. It changes whenever the " +
+		"specification changes, whether through Terraform or not, so it can detect drift or drive " +
+		"`replace_triggered_by`."
+}
+
+// SpecFingerprintPlanModifier plans a resource's spec_fingerprint as it is in
+// state unless one of attributes, the top-level attributes the resource writes
+// to or reads from its spec, is planned to change. An update of anything else,
+// such as the tags, leaves the spec and so its fingerprint as they are.
+func SpecFingerprintPlanModifier(attributes ...string) planmodifier.String {
+	return specFingerprintPlanModifier{attributes: attributes}
+}
+
+type specFingerprintPlanModifier struct {
+	attributes []string
+}
+
+func (m specFingerprintPlanModifier) Description(_ context.Context) string {
+	return "Keeps the fingerprint in state while the attributes of the specification do not change."
+}
+
+func (m specFingerprintPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m specFingerprintPlanModifier) PlanModifyString(
+	ctx context.Context,
+	request planmodifier.StringRequest,
+	response *planmodifier.StringResponse,
+) {
+	if !request.PlanValue.IsUnknown() || request.State.Raw.IsNull() || request.StateValue.IsNull() {
+		return
+	}
+
+	for _, name := range m.attributes {
+		var planned, stored attr.Value
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(name), &stored)...)
+		if response.Diagnostics.HasError() || !planned.Equal(stored) {
+			return
+		}
+	}
+
+	response.PlanValue = request.StateValue
+}
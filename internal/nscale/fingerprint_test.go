@@ -0,0 +1,158 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSpecFingerprint(t *testing.T) {
+	type spec struct {
+		Name  string            `json:"name"`
+		Tags  map[string]string `json:"tags"`
+		Count int               `json:"count"`
+	}
+
+	base := spec{Name: "a", Tags: map[string]string{"x": "1", "y": "2"}, Count: 1}
+
+	fingerprint := SpecFingerprint(base)
+	if fingerprint.IsNull() || len(fingerprint.ValueString()) != 64 {
+		t.Fatalf("SpecFingerprint() = %v, want a SHA-256 hex digest", fingerprint)
+	}
+
+	same := spec{Name: "a", Tags: map[string]string{"y": "2", "x": "1"}, Count: 1}
+	if got := SpecFingerprint(same); !got.Equal(fingerprint) {
+		t.Fatalf("SpecFingerprint() of an equal spec = %v, want %v", got, fingerprint)
+	}
+
+	changed := spec{Name: "a", Tags: map[string]string{"x": "1", "y": "2"}, Count: 2}
+	if got := SpecFingerprint(changed); got.Equal(fingerprint) {
+		t.Fatalf("SpecFingerprint() of a changed spec = %v, want it to differ", got)
+	}
+
+	if got := SpecFingerprint(func() {}); !got.IsNull() {
+		t.Fatalf("SpecFingerprint() of an unencodable spec = %v, want null", got)
+	}
+}
+
+type testFingerprintModel struct {
+	Rules           types.List   `tfsdk:"rules"`
+	Tags            types.Map    `tfsdk:"tags"`
+	SpecFingerprint types.String `tfsdk:"spec_fingerprint"`
+}
+
+func TestSpecFingerprintPlanModifier(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rules":            schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"tags":             schema.MapAttribute{ElementType: types.StringType, Optional: true},
+			"spec_fingerprint": schema.StringAttribute{Computed: true},
+		},
+	}
+
+	fingerprint := types.StringValue("fingerprint")
+	stored := testFingerprintModel{
+		Rules:           types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ssh")}),
+		Tags:            types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("ml")}),
+		SpecFingerprint: fingerprint,
+	}
+
+	testCases := []struct {
+		name   string
+		create bool
+		modify func(planned *testFingerprintModel)
+		want   types.String
+	}{
+		{
+			name:   "create",
+			create: true,
+			modify: func(*testFingerprintModel) {},
+			want:   types.StringUnknown(),
+		},
+		{
+			name: "tags changed",
+			modify: func(planned *testFingerprintModel) {
+				planned.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("infra")})
+			},
+			want: fingerprint,
+		},
+		{
+			name: "spec attribute changed",
+			modify: func(planned *testFingerprintModel) {
+				planned.Rules = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("http")})
+			},
+			want: types.StringUnknown(),
+		},
+		{
+			name: "spec attribute unknown",
+			modify: func(planned *testFingerprintModel) {
+				planned.Rules = types.ListUnknown(types.StringType)
+			},
+			want: types.StringUnknown(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			terraformType := testSchema.Type().TerraformType(ctx)
+
+			state := tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(terraformType, nil)}
+			stateValue := types.StringNull()
+			if !testCase.create {
+				if diagnostics := state.Set(ctx, stored); diagnostics.HasError() {
+					t.Fatalf("failed to set state: %v", diagnostics)
+				}
+				stateValue = fingerprint
+			}
+
+			planned := stored
+			planned.SpecFingerprint = types.StringUnknown()
+			testCase.modify(&planned)
+			plan := tfsdk.Plan{Schema: testSchema, Raw: tftypes.NewValue(terraformType, nil)}
+			if diagnostics := plan.Set(ctx, planned); diagnostics.HasError() {
+				t.Fatalf("failed to set plan: %v", diagnostics)
+			}
+
+			request := planmodifier.StringRequest{
+				Plan:       plan,
+				State:      state,
+				PlanValue:  types.StringUnknown(),
+				StateValue: stateValue,
+			}
+			response := planmodifier.StringResponse{PlanValue: request.PlanValue}
+
+			SpecFingerprintPlanModifier("rules").PlanModifyString(ctx, request, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("PlanModifyString() error: %v", response.Diagnostics)
+			}
+
+			if !response.PlanValue.Equal(testCase.want) {
+				t.Fatalf("planned spec_fingerprint = %v, want %v", response.PlanValue, testCase.want)
+			}
+		})
+	}
+}
@@ -19,7 +19,6 @@ package nscale
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 	"time"
 
@@ -27,15 +26,78 @@ import (
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 )
 
 const (
 	TerraformOperationTagPrefix = "terraform.nscale.com/"
 	defaultStateWatcherTimeout  = 30 * time.Minute
+
+	// metadataUpdateTimeout bounds the wait for an update that only changes
+	// metadata, which the API observes as soon as its cache catches up.
+	metadataUpdateTimeout = 2 * time.Minute
 )
 
+// pollBackoff is the schedule the state watchers poll on: once immediately,
+// then after Initial, doubling up to Max. It is independent of the HTTP
+// client's retries, which only cover individual failed requests.
+type pollBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+// stateWatcherPollBackoff settles long provisions at one poll a minute, where
+// the StateChangeConf default backs off to at most 10s and so lists every
+// resource of a kind some 180 times in a 30-minute create.
+//
+//nolint:gochecknoglobals // shortened by tests; never written outside them.
+var stateWatcherPollBackoff = pollBackoff{Initial: 5 * time.Second, Max: time.Minute}
+
+// apply makes conf poll on the backoff schedule. StateChangeConf re-reads
+// PollInterval before every wait, so the refresh function lengthens it as the
+// polls go by.
+//
+// A poll the API turns away for a maintenance window does not fail the wait:
+// the previous result stands and the next poll comes after the Retry-After
+// delay, so only conf's Timeout ends a wait that the maintenance outlasts.
+func (b pollBackoff) apply(ctx context.Context, conf *retry.StateChangeConf) {
+	refresh := conf.Refresh
+	next := b.Initial
+
+	var (
+		lastResult any = struct{}{}
+		lastState  string
+	)
+	if len(conf.Pending) > 0 {
+		lastState = conf.Pending[0]
+	}
+
+	conf.PollInterval = next
+	conf.Refresh = func() (any, string, error) {
+		conf.PollInterval = next
+		next = min(next*2, b.Max)
+
+		result, state, err := refresh()
+		if delay, ok := APIMaintenanceDelay(err); ok {
+			tflog.Warn(ctx, "The Nscale API is undergoing maintenance, waiting before polling again", map[string]any{
+				"retry_after": delay.String(),
+			})
+			conf.PollInterval = delay
+			return lastResult, lastState, nil
+		}
+
+		if err == nil && result != nil {
+			lastResult, lastState = result, state
+		}
+
+		return result, state, err
+	}
+}
+
 type StateReaderFunc func(ctx context.Context, target any) diag.Diagnostics
 
 func ReadTerraformState[T any](ctx context.Context, fn StateReaderFunc, mutates ...func(*T)) (T, diag.Diagnostics) {
@@ -104,6 +166,9 @@ type CreateStateWatcher[T any] struct {
 	ResourceTitle string
 	ResourceName  string
 	GetFunc       func(ctx context.Context) (*T, ResourceStatus, error)
+
+	// waiter runs the poll loop, in real time when nil.
+	waiter stateWaiter
 }
 
 func (w *CreateStateWatcher[T]) Wait(
@@ -120,6 +185,8 @@ func (w *CreateStateWatcher[T]) Wait(
 	var lastStatus ResourceStatus
 	var haveStatus bool
 
+	progress := newProgressReporter(ctx, w.ResourceTitle, "creating")
+
 	stateWatcher := retry.StateChangeConf{
 		Timeout: timeout,
 		Pending: []string{
@@ -134,21 +201,23 @@ func (w *CreateStateWatcher[T]) Wait(
 		Refresh: func() (any, string, error) {
 			result, status, err := w.GetFunc(ctx)
 			if err != nil {
-				if e, ok := AsAPIError(err); ok && e.StatusCode == http.StatusNotFound {
+				if IsAPIErrorNotFound(err) {
 					// FIXME: Temporary workaround for resources that might not yet be visible in the cache-backed client. Should be revisited once API consistency is guaranteed.
+					progress.observe("", string(coreapi.ResourceProvisioningStatusUnknown))
 					return nil, string(coreapi.ResourceProvisioningStatusUnknown), nil
 				}
 				return nil, "", err
 			}
 			lastStatus = status
 			haveStatus = true
+			progress.observe(status.ID, string(status.ProvisioningStatus))
 			return result, string(status.ProvisioningStatus), nil
 		},
 	}
 
 	var zero *T
 
-	state, err := stateWatcher.WaitForStateContext(ctx)
+	state, err := waitForState(ctx, w.waiter, &stateWatcher)
 	if err != nil {
 		TerraformDebugLogAPIResponseBody(ctx, err)
 		response.Diagnostics.AddError(
@@ -187,7 +256,7 @@ func (r *ResourceReader[T]) Read(ctx context.Context, id string, response *resou
 
 	result, _, err := r.GetFunc(ctx, id)
 	if err != nil {
-		if e, ok := AsAPIError(err); ok && e.StatusCode == http.StatusNotFound {
+		if IsAPIErrorNotFound(err) {
 			response.Diagnostics.AddWarning(
 				fmt.Sprintf("%s Not Found", r.ResourceTitle),
 				fmt.Sprintf(
@@ -202,10 +271,7 @@ func (r *ResourceReader[T]) Read(ctx context.Context, id string, response *resou
 
 		TerraformDebugLogAPIResponseBody(ctx, err)
 
-		response.Diagnostics.AddError(
-			fmt.Sprintf("Failed to Read %s", r.ResourceTitle),
-			fmt.Sprintf("An error occurred while retrieving the %s: %s", r.ResourceName, err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, r.ResourceTitle, r.ResourceName, err)
 
 		return zero, false
 	}
@@ -275,6 +341,9 @@ type UpdateStateWatcher[T any] struct {
 	ResourceTitle string
 	ResourceName  string
 	GetFunc       func(ctx context.Context) (*T, ResourceStatus, error)
+
+	// waiter runs the poll loop, in real time when nil.
+	waiter stateWaiter
 }
 
 func (w *UpdateStateWatcher[T]) Wait(
@@ -289,9 +358,42 @@ func (w *UpdateStateWatcher[T]) Wait(
 		return nil, false
 	}
 
+	return w.WaitFor(ctx, operationTagKey, timeout, &response.Diagnostics)
+}
+
+// WaitAdopted waits for an update issued from Create to bring an adopted,
+// pre-existing object in line with the plan. It is bounded by the create
+// timeout, since that is the operation the user asked for.
+func (w *UpdateStateWatcher[T]) WaitAdopted(
+	ctx context.Context,
+	operationTagKey string,
+	timeouts tftimeouts.Value,
+	response *resource.CreateResponse,
+) (*T, bool) {
+	timeout, diagnostics := timeouts.Create(ctx, defaultStateWatcherTimeout)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return nil, false
+	}
+
+	return w.WaitFor(ctx, operationTagKey, timeout, &response.Diagnostics)
+}
+
+// WaitFor waits up to timeout for the write tagged with operationTagKey to be
+// observed. It is for writes that do not map one-to-one onto a Terraform
+// operation on the watched object, such as a child resource that is stored in
+// its parent's spec, where the caller picks which configured timeout applies.
+func (w *UpdateStateWatcher[T]) WaitFor(
+	ctx context.Context,
+	operationTagKey string,
+	timeout time.Duration,
+	diagnostics *diag.Diagnostics,
+) (*T, bool) {
 	var lastStatus ResourceStatus
 	var haveStatus bool
 
+	progress := newProgressReporter(ctx, w.ResourceTitle, "updating")
+
 	stateWatcher := retry.StateChangeConf{
 		Timeout: timeout,
 		Pending: []string{UpdateStateUpdating},
@@ -304,6 +406,7 @@ func (w *UpdateStateWatcher[T]) Wait(
 
 			lastStatus = status
 			haveStatus = true
+			progress.observe(status.ID, string(status.ProvisioningStatus))
 
 			if status.ProvisioningStatus == coreapi.ResourceProvisioningStatusError {
 				return result, UpdateStateProvisioningError, nil
@@ -319,22 +422,22 @@ func (w *UpdateStateWatcher[T]) Wait(
 
 	var zero *T
 
-	state, err := stateWatcher.WaitForStateContext(ctx)
+	state, err := waitForState(ctx, w.waiter, &stateWatcher)
 	if err != nil {
 		TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
+		diagnostics.AddError(
 			fmt.Sprintf("Failed to Wait for %s to be Updated", w.ResourceTitle),
 			fmt.Sprintf("An error occurred while waiting for the %s to be updated: %s", w.ResourceName, err),
 		)
 		return zero, false
 	}
 
-	result, ok := assertState[T](state, &response.Diagnostics)
+	result, ok := assertState[T](state, diagnostics)
 	if !ok {
 		return zero, false
 	}
 
-	if addProvisioningErrorDiagnostic(&response.Diagnostics, w.ResourceTitle, lastStatus, haveStatus,
+	if addProvisioningErrorDiagnostic(diagnostics, w.ResourceTitle, lastStatus, haveStatus,
 		"transitioned to 'error' during update. Run 'terraform apply' to try again, or reach out to support.") {
 		return result, false
 	}
@@ -353,6 +456,9 @@ type DeleteStateWatcher struct {
 	ResourceTitle string
 	ResourceName  string
 	GetFunc       func(ctx context.Context) (any, ResourceStatus, error)
+
+	// waiter runs the poll loop, in real time when nil.
+	waiter stateWaiter
 }
 
 func (w *DeleteStateWatcher) Wait(
@@ -369,6 +475,8 @@ func (w *DeleteStateWatcher) Wait(
 	var lastStatus ResourceStatus
 	var haveStatus bool
 
+	progress := newProgressReporter(ctx, w.ResourceTitle, "deleting")
+
 	stateWatcher := retry.StateChangeConf{
 		Timeout: timeout,
 		Pending: []string{DeleteStateDeleting},
@@ -378,13 +486,14 @@ func (w *DeleteStateWatcher) Wait(
 			if err == nil {
 				lastStatus = status
 				haveStatus = true
+				progress.observe(status.ID, string(status.ProvisioningStatus))
 				if status.ProvisioningStatus == coreapi.ResourceProvisioningStatusError {
 					return struct{}{}, DeleteStateProvisioningError, nil
 				}
 				return struct{}{}, DeleteStateDeleting, nil
 			}
 
-			if e, ok := AsAPIError(err); ok && e.StatusCode == http.StatusNotFound {
+			if IsAPIErrorNotFound(err) {
 				return struct{}{}, DeleteStateDeleted, nil
 			}
 
@@ -392,7 +501,7 @@ func (w *DeleteStateWatcher) Wait(
 		},
 	}
 
-	if _, err := stateWatcher.WaitForStateContext(ctx); err != nil {
+	if _, err := waitForState(ctx, w.waiter, &stateWatcher); err != nil {
 		TerraformDebugLogAPIResponseBody(ctx, err)
 		response.Diagnostics.AddError(
 			fmt.Sprintf("Failed to Wait for %s to be Deleted", w.ResourceTitle),
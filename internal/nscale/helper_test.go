@@ -3,6 +3,8 @@ package nscale
 import (
 	"context"
 	"errors"
+	"net/http"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -10,6 +12,7 @@ import (
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	coreapi "github.com/nscaledev/nscale-sdk-go/common"
 )
 
@@ -279,6 +282,48 @@ func TestUpdateStateWatcherWaitTreatsErrorAsTerminal(t *testing.T) {
 	}
 }
 
+// TestUpdateStateWatcherWaitAdoptedWaitsForOperationTag ensures the adopt waiter polls past the
+// stale, pre-update object and only returns once the operation tag has propagated.
+func TestUpdateStateWatcherWaitAdoptedWaitsForOperationTag(t *testing.T) {
+	const operationTagKey = TerraformOperationTagPrefix + "test-op"
+
+	calls := 0
+
+	watcher := UpdateStateWatcher[waitTestResource]{
+		ResourceTitle: "Network",
+		ResourceName:  "network",
+		GetFunc: func(ctx context.Context) (*waitTestResource, ResourceStatus, error) {
+			calls++
+
+			metadata := &coreapi.ProjectScopedResourceReadMetadata{
+				Id:                 "b0a6d2c4-5f0e-4d43-9a8e-2a7c1f3e6d90",
+				ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned,
+			}
+			if calls > 1 {
+				metadata.Tags = &coreapi.TagList{{Name: operationTagKey, Value: "1"}}
+				return &waitTestResource{name: "updated"}, StatusFromProjectScoped(metadata), nil
+			}
+
+			return &waitTestResource{name: "stale"}, StatusFromProjectScoped(metadata), nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	var response resource.CreateResponse
+	var timeouts tftimeouts.Value
+
+	result, ok := watcher.WaitAdopted(ctx, operationTagKey, timeouts, &response)
+	if !ok {
+		t.Fatalf("WaitAdopted() returned ok=false: %#v", response.Diagnostics)
+	}
+
+	if result.name != "updated" {
+		t.Fatalf("WaitAdopted() returned %q, want the tagged object", result.name)
+	}
+}
+
 // TestDeleteStateWatcherWaitTreatsErrorAsTerminal ensures the delete waiter exits cleanly with a
 // diagnostic when the API reports provisioningStatus=error instead of 404'ing.
 func TestDeleteStateWatcherWaitTreatsErrorAsTerminal(t *testing.T) {
@@ -332,3 +377,83 @@ func TestDeleteStateWatcherWaitTreatsErrorAsTerminal(t *testing.T) {
 		t.Fatalf("Wait() did not produce a diagnostic with summary %q: %#v", wantSummary, response.Diagnostics)
 	}
 }
+
+func TestPollBackoffLengthensIntervalUpToMax(t *testing.T) {
+	backoff := pollBackoff{Initial: 5 * time.Second, Max: time.Minute}
+
+	conf := retry.StateChangeConf{
+		Refresh: func() (any, string, error) {
+			return struct{}{}, "pending", nil
+		},
+	}
+	backoff.apply(context.Background(), &conf)
+
+	var intervals []time.Duration
+	for range 6 {
+		if _, _, err := conf.Refresh(); err != nil {
+			t.Fatalf("Refresh() error = %v", err)
+		}
+		intervals = append(intervals, conf.PollInterval)
+	}
+
+	want := []time.Duration{
+		5 * time.Second,
+		10 * time.Second,
+		20 * time.Second,
+		40 * time.Second,
+		time.Minute,
+		time.Minute,
+	}
+	if !slices.Equal(intervals, want) {
+		t.Fatalf("poll intervals = %v, want %v", intervals, want)
+	}
+}
+
+func TestPollBackoffWaitsOutMaintenance(t *testing.T) {
+	backoff := pollBackoff{Initial: 5 * time.Second, Max: time.Minute}
+
+	maintenance := &APIError{StatusCode: http.StatusServiceUnavailable, RetryAfter: 2 * time.Minute}
+	failure := &APIError{StatusCode: http.StatusInternalServerError}
+
+	polls := []struct {
+		result any
+		state  string
+		err    error
+	}{
+		{err: maintenance},
+		{result: "first", state: "pending"},
+		{err: maintenance},
+		{err: failure},
+	}
+
+	conf := retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Refresh: func() (any, string, error) {
+			poll := polls[0]
+			polls = polls[1:]
+			return poll.result, poll.state, poll.err
+		},
+	}
+	backoff.apply(context.Background(), &conf)
+
+	// Maintenance before any result holds the wait in its first pending state.
+	if result, state, err := conf.Refresh(); err != nil || result == nil || state != "pending" {
+		t.Fatalf("Refresh() = %v, %q, %v, want a pending result", result, state, err)
+	}
+	if conf.PollInterval != 2*time.Minute {
+		t.Fatalf("poll interval = %v, want the Retry-After delay", conf.PollInterval)
+	}
+
+	if _, _, err := conf.Refresh(); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	// Maintenance later repeats the last result.
+	if result, state, err := conf.Refresh(); err != nil || result != "first" || state != "pending" {
+		t.Fatalf("Refresh() = %v, %q, %v, want the last result", result, state, err)
+	}
+
+	if _, _, err := conf.Refresh(); !errors.Is(err, failure) {
+		t.Fatalf("Refresh() error = %v, want other errors to fail the wait", err)
+	}
+}
@@ -28,30 +28,105 @@ type HTTPClient struct {
 	internal    *http.Client
 	userAgent   string
 	accessToken string
+
+	// projectAccessTokens replaces accessToken for requests scoped to one of
+	// its projects with Client.WithProjectID.
+	projectAccessTokens map[string]string
+
+	// oidc, when set, provides the token used in place of accessToken.
+	oidc *oidcTokenSource
 }
 
-func NewHTTPClient(userAgent, serviceToken string) *HTTPClient {
+// Credentials are how the client authenticates with the Nscale APIs.
+type Credentials struct {
+	// ServiceToken authenticates every request that no project token covers.
+	ServiceToken string
+
+	// ProjectServiceTokens maps project IDs to the service tokens used for
+	// requests scoped to them; see Client.WithProjectID.
+	ProjectServiceTokens map[string]string
+
+	// OIDC, when set, replaces ServiceToken with a token exchanged for the
+	// CI platform's identity token.
+	OIDC *OIDCCredentials
+}
+
+func NewHTTPClient(userAgent string, credentials Credentials) *HTTPClient {
 	retryableHTTPClient := retryablehttp.NewClient()
 	retryableHTTPClient.CheckRetry = retryPolicy
+	retryableHTTPClient.ErrorHandler = retryErrorHandler
+
+	projectAccessTokens := make(map[string]string, len(credentials.ProjectServiceTokens))
+	for projectID, token := range credentials.ProjectServiceTokens {
+		projectAccessTokens[projectID] = fmt.Sprintf("Bearer %s", token)
+	}
 
 	return &HTTPClient{
-		internal:    retryableHTTPClient.StandardClient(),
-		userAgent:   userAgent,
-		accessToken: fmt.Sprintf("Bearer %s", serviceToken),
+		internal:            retryableHTTPClient.StandardClient(),
+		userAgent:           userAgent,
+		accessToken:         fmt.Sprintf("Bearer %s", credentials.ServiceToken),
+		projectAccessTokens: projectAccessTokens,
 	}
 }
 
 func (c *HTTPClient) Do(r *http.Request) (*http.Response, error) {
+	accessToken, err := c.authorization(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
 	r.Header.Set("User-Agent", c.userAgent)
-	r.Header.Set("Authorization", c.accessToken)
+	r.Header.Set("Authorization", accessToken)
 	//nolint:gosec // request URL is built by the openapi-generated client against a configured API host, not user-controlled input
 	return c.internal.Do(r)
 }
 
+// authorization returns the Authorization header for a request made with ctx.
+func (c *HTTPClient) authorization(ctx context.Context) (string, error) {
+	if token, ok := c.projectAccessTokens[ProjectIDFromContext(ctx)]; ok {
+		return token, nil
+	}
+
+	if c.oidc == nil {
+		return c.accessToken, nil
+	}
+
+	token, err := c.oidc.token(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Bearer %s", token), nil
+}
+
 // retryPolicy defines a custom retry policy to prevent recreating the same resource on 5XX errors.
+// A 503 with a Retry-After header is the exception: the API sends it during maintenance windows
+// without processing the request, and the retry waits as long as the header asks.
 func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if responseRetryAfter(resp) > 0 {
+		return ctx.Err() == nil, ctx.Err()
+	}
 	if resp != nil && resp.StatusCode >= http.StatusInternalServerError {
 		return false, nil
 	}
 	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
 }
+
+// retryErrorHandler returns the last response of a maintenance window that
+// outlasted the retries, so the caller reads it as an *APIError carrying the
+// Retry-After delay and the state watchers can keep waiting. Any other
+// failure is reported as retryablehttp reports it by default.
+func retryErrorHandler(resp *http.Response, err error, numTries int) (*http.Response, error) {
+	if responseRetryAfter(resp) > 0 {
+		return resp, nil
+	}
+
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	if err == nil {
+		return nil, fmt.Errorf("giving up after %d attempt(s)", numTries)
+	}
+	return nil, fmt.Errorf("giving up after %d attempt(s): %w", numTries, err)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"strings"
+
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+// ListAvailableImages returns the ready images available to the organization
+// in a region, including the platform's own images.
+func (c *Client) ListAvailableImages(
+	ctx context.Context,
+	regionID regionapi.RegionIDParameter,
+) ([]regionapi.Image, error) {
+	scope := regionapi.GetApiV2RegionsRegionIDImagesParamsScopeAvailable
+	params := &regionapi.GetApiV2RegionsRegionIDImagesParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{c.OrganizationID},
+		Scope:          &scope,
+		Status:         &regionapi.ImageStatusQueryParameter{regionapi.ImageStateReady},
+	}
+
+	response, err := c.Region.GetApiV2RegionsRegionIDImages(ctx, regionID, params)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return ReadJSONResponseValue[[]regionapi.Image](response)
+}
+
+// VersionHasPrefix reports whether version is prefix or a more specific version
+// within it, comparing whole dot-separated components and ignoring a leading
+// "v": "2.4" matches "2.4" and "v2.4.1" but not "2.40".
+func VersionHasPrefix(version, prefix string) bool {
+	version = strings.TrimPrefix(version, "v")
+	prefix = strings.TrimPrefix(prefix, "v")
+
+	return version == prefix || strings.HasPrefix(version, prefix+".")
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+)
+
+// instanceBatchWindow is how long the first instance read of a batch waits for
+// others to join it before the batch is sent.
+//
+//nolint:gochecknoglobals // overridden by tests.
+var instanceBatchWindow = 50 * time.Millisecond
+
+type batchedReadsContextKey struct{}
+
+// withBatchedReads marks ctx as belonging to a Terraform read, the only reads
+// that Terraform issues in parallel. Other reads, such as the polls of a
+// watcher, always go straight to the API.
+func withBatchedReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, batchedReadsContextKey{}, true)
+}
+
+func batchedReads(ctx context.Context) bool {
+	batched, _ := ctx.Value(batchedReadsContextKey{}).(bool)
+	return batched
+}
+
+// instanceReader coalesces the instance reads that Terraform issues in
+// parallel, for example while refreshing many nscale_instance resources, into
+// a single list of the organization's instances. The API has no way to fetch
+// several instances by ID, so the list is filtered locally. Reads are batched
+// per project, since a project's service token may only list its own
+// instances.
+type instanceReader struct {
+	mutex    sync.Mutex
+	pending  map[string]*instanceBatch
+	inFlight map[string]int
+}
+
+type instanceBatch struct {
+	ids       map[string]struct{}
+	done      chan struct{}
+	instances map[string]*computeapi.InstanceRead
+	err       error
+}
+
+// GetInstance reads an instance by ID. A read with no other read of its
+// project in flight is sent straight away as a GET of the instance. Reads that
+// arrive while one is in flight are held for instanceBatchWindow and share one
+// list call; a read that is alone in its batch, or whose instance is missing
+// from the list, falls back to a GET so that errors, including not found, come
+// from the API.
+func (c *Client) GetInstance(ctx context.Context, id string) (*computeapi.InstanceRead, error) {
+	// Without an organization the list would not be scoped to the caller.
+	if c.OrganizationID == "" || !batchedReads(ctx) {
+		return c.getInstance(ctx, id)
+	}
+
+	projectID := ProjectIDFromContext(ctx)
+
+	batch := c.instances.join(ctx, c, projectID, id)
+	if batch == nil {
+		defer c.instances.leave(projectID)
+		return c.getInstance(ctx, id)
+	}
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if instance, ok := batch.instances[id]; ok && batch.err == nil {
+		copied := *instance
+		return &copied, nil
+	}
+
+	return c.getInstance(ctx, id)
+}
+
+// join adds id to the pending batch of projectID, opening a new one if there is
+// none but another read of the project is in flight. It returns nil if the
+// read is alone, in which case it is recorded as in flight until the caller
+// calls leave.
+func (r *instanceReader) join(ctx context.Context, client *Client, projectID, id string) *instanceBatch {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	batch, ok := r.pending[projectID]
+	if !ok {
+		if r.inFlight[projectID] == 0 {
+			if r.inFlight == nil {
+				r.inFlight = map[string]int{}
+			}
+			r.inFlight[projectID]++
+
+			return nil
+		}
+
+		batch = &instanceBatch{
+			ids:  map[string]struct{}{},
+			done: make(chan struct{}),
+		}
+
+		if r.pending == nil {
+			r.pending = map[string]*instanceBatch{}
+		}
+		r.pending[projectID] = batch
+
+		// The list runs on behalf of every read in the batch, so it must not be
+		// cancelled along with the read that happened to open it.
+		listCtx := context.WithoutCancel(ctx)
+		time.AfterFunc(instanceBatchWindow, func() { r.send(listCtx, client, projectID, batch) })
+	}
+
+	batch.ids[id] = struct{}{}
+
+	return batch
+}
+
+// leave records that a read join let through on its own has finished.
+func (r *instanceReader) leave(projectID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.inFlight[projectID]--
+	if r.inFlight[projectID] == 0 {
+		delete(r.inFlight, projectID)
+	}
+}
+
+// send closes the batch to new reads and, if more than one instance was
+// requested, lists the instances of the batch's project for them.
+func (r *instanceReader) send(ctx context.Context, client *Client, projectID string, batch *instanceBatch) {
+	r.mutex.Lock()
+	delete(r.pending, projectID)
+	r.mutex.Unlock()
+
+	defer close(batch.done)
+
+	if len(batch.ids) < 2 {
+		return
+	}
+
+	instances, err := client.listInstances(ctx, projectID)
+	if err != nil {
+		batch.err = err
+		return
+	}
+
+	batch.instances = map[string]*computeapi.InstanceRead{}
+	for i := range instances {
+		if _, ok := batch.ids[instances[i].Metadata.Id]; ok {
+			batch.instances[instances[i].Metadata.Id] = &instances[i]
+		}
+	}
+}
+
+// listInstances lists the organization's instances, only those of projectID
+// unless it is empty.
+func (c *Client) listInstances(ctx context.Context, projectID string) (computeapi.InstancesRead, error) {
+	params := &computeapi.GetApiV2InstancesParams{
+		OrganizationID: &computeapi.OrganizationIDQueryParameter{c.OrganizationID},
+	}
+
+	if projectID != "" {
+		params.ProjectID = &computeapi.ProjectIDQueryParameter{projectID}
+	}
+
+	response, err := c.Compute.GetApiV2Instances(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return ReadJSONResponseValue[computeapi.InstancesRead](response)
+}
+
+func (c *Client) getInstance(ctx context.Context, id string) (*computeapi.InstanceRead, error) {
+	response, err := c.Compute.GetApiV2InstancesInstanceID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return ReadJSONResponsePointer[computeapi.InstanceRead](response)
+}
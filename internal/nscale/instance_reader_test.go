@@ -0,0 +1,295 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+)
+
+// fakeInstanceAPI serves the two instance reads from a fixed set of instances
+// and counts the calls made to each.
+type fakeInstanceAPI struct {
+	instances []computeapi.InstanceRead
+	listErr   bool
+	lists     atomic.Int32
+	gets      atomic.Int32
+
+	// listProjectIDs records the projectID filter of each list.
+	listProjectIDs []computeapi.ProjectIDQueryParameter
+
+	// held, when set, is closed once the first get starts, which then blocks
+	// until release is closed.
+	held    chan struct{}
+	release chan struct{}
+}
+
+func jsonResponse(t *testing.T, status int, body any) *http.Response {
+	t.Helper()
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("failed to encode response: %v", err)
+	}
+
+	return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(data))}
+}
+
+func (f *fakeInstanceAPI) client(t *testing.T) *Client {
+	t.Helper()
+
+	return &Client{OrganizationID: "org", Compute: &fakeInstanceClient{fake: f, t: t}}
+}
+
+type fakeInstanceClient struct {
+	computeapi.ClientInterface
+
+	fake *fakeInstanceAPI
+	t    *testing.T
+}
+
+func (c *fakeInstanceClient) GetApiV2Instances(
+	_ context.Context,
+	params *computeapi.GetApiV2InstancesParams,
+	_ ...computeapi.RequestEditorFn,
+) (*http.Response, error) {
+	c.fake.lists.Add(1)
+
+	var projectIDs computeapi.ProjectIDQueryParameter
+	if params.ProjectID != nil {
+		projectIDs = *params.ProjectID
+	}
+	c.fake.listProjectIDs = append(c.fake.listProjectIDs, projectIDs)
+
+	if c.fake.listErr {
+		return jsonResponse(c.t, http.StatusForbidden, errorResponse{Error: "forbidden"}), nil
+	}
+	return jsonResponse(c.t, http.StatusOK, c.fake.instances), nil
+}
+
+func (c *fakeInstanceClient) GetApiV2InstancesInstanceID(
+	_ context.Context,
+	id string,
+	_ ...computeapi.RequestEditorFn,
+) (*http.Response, error) {
+	if c.fake.gets.Add(1) == 1 && c.fake.held != nil {
+		close(c.fake.held)
+		<-c.fake.release
+	}
+
+	for _, instance := range c.fake.instances {
+		if instance.Metadata.Id == id {
+			return jsonResponse(c.t, http.StatusOK, instance), nil
+		}
+	}
+	return jsonResponse(c.t, http.StatusNotFound, errorResponse{Error: "not_found"}), nil
+}
+
+func testInstances(count int) []computeapi.InstanceRead {
+	instances := make([]computeapi.InstanceRead, count)
+	for i := range instances {
+		instances[i].Metadata.Id = fmt.Sprintf("instance-%d", i)
+	}
+	return instances
+}
+
+// readConcurrently reads the given instances in parallel with ctx, and returns
+// the error for each ID.
+func readConcurrently(ctx context.Context, client *Client, ids []string) map[string]error {
+	var (
+		mutex  sync.Mutex
+		wg     sync.WaitGroup
+		errors = map[string]error{}
+	)
+
+	for _, id := range ids {
+		wg.Go(func() {
+			instance, err := client.GetInstance(ctx, id)
+			if err == nil && instance.Metadata.Id != id {
+				err = fmt.Errorf("read instance %s, want %s", instance.Metadata.Id, id)
+			}
+
+			mutex.Lock()
+			errors[id] = err
+			mutex.Unlock()
+		})
+	}
+
+	wg.Wait()
+
+	return errors
+}
+
+// readBehindInFlightRead reads the given instances in parallel, as Terraform
+// does when refreshing in the client's default project, while a read of
+// instance-0 is in flight, and returns the error for each ID.
+func readBehindInFlightRead(t *testing.T, fake *fakeInstanceAPI, client *Client, ids []string) map[string]error {
+	t.Helper()
+
+	fake.held = make(chan struct{})
+	fake.release = make(chan struct{})
+
+	ctx := withBatchedReads(client.WithProjectID(context.Background(), ""))
+
+	done := make(chan error)
+	go func() {
+		_, err := client.GetInstance(ctx, "instance-0")
+		done <- err
+	}()
+
+	<-fake.held
+	errors := readConcurrently(ctx, client, ids)
+	close(fake.release)
+
+	if err := <-done; err != nil {
+		t.Fatalf("GetInstance(instance-0) error = %v", err)
+	}
+
+	return errors
+}
+
+func TestGetInstanceCoalescesConcurrentReads(t *testing.T) {
+	fake := &fakeInstanceAPI{instances: testInstances(20)}
+	client := fake.client(t)
+
+	ids := make([]string, 0, len(fake.instances)-1)
+	for _, instance := range fake.instances[1:] {
+		ids = append(ids, instance.Metadata.Id)
+	}
+
+	for id, err := range readBehindInFlightRead(t, fake, client, ids) {
+		if err != nil {
+			t.Fatalf("GetInstance(%s) error = %v", id, err)
+		}
+	}
+
+	if lists, gets := fake.lists.Load(), fake.gets.Load(); lists != 1 || gets != 1 {
+		t.Fatalf("made %d list and %d get calls, want 1 list and 1 get", lists, gets)
+	}
+}
+
+func TestGetInstanceListsTheProject(t *testing.T) {
+	testCases := []struct {
+		name      string
+		projectID string
+		want      []string
+	}{
+		{name: "project scoped", projectID: "project", want: []string{"project"}},
+		{name: "organization wide", want: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fake := &fakeInstanceAPI{instances: testInstances(3)}
+			client := fake.client(t)
+			client.ProjectID = testCase.projectID
+
+			for id, err := range readBehindInFlightRead(t, fake, client, []string{"instance-1", "instance-2"}) {
+				if err != nil {
+					t.Fatalf("GetInstance(%s) error = %v", id, err)
+				}
+			}
+
+			if len(fake.listProjectIDs) != 1 || !slices.Equal(fake.listProjectIDs[0], testCase.want) {
+				t.Fatalf("listed with projectID filters %v, want a single list with %v", fake.listProjectIDs, testCase.want)
+			}
+		})
+	}
+}
+
+func TestGetInstanceSkipsBatching(t *testing.T) {
+	t.Run("single read", func(t *testing.T) {
+		fake := &fakeInstanceAPI{instances: testInstances(1)}
+		ctx := withBatchedReads(context.Background())
+
+		start := time.Now()
+		if _, err := fake.client(t).GetInstance(ctx, "instance-0"); err != nil {
+			t.Fatalf("GetInstance() error = %v", err)
+		}
+
+		if elapsed := time.Since(start); elapsed >= instanceBatchWindow {
+			t.Fatalf("GetInstance() took %v, want it not to wait for a batch", elapsed)
+		}
+
+		if lists, gets := fake.lists.Load(), fake.gets.Load(); lists != 0 || gets != 1 {
+			t.Fatalf("made %d list and %d get calls, want a single get", lists, gets)
+		}
+	})
+
+	t.Run("reads outside a Terraform read", func(t *testing.T) {
+		fake := &fakeInstanceAPI{instances: testInstances(5)}
+		ids := []string{"instance-0", "instance-1", "instance-2", "instance-3", "instance-4"}
+
+		for id, err := range readConcurrently(context.Background(), fake.client(t), ids) {
+			if err != nil {
+				t.Fatalf("GetInstance(%s) error = %v", id, err)
+			}
+		}
+
+		if lists, gets := fake.lists.Load(), fake.gets.Load(); lists != 0 || gets != 5 {
+			t.Fatalf("made %d list and %d get calls, want 5 gets", lists, gets)
+		}
+	})
+}
+
+func TestGetInstanceFallsBackToGet(t *testing.T) {
+	t.Run("instance missing from the list", func(t *testing.T) {
+		fake := &fakeInstanceAPI{instances: testInstances(2)}
+		client := fake.client(t)
+
+		errors := readBehindInFlightRead(t, fake, client, []string{"instance-1", "missing"})
+
+		if err := errors["instance-1"]; err != nil {
+			t.Fatalf("GetInstance(instance-1) error = %v", err)
+		}
+
+		if apiErr, ok := AsAPIError(errors["missing"]); !ok || apiErr.StatusCode != http.StatusNotFound {
+			t.Fatalf("GetInstance(missing) error = %v, want a not found API error", errors["missing"])
+		}
+
+		if lists, gets := fake.lists.Load(), fake.gets.Load(); lists != 1 || gets != 2 {
+			t.Fatalf("made %d list and %d get calls, want 1 list and 2 gets", lists, gets)
+		}
+	})
+
+	t.Run("list fails", func(t *testing.T) {
+		fake := &fakeInstanceAPI{instances: testInstances(3), listErr: true}
+
+		client := fake.client(t)
+
+		for id, err := range readBehindInFlightRead(t, fake, client, []string{"instance-1", "instance-2"}) {
+			if err != nil {
+				t.Fatalf("GetInstance(%s) error = %v", id, err)
+			}
+		}
+
+		if gets := fake.gets.Load(); gets != 3 {
+			t.Fatalf("made %d get calls, want 3", gets)
+		}
+	})
+}
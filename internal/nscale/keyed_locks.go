@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import "sync"
+
+// KeyedLocks serializes work on the same object between the resources of a
+// single Terraform run, such as the read-modify-write of an object by the
+// resources that each manage a part of it. Those resources do not depend on
+// each other, so Terraform applies them in parallel, and the API has no
+// conditional write that would let a lost update be detected. The zero value
+// is ready to use.
+type KeyedLocks struct {
+	mutex sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// Lock blocks until the caller holds the lock of key and returns the function
+// that releases it.
+func (l *KeyedLocks) Lock(key string) func() {
+	l.mutex.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*sync.Mutex{}
+	}
+	lock, ok := l.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[key] = lock
+	}
+	l.mutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// TestMain shortens the state and dependency watchers' poll schedules so that watcher tests
+// needing several polls finish well inside their own short timeouts, and widens
+// the instance batch window so that concurrent test reads reliably share a
+// batch on a loaded machine.
+func TestMain(m *testing.M) {
+	stateWatcherPollBackoff = pollBackoff{Initial: 10 * time.Millisecond, Max: 40 * time.Millisecond}
+	dependencyPollBackoff = stateWatcherPollBackoff
+	instanceBatchWindow = 200 * time.Millisecond
+
+	os.Exit(m.Run())
+}
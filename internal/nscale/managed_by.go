@@ -0,0 +1,74 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import coreapi "github.com/nscaledev/nscale-sdk-go/common"
+
+const (
+	// ManagedByTerraform is the manager of a resource the provider created or
+	// last updated.
+	ManagedByTerraform = "terraform"
+
+	// ManagedByAPI is the manager of a resource that carries no marker, such
+	// as one created by a script calling the API directly.
+	ManagedByAPI = "api"
+
+	// ManagedByTagName is the tag marking a resource as managed by Terraform.
+	// Its reserved prefix keeps it out of the tags attribute, like the
+	// operation tags.
+	ManagedByTagName = TerraformOperationTagPrefix + "managed-by"
+
+	// ManagedByToolTagName is the tag through which the console and other
+	// tools may name themselves as a resource's manager.
+	ManagedByToolTagName = "managed-by"
+)
+
+// WriteManagedByTag marks the resource being written as managed by Terraform.
+// Every create and update writes it, as an update replaces all of the
+// resource's tags.
+func WriteManagedByTag(metadata *coreapi.ResourceWriteMetadata) {
+	if metadata.Tags == nil {
+		var tags []coreapi.Tag
+		metadata.Tags = &tags
+	}
+
+	*metadata.Tags = append(*metadata.Tags, coreapi.Tag{
+		Name:  ManagedByTagName,
+		Value: ManagedByTerraform,
+	})
+}
+
+// ManagedBy returns what manages a resource with tags: ManagedByTerraform when
+// the provider last wrote it, otherwise the value of a ManagedByToolTagName tag
+// such as "console", and ManagedByAPI when there is neither.
+func ManagedBy(tags *coreapi.TagList) string {
+	if tags == nil {
+		return ManagedByAPI
+	}
+
+	managedBy := ManagedByAPI
+	for _, tag := range *tags {
+		switch {
+		case tag.Name == ManagedByTagName:
+			return ManagedByTerraform
+		case tag.Name == ManagedByToolTagName && tag.Value != "":
+			managedBy = tag.Value
+		}
+	}
+
+	return managedBy
+}
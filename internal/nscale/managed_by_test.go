@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"testing"
+
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+)
+
+func TestManagedBy(t *testing.T) {
+	var written coreapi.ResourceWriteMetadata
+	WriteManagedByTag(&written)
+
+	testCases := []struct {
+		name string
+		tags *coreapi.TagList
+		want string
+	}{
+		{name: "no tags", want: ManagedByAPI},
+		{name: "written by terraform", tags: written.Tags, want: ManagedByTerraform},
+		{
+			name: "named by a tool",
+			tags: &coreapi.TagList{{Name: ManagedByToolTagName, Value: "console"}},
+			want: "console",
+		},
+		{
+			name: "terraform wins over a tool",
+			tags: &coreapi.TagList{{Name: ManagedByToolTagName, Value: "console"}, (*written.Tags)[0]},
+			want: ManagedByTerraform,
+		},
+		{
+			name: "marker is hidden from the tags attribute",
+			tags: RemoveOperationTags(written.Tags),
+			want: ManagedByAPI,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := ManagedBy(testCase.tags); got != testCase.want {
+				t.Fatalf("ManagedBy() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/pointer"
+)
+
+const (
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	idTokenType            = "urn:ietf:params:oauth:token-type:id_token"
+
+	// oidcRefreshMargin is how long before it expires an exchanged token is
+	// replaced, so a request never starts with a token about to expire.
+	oidcRefreshMargin = time.Minute
+)
+
+// OIDCCredentials describe where to get the OIDC identity token a CI platform
+// issues to a pipeline, which is exchanged with the identity service for an
+// API token so the pipeline needs no stored service token. Exactly one of
+// TokenFile and RequestURL is used, TokenFile first.
+type OIDCCredentials struct {
+	// TokenFile is a file holding the identity token. It is read again every
+	// time the token is exchanged, so a platform may rotate it.
+	TokenFile string
+
+	// RequestURL and RequestToken request the identity token from an endpoint
+	// such as GitHub Actions' ACTIONS_ID_TOKEN_REQUEST_URL.
+	RequestURL   string
+	RequestToken string
+
+	// Audience, when set, is the audience requested for the identity token.
+	Audience string
+}
+
+// oidcTokenSource exchanges the pipeline's identity token for an API token,
+// and exchanges it again shortly before that token expires.
+type oidcTokenSource struct {
+	credentials    OIDCCredentials
+	organizationID string
+	identity       identityapi.ClientInterface
+	httpClient     *http.Client
+
+	mutex       sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+// oidcTokenSources shares token sources between the provider instances of a
+// process, such as the aliases of one configuration, so that they exchange one
+// identity token between them rather than one each.
+//
+//nolint:gochecknoglobals // shared by every provider instance in the process.
+var oidcTokenSources = oidcTokenSourceCache{sources: map[oidcTokenSourceKey]*oidcTokenSource{}}
+
+// oidcTokenSourceKey identifies the API tokens a source exchanges for: those
+// of one identity service and organization, for one pipeline identity.
+type oidcTokenSourceKey struct {
+	credentials            OIDCCredentials
+	identityServiceBaseURL string
+	organizationID         string
+}
+
+type oidcTokenSourceCache struct {
+	mutex   sync.Mutex
+	sources map[oidcTokenSourceKey]*oidcTokenSource
+}
+
+// get returns the token source for the credentials, identity service and
+// organization, creating it the first time they are asked for.
+func (c *oidcTokenSourceCache) get(
+	credentials OIDCCredentials,
+	identityServiceBaseURL, organizationID, userAgent string,
+) (*oidcTokenSource, error) {
+	key := oidcTokenSourceKey{
+		credentials:            credentials,
+		identityServiceBaseURL: identityServiceBaseURL,
+		organizationID:         organizationID,
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if source, ok := c.sources[key]; ok {
+		return source, nil
+	}
+
+	source, err := newOIDCTokenSource(credentials, identityServiceBaseURL, organizationID, userAgent)
+	if err != nil {
+		return nil, err
+	}
+	c.sources[key] = source
+
+	return source, nil
+}
+
+func newOIDCTokenSource(
+	credentials OIDCCredentials,
+	identityServiceBaseURL, organizationID, userAgent string,
+) (*oidcTokenSource, error) {
+	retryableHTTPClient := retryablehttp.NewClient()
+	retryableHTTPClient.CheckRetry = retryPolicy
+	httpClient := retryableHTTPClient.StandardClient()
+
+	// The exchange authenticates with the identity token in its body, so it
+	// must not go through the HTTPClient it provides the token for.
+	identity, err := identityapi.NewClient(
+		identityServiceBaseURL,
+		identityapi.WithHTTPClient(httpClient),
+		identityapi.WithRequestEditorFn(func(_ context.Context, r *http.Request) error {
+			r.Header.Set("User-Agent", userAgent)
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Nscale identity API client: %w", err)
+	}
+
+	return &oidcTokenSource{
+		credentials:    credentials,
+		organizationID: organizationID,
+		identity:       identity,
+		httpClient:     httpClient,
+	}, nil
+}
+
+// token returns an API token, exchanging a new identity token for it when
+// there is none yet or the current one is about to expire.
+func (s *oidcTokenSource) token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.accessToken != "" && (s.expiry.IsZero() || time.Now().Before(s.expiry.Add(-oidcRefreshMargin))) {
+		return s.accessToken, nil
+	}
+
+	idToken, err := s.identityToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the OIDC identity token: %w", err)
+	}
+
+	token, err := s.exchange(ctx, idToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange the OIDC identity token: %w", err)
+	}
+
+	s.accessToken = token.AccessToken
+	s.expiry = time.Time{}
+	if token.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return s.accessToken, nil
+}
+
+func (s *oidcTokenSource) identityToken(ctx context.Context) (string, error) {
+	if s.credentials.TokenFile != "" {
+		data, err := os.ReadFile(s.credentials.TokenFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	requestURL, err := url.Parse(s.credentials.RequestURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid request URL: %w", err)
+	}
+
+	if s.credentials.Audience != "" {
+		query := requestURL.Query()
+		query.Set("audience", s.credentials.Audience)
+		requestURL.RawQuery = query.Encode()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Authorization", "Bearer "+s.credentials.RequestToken)
+
+	//nolint:gosec // the request URL is the CI platform's token endpoint, configured by the pipeline.
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("the token request returned %s", response.Status)
+	}
+
+	var body struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode the token response: %w", err)
+	}
+
+	if body.Value == "" {
+		return "", errors.New("the token response has no token")
+	}
+
+	return body.Value, nil
+}
+
+func (s *oidcTokenSource) exchange(ctx context.Context, idToken string) (*identityapi.Token, error) {
+	options := identityapi.TokenRequestOptions{
+		GrantType:        tokenExchangeGrantType,
+		SubjectToken:     &idToken,
+		SubjectTokenType: pointer.Reference(idTokenType),
+	}
+	if s.organizationID != "" {
+		options.XOrganizationId = &s.organizationID
+	}
+
+	response, err := s.identity.PostOauth2V2TokenWithFormdataBody(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return ReadJSONResponsePointer[identityapi.Token](response)
+}
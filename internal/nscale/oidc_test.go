@@ -0,0 +1,198 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeOIDCServer serves both the CI platform's identity token endpoint, at
+// /id-token, and the identity service's token exchange, and records the
+// requests they receive.
+type fakeOIDCServer struct {
+	*httptest.Server
+
+	expiresIn  int
+	audience   string
+	exchanges  int
+	subjectIDs []string
+}
+
+func newFakeOIDCServer(t *testing.T, expiresIn int) *fakeOIDCServer {
+	t.Helper()
+
+	fake := &fakeOIDCServer{expiresIn: expiresIn}
+	fake.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/id-token":
+			if r.Header.Get("Authorization") != "Bearer request-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fake.audience = r.URL.Query().Get("audience")
+			_ = json.NewEncoder(w).Encode(map[string]string{"value": "requested-id-token"})
+		case "/oauth2/v2/token":
+			if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != tokenExchangeGrantType {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fake.exchanges++
+			fake.subjectIDs = append(fake.subjectIDs, r.Form.Get("subject_token"))
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"access_token": "api-token",
+				"token_type":   "Bearer",
+				"expires_in":   fake.expiresIn,
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+func TestOIDCTokenSource(t *testing.T) {
+	t.Run("token file", func(t *testing.T) {
+		fake := newFakeOIDCServer(t, 3600)
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		if err := os.WriteFile(tokenFile, []byte("file-id-token\n"), 0o600); err != nil {
+			t.Fatalf("failed to write token file: %v", err)
+		}
+
+		source, err := newOIDCTokenSource(OIDCCredentials{TokenFile: tokenFile}, fake.URL, "organization", "test")
+		if err != nil {
+			t.Fatalf("newOIDCTokenSource() error = %v", err)
+		}
+
+		for range 2 {
+			token, err := source.token(context.Background())
+			if err != nil {
+				t.Fatalf("token() error = %v", err)
+			}
+			if token != "api-token" {
+				t.Fatalf("token() = %q, want api-token", token)
+			}
+		}
+
+		if fake.exchanges != 1 || fake.subjectIDs[0] != "file-id-token" {
+			t.Fatalf("made %d exchanges of %v, want one of file-id-token", fake.exchanges, fake.subjectIDs)
+		}
+	})
+
+	t.Run("request URL", func(t *testing.T) {
+		fake := newFakeOIDCServer(t, 3600)
+
+		source, err := newOIDCTokenSource(OIDCCredentials{
+			RequestURL:   fake.URL + "/id-token?api-version=2.0",
+			RequestToken: "request-token",
+			Audience:     "nscale",
+		}, fake.URL, "organization", "test")
+		if err != nil {
+			t.Fatalf("newOIDCTokenSource() error = %v", err)
+		}
+
+		if _, err := source.token(context.Background()); err != nil {
+			t.Fatalf("token() error = %v", err)
+		}
+
+		if fake.audience != "nscale" || fake.subjectIDs[0] != "requested-id-token" {
+			t.Fatalf("requested audience %q and exchanged %v", fake.audience, fake.subjectIDs)
+		}
+	})
+
+	t.Run("refreshes before expiry", func(t *testing.T) {
+		fake := newFakeOIDCServer(t, 30)
+
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		if err := os.WriteFile(tokenFile, []byte("file-id-token"), 0o600); err != nil {
+			t.Fatalf("failed to write token file: %v", err)
+		}
+
+		source, err := newOIDCTokenSource(OIDCCredentials{TokenFile: tokenFile}, fake.URL, "organization", "test")
+		if err != nil {
+			t.Fatalf("newOIDCTokenSource() error = %v", err)
+		}
+
+		for range 2 {
+			if _, err := source.token(context.Background()); err != nil {
+				t.Fatalf("token() error = %v", err)
+			}
+		}
+
+		// A token that expires within oidcRefreshMargin is never reused.
+		if fake.exchanges != 2 {
+			t.Fatalf("made %d exchanges, want 2 for a token expiring within %s", fake.exchanges, oidcRefreshMargin)
+		}
+
+		if source.expiry.Before(time.Now()) {
+			t.Fatalf("expiry %s is in the past", source.expiry)
+		}
+	})
+}
+
+func TestOIDCTokenSourcesAreShared(t *testing.T) {
+	fake := newFakeOIDCServer(t, 3600)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-id-token"), 0o600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+
+	cache := oidcTokenSourceCache{sources: map[oidcTokenSourceKey]*oidcTokenSource{}}
+	credentials := OIDCCredentials{TokenFile: tokenFile}
+
+	// Two provider aliases configured alike share one exchanged token.
+	for range 2 {
+		source, err := cache.get(credentials, fake.URL, "organization", "test")
+		if err != nil {
+			t.Fatalf("get() error = %v", err)
+		}
+
+		if _, err := source.token(context.Background()); err != nil {
+			t.Fatalf("token() error = %v", err)
+		}
+	}
+
+	if fake.exchanges != 1 {
+		t.Fatalf("made %d exchanges, want 1 shared between the sources", fake.exchanges)
+	}
+
+	// Another organization is issued its own tokens.
+	source, err := cache.get(credentials, fake.URL, "other-organization", "test")
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+
+	if _, err := source.token(context.Background()); err != nil {
+		t.Fatalf("token() error = %v", err)
+	}
+
+	if fake.exchanges != 2 {
+		t.Fatalf("made %d exchanges, want a second for another organization", fake.exchanges)
+	}
+}
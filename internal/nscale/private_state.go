@@ -0,0 +1,50 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// PrivateState is the private state of a resource, as carried by the
+// framework's requests and responses, which the provider keeps alongside the
+// state but never shows to the user.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// privateFlagValue is stored for a set flag; private state values must be
+// JSON.
+const privateFlagValue = "true"
+
+// SetPrivateFlag sets or clears the flag key in private.
+func SetPrivateFlag(ctx context.Context, private PrivateState, key string, set bool) diag.Diagnostics {
+	if !set {
+		return private.SetKey(ctx, key, nil)
+	}
+
+	return private.SetKey(ctx, key, []byte(privateFlagValue))
+}
+
+// PrivateFlag reports whether the flag key is set in private.
+func PrivateFlag(ctx context.Context, private PrivateState, key string) (bool, diag.Diagnostics) {
+	value, diagnostics := private.GetKey(ctx, key)
+	return string(value) == privateFlagValue, diagnostics
+}
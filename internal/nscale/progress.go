@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// stateWatcherSubsystem is the log subsystem the state watchers report
+	// their progress under. Its level is set on its own by
+	// TF_LOG_PROVIDER_NSCALE_STATE_WATCHER, for example to keep them out of a
+	// debug log of something else.
+	stateWatcherSubsystem = "state_watcher"
+
+	// stateWatcherProgressInterval is how often a watcher reports a status that
+	// has not changed.
+	stateWatcherProgressInterval = 5 * time.Minute
+)
+
+// progressReporter logs what a state watcher is waiting on: each status it
+// sees first, then the unchanged status every stateWatcherProgressInterval,
+// with the time elapsed since the wait began. Terraform itself only reports
+// that the operation is still running.
+type progressReporter struct {
+	ctx           context.Context
+	resourceTitle string
+	action        string
+	now           func() time.Time
+
+	start      time.Time
+	lastReport time.Time
+	lastStatus string
+}
+
+// newProgressReporter starts the clock for a watcher that is action, such as
+// "creating", the resource.
+func newProgressReporter(ctx context.Context, resourceTitle, action string) *progressReporter {
+	ctx = tflog.NewSubsystem(ctx, stateWatcherSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_NSCALE", "STATE_WATCHER"),
+		tflog.WithRootFields(),
+	)
+
+	return &progressReporter{
+		ctx:           ctx,
+		resourceTitle: resourceTitle,
+		action:        action,
+		now:           time.Now,
+		start:         time.Now(),
+	}
+}
+
+// observe records the status of the latest poll.
+func (p *progressReporter) observe(id string, status string) {
+	now := p.now()
+	if status == p.lastStatus && now.Sub(p.lastReport) < stateWatcherProgressInterval {
+		return
+	}
+
+	p.lastStatus = status
+	p.lastReport = now
+
+	elapsed := now.Sub(p.start).Round(time.Second)
+
+	tflog.SubsystemInfo(
+		p.ctx,
+		stateWatcherSubsystem,
+		fmt.Sprintf("%s is still %s (elapsed %s, status %s)", p.resourceTitle, p.action, elapsed, status),
+		map[string]any{
+			"id":      id,
+			"status":  status,
+			"elapsed": elapsed.String(),
+		},
+	)
+}
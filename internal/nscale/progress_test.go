@@ -0,0 +1,80 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestProgressReporter(t *testing.T) {
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+
+	progress := newProgressReporter(ctx, "Instance", "creating")
+	progress.start = start
+	progress.now = func() time.Time { return now }
+
+	for _, poll := range []struct {
+		after  time.Duration
+		status string
+	}{
+		{after: 0, status: "unknown"},
+		{after: 5 * time.Second, status: "provisioning"},
+		{after: time.Minute, status: "provisioning"},
+		{after: 12 * time.Minute, status: "provisioning"},
+		{after: 13 * time.Minute, status: "provisioning"},
+		{after: 14 * time.Minute, status: "provisioned"},
+	} {
+		now = start.Add(poll.after)
+		progress.observe("instance-id", poll.status)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode log output: %v", err)
+	}
+
+	want := []string{
+		"Instance is still creating (elapsed 0s, status unknown)",
+		"Instance is still creating (elapsed 5s, status provisioning)",
+		"Instance is still creating (elapsed 12m0s, status provisioning)",
+		"Instance is still creating (elapsed 14m0s, status provisioned)",
+	}
+
+	if len(entries) != len(want) {
+		t.Fatalf("logged %d entries, want %d: %v", len(entries), len(want), entries)
+	}
+
+	for i, entry := range entries {
+		if entry["@message"] != want[i] {
+			t.Errorf("entry %d message = %q, want %q", i, entry["@message"], want[i])
+		}
+
+		if entry["@module"] != "provider."+stateWatcherSubsystem {
+			t.Errorf("entry %d module = %q, want the %s subsystem", i, entry["@module"], stateWatcherSubsystem)
+		}
+	}
+}
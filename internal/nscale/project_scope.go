@@ -0,0 +1,148 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+)
+
+type projectIDContextKey struct{}
+
+// WithProjectID scopes the API requests made with the returned context to a
+// project, so they authenticate with the service token the provider has for
+// it in project_service_tokens, falling back to service_token. An empty
+// resourceProjectID scopes to the provider's default project, as
+// ResolveProjectID does.
+func (c *Client) WithProjectID(ctx context.Context, resourceProjectID string) context.Context {
+	projectID := resourceProjectID
+	if projectID == "" {
+		projectID = c.ProjectID
+	}
+
+	if projectID == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, projectIDContextKey{}, projectID)
+}
+
+// WithProjectIDFrom scopes ctx, as WithProjectID does, to the project_id
+// attribute read with get, which is the GetAttribute method of a request's
+// plan, state or config. Resources and data sources without a project_id
+// attribute, or whose project_id is not known yet, are scoped to the
+// provider's default project.
+func (c *Client) WithProjectIDFrom(
+	ctx context.Context,
+	get func(context.Context, path.Path, any) diag.Diagnostics,
+) context.Context {
+	var projectID types.String
+	if diagnostics := get(ctx, path.Root("project_id"), &projectID); diagnostics.HasError() {
+		return c.WithProjectID(ctx, "")
+	}
+
+	return c.WithProjectID(ctx, projectID.ValueString())
+}
+
+// ProjectIDFromContext returns the project ctx is scoped to, or an empty
+// string.
+func ProjectIDFromContext(ctx context.Context) string {
+	projectID, _ := ctx.Value(projectIDContextKey{}).(string)
+	return projectID
+}
+
+// ProjectScopes returns ctx scoped to each project the provider has a token
+// for: first the default project, as WithProjectID(ctx, "") scopes it, then
+// the other projects of project_service_tokens. Organization-wide requests
+// that must see every project the provider can reach are made once in each
+// scope.
+func (c *Client) ProjectScopes(ctx context.Context) []context.Context {
+	scopes := []context.Context{c.WithProjectID(ctx, "")}
+	for _, projectID := range c.projectIDs {
+		if projectID != c.ProjectID {
+			scopes = append(scopes, c.WithProjectID(ctx, projectID))
+		}
+	}
+
+	return scopes
+}
+
+// WithProjectIDOf scopes ctx, as WithProjectID does, to the project of an
+// existing object that has no project_id attribute of its own, such as a
+// security group, which belongs to the project of its network. lookup returns
+// the object's project, and is tried in each of ProjectScopes until one can
+// read it. Without project_service_tokens every request uses the same token,
+// so no lookup is made. When no scope can read the object, ctx is scoped to
+// the default project, and the request made with it reports the error.
+func (c *Client) WithProjectIDOf(
+	ctx context.Context,
+	lookup func(context.Context) (string, error),
+) context.Context {
+	if len(c.projectIDs) == 0 {
+		return c.WithProjectID(ctx, "")
+	}
+
+	for _, scope := range c.ProjectScopes(ctx) {
+		if projectID, err := lookup(scope); err == nil && projectID != "" {
+			return c.WithProjectID(ctx, projectID)
+		}
+	}
+
+	return c.WithProjectID(ctx, "")
+}
+
+// WithInstanceProjectID scopes ctx to the project of the instance with the
+// given ID; see WithProjectIDOf.
+func (c *Client) WithInstanceProjectID(ctx context.Context, instanceID string) context.Context {
+	return c.WithProjectIDOf(ctx, func(ctx context.Context) (string, error) {
+		instance, err := c.getInstance(ctx, instanceID)
+		if err != nil {
+			return "", err
+		}
+
+		return instance.Metadata.ProjectId, nil
+	})
+}
+
+// WithNetworkProjectID scopes ctx to the project of the network with the given
+// ID; see WithProjectIDOf.
+func (c *Client) WithNetworkProjectID(ctx context.Context, networkID string) context.Context {
+	return c.WithProjectIDOf(ctx, func(ctx context.Context) (string, error) {
+		id, err := regionids.ParseNetworkID(networkID)
+		if err != nil {
+			return "", err
+		}
+
+		response, err := c.Region.GetApiV2NetworksNetworkID(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		defer response.Body.Close()
+
+		network, err := ReadJSONResponsePointer[regionapi.NetworkV2Read](response)
+		if err != nil {
+			return "", err
+		}
+
+		return network.Metadata.ProjectId, nil
+	})
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProjectServiceTokens(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(server.Close)
+
+	httpClient := NewHTTPClient("test", Credentials{
+		ServiceToken:         "default-token",
+		ProjectServiceTokens: map[string]string{"project-a": "project-a-token"},
+	})
+
+	testCases := []struct {
+		name             string
+		defaultProjectID string
+		projectID        string
+		want             string
+	}{
+		{name: "unscoped", want: "Bearer default-token"},
+		{name: "project with a token", projectID: "project-a", want: "Bearer project-a-token"},
+		{name: "project without a token", projectID: "project-b", want: "Bearer default-token"},
+		{
+			name:             "default project with a token",
+			defaultProjectID: "project-a",
+			want:             "Bearer project-a-token",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := &Client{ProjectID: testCase.defaultProjectID}
+			ctx := client.WithProjectID(context.Background(), testCase.projectID)
+
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatalf("failed to build request: %v", err)
+			}
+
+			response, err := httpClient.Do(request)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			response.Body.Close()
+
+			if authorization != testCase.want {
+				t.Fatalf("Authorization = %q, want %q", authorization, testCase.want)
+			}
+		})
+	}
+}
+
+func TestWithProjectIDOf(t *testing.T) {
+	// lookup finds the object only with the token of project-b.
+	lookup := func(ctx context.Context) (string, error) {
+		if ProjectIDFromContext(ctx) != "project-b" {
+			return "", errors.New("not found")
+		}
+
+		return "project-b", nil
+	}
+
+	testCases := []struct {
+		name       string
+		projectIDs []string
+		lookup     func(context.Context) (string, error)
+		want       string
+	}{
+		{name: "without project tokens", lookup: lookup, want: "default"},
+		{name: "found in a project scope", projectIDs: []string{"project-a", "project-b"}, lookup: lookup, want: "project-b"},
+		{
+			name:       "not found",
+			projectIDs: []string{"project-a"},
+			lookup:     lookup,
+			want:       "default",
+		},
+		{
+			name:       "found in the default scope",
+			projectIDs: []string{"project-a"},
+			lookup:     func(context.Context) (string, error) { return "project-a", nil },
+			want:       "project-a",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := &Client{ProjectID: "default", projectIDs: testCase.projectIDs}
+			ctx := client.WithProjectIDOf(context.Background(), testCase.lookup)
+
+			if got := ProjectIDFromContext(ctx); got != testCase.want {
+				t.Fatalf("project = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
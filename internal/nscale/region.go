@@ -0,0 +1,63 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	identityids "github.com/unikorn-cloud/identity/pkg/ids"
+)
+
+// ErrRegionNotFound is returned by ValidateRegion when the organization has no
+// region with the configured ID.
+var ErrRegionNotFound = errors.New("region not found")
+
+// ValidateRegion checks that RegionID names one of the regions available to the
+// organization. A misconfigured region otherwise only surfaces as a not found
+// error from the first resource that is created in it.
+func (c *Client) ValidateRegion(ctx context.Context) error {
+	organizationID, err := identityids.ParseOrganizationID(c.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("invalid organization ID %q: %w", c.OrganizationID, err)
+	}
+
+	response, err := c.Region.GetApiV1OrganizationsOrganizationIDRegions(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	regions, err := ReadJSONResponseValue[[]regionapi.RegionRead](response)
+	if err != nil {
+		return err
+	}
+
+	available := make([]string, 0, len(regions))
+	for _, region := range regions {
+		if region.Metadata.Id == c.RegionID {
+			return nil
+		}
+		available = append(available, fmt.Sprintf("%s (%s)", region.Metadata.Id, region.Metadata.Name))
+	}
+
+	return fmt.Errorf("%w: %s is not one of the available regions: %s",
+		ErrRegionNotFound, c.RegionID, strings.Join(available, ", "))
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type regionIDContextKey struct{}
+
+// WithRegionIDFrom scopes ctx to the region_id attribute read with get, which
+// is the GetAttribute method of a request's plan, state or config, for the
+// get closures of resources the API can only read within their region.
+// Resources without a region_id attribute, or whose region_id is not known
+// yet, are scoped to the provider's default region.
+func (c *Client) WithRegionIDFrom(
+	ctx context.Context,
+	get func(context.Context, path.Path, any) diag.Diagnostics,
+) context.Context {
+	regionID := c.RegionID
+
+	var configured types.String
+	diagnostics := get(ctx, path.Root("region_id"), &configured)
+	if !diagnostics.HasError() && !configured.IsNull() && !configured.IsUnknown() {
+		regionID = configured.ValueString()
+	}
+
+	if regionID == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, regionIDContextKey{}, regionID)
+}
+
+// RegionIDFromContext returns the region ctx is scoped to, or an empty string.
+func RegionIDFromContext(ctx context.Context) string {
+	regionID, _ := ctx.Value(regionIDContextKey{}).(string)
+	return regionID
+}
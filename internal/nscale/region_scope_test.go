@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithRegionIDFrom(t *testing.T) {
+	testCases := []struct {
+		name            string
+		defaultRegionID string
+		regionID        types.String
+		noAttribute     bool
+		want            string
+	}{
+		{name: "configured", defaultRegionID: "default", regionID: types.StringValue("region"), want: "region"},
+		{name: "null", defaultRegionID: "default", regionID: types.StringNull(), want: "default"},
+		{name: "unknown", defaultRegionID: "default", regionID: types.StringUnknown(), want: "default"},
+		{name: "no attribute", defaultRegionID: "default", noAttribute: true, want: "default"},
+		{name: "no region", regionID: types.StringNull()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			get := func(_ context.Context, _ path.Path, target any) diag.Diagnostics {
+				var diagnostics diag.Diagnostics
+				if testCase.noAttribute {
+					diagnostics.AddError("Invalid Attribute Path", "region_id is not in the schema")
+					return diagnostics
+				}
+
+				if regionID, ok := target.(*types.String); ok {
+					*regionID = testCase.regionID
+				}
+				return diagnostics
+			}
+
+			client := &Client{RegionID: testCase.defaultRegionID}
+			ctx := client.WithRegionIDFrom(context.Background(), get)
+
+			if got := RegionIDFromContext(ctx); got != testCase.want {
+				t.Fatalf("RegionIDFromContext() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
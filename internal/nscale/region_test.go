@@ -0,0 +1,69 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+type fakeRegionClient struct {
+	regionapi.ClientInterface
+
+	regions []regionapi.RegionRead
+	t       *testing.T
+}
+
+func (c *fakeRegionClient) GetApiV1OrganizationsOrganizationIDRegions(
+	_ context.Context,
+	_ regionapi.OrganizationIDParameter,
+	_ ...regionapi.RequestEditorFn,
+) (*http.Response, error) {
+	return jsonResponse(c.t, http.StatusOK, c.regions), nil
+}
+
+func TestValidateRegion(t *testing.T) {
+	regions := make([]regionapi.RegionRead, 2)
+	regions[0].Metadata.Id, regions[0].Metadata.Name = "region-a", "eu-west"
+	regions[1].Metadata.Id, regions[1].Metadata.Name = "region-b", "us-east"
+
+	newClient := func(regionID string) *Client {
+		return &Client{
+			OrganizationID: "00000000-0000-0000-0000-000000000000",
+			RegionID:       regionID,
+			Region:         &fakeRegionClient{regions: regions, t: t},
+		}
+	}
+
+	if err := newClient("region-b").ValidateRegion(context.Background()); err != nil {
+		t.Fatalf("ValidateRegion() error = %v, want nil", err)
+	}
+
+	err := newClient("region-c").ValidateRegion(context.Background())
+	if !errors.Is(err, ErrRegionNotFound) {
+		t.Fatalf("ValidateRegion() error = %v, want ErrRegionNotFound", err)
+	}
+
+	if !strings.Contains(err.Error(), "region-a (eu-west), region-b (us-east)") {
+		t.Fatalf("ValidateRegion() error = %q, want the available regions listed", err)
+	}
+}
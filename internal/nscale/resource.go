@@ -19,12 +19,16 @@ package nscale
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"slices"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 )
 
 // ResourceAdapter captures everything that varies between resources, so the
@@ -47,13 +51,38 @@ type ResourceAdapter[TFModel any, APIRead any] struct {
 	// (used for the id and the first state write before the create wait).
 	Create func(ctx context.Context, client *Client, plan TFModel) (*APIRead, diag.Diagnostics)
 
+	// Adopt, when set, looks for an existing object the plan should take over
+	// instead of creating a new one, returning nil when there is none (or the
+	// plan does not ask for adoption). The base applies the plan to a match via
+	// Update, so Adopt is ignored on immutable resources.
+	Adopt func(ctx context.Context, client *Client, plan TFModel) (*APIRead, diag.Diagnostics)
+
 	// Get reads one object by id, already adapted to ResourceStatus.
 	Get func(ctx context.Context, client *Client, id string) (*APIRead, ResourceStatus, error)
 
 	// Update issues the update call (writing an operation tag into its params)
-	// and returns the tag key the update watcher waits for. A nil Update marks
-	// the resource immutable.
-	Update func(ctx context.Context, client *Client, id string, plan TFModel) (operationTagKey string, diags diag.Diagnostics)
+	// and returns the tag key the update watcher waits for. prior is the state
+	// the plan was made against, or nil when adopting an object that has none.
+	// A nil Update marks the resource immutable.
+	Update func(
+		ctx context.Context,
+		client *Client,
+		id string,
+		prior *TFModel,
+		plan TFModel,
+	) (operationTagKey string, diags diag.Diagnostics)
+
+	// Settled, when set, reports whether an update has fully taken effect on
+	// the object read back, for changes the API makes some time after the write
+	// is observed, such as attaching a public IP. The update watcher keeps
+	// waiting until it has.
+	Settled func(api *APIRead, plan TFModel) bool
+
+	// MetadataOnly, when set, reports whether plan only changes the metadata of
+	// prior, such as its name, description or tags, which the API applies
+	// without reprovisioning anything. The update watcher then only waits
+	// metadataUpdateTimeout for the write to be observed, and not for Settled.
+	MetadataOnly func(prior, plan TFModel) bool
 
 	// Delete issues the delete call. The base owns the delete-poll watcher and
 	// tolerates a 404 (already gone).
@@ -63,10 +92,50 @@ type ResourceAdapter[TFModel any, APIRead any] struct {
 	// own (notably dst's timeouts) intact.
 	ToModel func(api *APIRead, dst *TFModel)
 
+	// Enrich, when set, fills in the attributes of dst read from endpoints
+	// other than Get's, after ToModel has mapped a settled object into it. It
+	// should leave an attribute as it is and warn when it cannot be read, so a
+	// transient failure does not change the state.
+	Enrich func(ctx context.Context, client *Client, dst *TFModel) diag.Diagnostics
+
+	// Refreshed, when set, is called by Read after the object has been mapped
+	// into dst, with prior, the state the refresh started from. It updates the
+	// attributes that record what a refresh observes, which an apply must not
+	// change because they are planned as they are in state.
+	Refreshed func(prior TFModel, dst *TFModel)
+
 	// IDFromModel and TimeoutsFromModel let the base read the id and timeouts off
 	// the model without knowing its concrete type.
 	IDFromModel       func(m TFModel) string
 	TimeoutsFromModel func(m TFModel) tftimeouts.Value
+
+	// Tagged marks a resource with a top-level tags attribute, which the base
+	// checks against the provider's required_tags at plan time.
+	Tagged bool
+
+	// RequiredFeature, when set, is the API feature the resource's endpoints
+	// belong to; the base checks the environment provides it in Configure.
+	RequiredFeature APIFeature
+
+	// CreateOnlyAttributes are the attributes only consulted at create time,
+	// such as adopt_existing. An update that changes nothing else is recorded
+	// in state without calling the API; see RecordCreateOnlyChanges.
+	CreateOnlyAttributes []string
+
+	// SensitiveAttributes are the attributes holding secrets, such as a
+	// generated SSH private key, which the base stores as null when the
+	// provider is configured with disallow_sensitive_in_state.
+	SensitiveAttributes []path.Path
+
+	// ModifyPlan, when set, checks or adjusts the plan after the base has
+	// enforced the tag policy, for resources whose configuration can only be
+	// validated against the API.
+	ModifyPlan func(
+		ctx context.Context,
+		client *Client,
+		request resource.ModifyPlanRequest,
+		response *resource.ModifyPlanResponse,
+	)
 }
 
 // GenericResource implements the resource.Resource lifecycle once, driven by a
@@ -87,7 +156,7 @@ func NewGenericResource[TFModel, APIRead any](
 }
 
 func (r *GenericResource[TFModel, APIRead]) Configure(
-	_ context.Context,
+	ctx context.Context,
 	request resource.ConfigureRequest,
 	response *resource.ConfigureResponse,
 ) {
@@ -108,6 +177,10 @@ func (r *GenericResource[TFModel, APIRead]) Configure(
 	}
 
 	r.client = client
+
+	if r.adapter.RequiredFeature != "" {
+		response.Diagnostics.Append(client.RequireFeature(ctx, r.adapter.RequiredFeature, r.adapter.Title)...)
+	}
 }
 
 func (r *GenericResource[TFModel, APIRead]) Metadata(
@@ -126,17 +199,49 @@ func (r *GenericResource[TFModel, APIRead]) ImportState(
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
 }
 
+func (r *GenericResource[TFModel, APIRead]) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if r.adapter.Tagged {
+		EnforceRequiredTags(ctx, r.client, request, response)
+	}
+
+	if r.adapter.ModifyPlan != nil && !response.Diagnostics.HasError() {
+		r.adapter.ModifyPlan(ctx, r.client, request, response)
+	}
+}
+
 func (r *GenericResource[TFModel, APIRead]) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
 	response *resource.CreateResponse,
 ) {
+	// Authenticate with the service token of the resource's project, if the
+	// provider has one for it, and read the resource in its region.
+	ctx = r.client.WithProjectIDFrom(ctx, request.Plan.GetAttribute)
+	ctx = r.client.WithRegionIDFrom(ctx, request.Plan.GetAttribute)
+
 	data, diagnostics := ReadTerraformState[TFModel](ctx, request.Plan.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
 
+	if r.adapter.Adopt != nil && r.adapter.Update != nil {
+		existing, adoptDiagnostics := r.adapter.Adopt(ctx, r.client, data)
+		if adoptDiagnostics.HasError() {
+			response.Diagnostics.Append(adoptDiagnostics...)
+			return
+		}
+
+		if existing != nil {
+			r.adopt(ctx, existing, data, response)
+			return
+		}
+	}
+
 	api, diagnostics := r.adapter.Create(ctx, r.client, data)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -145,7 +250,7 @@ func (r *GenericResource[TFModel, APIRead]) Create(
 
 	// Record the ID before waiting so a timeout does not orphan the resource.
 	r.adapter.ToModel(api, &data)
-	if diagnostics = response.State.Set(ctx, data); diagnostics.HasError() {
+	if diagnostics = r.setState(ctx, &response.State, data); diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
@@ -165,8 +270,39 @@ func (r *GenericResource[TFModel, APIRead]) Create(
 		return
 	}
 
-	r.adapter.ToModel(final, &data)
-	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+	r.toModel(ctx, final, &data, &response.Diagnostics)
+	response.Diagnostics.Append(r.setState(ctx, &response.State, data)...)
+}
+
+// adopt takes over an existing object in place of a create by applying the plan
+// to it. Unlike Create, state is only written once the update has landed: a
+// failure part-way would otherwise leave an object the user did not create
+// tainted in state, and the next apply would destroy it.
+func (r *GenericResource[TFModel, APIRead]) adopt(
+	ctx context.Context,
+	existing *APIRead,
+	plan TFModel,
+	response *resource.CreateResponse,
+) {
+	data := plan
+	r.adapter.ToModel(existing, &data)
+	id := r.adapter.IDFromModel(data)
+
+	operationTagKey, diagnostics := r.adapter.Update(ctx, r.client, id, nil, plan)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	stateWatcher := r.updateStateWatcher(id, plan)
+
+	final, ok := stateWatcher.WaitAdopted(ctx, operationTagKey, r.adapter.TimeoutsFromModel(plan), response)
+	if !ok {
+		return
+	}
+
+	r.toModel(ctx, final, &data, &response.Diagnostics)
+	response.Diagnostics.Append(r.setState(ctx, &response.State, data)...)
 }
 
 func (r *GenericResource[TFModel, APIRead]) Read(
@@ -174,6 +310,10 @@ func (r *GenericResource[TFModel, APIRead]) Read(
 	request resource.ReadRequest,
 	response *resource.ReadResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+	ctx = r.client.WithRegionIDFrom(ctx, request.State.GetAttribute)
+	ctx = withBatchedReads(ctx)
+
 	data, diagnostics := ReadTerraformState[TFModel](ctx, request.State.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -193,8 +333,14 @@ func (r *GenericResource[TFModel, APIRead]) Read(
 		return
 	}
 
-	r.adapter.ToModel(api, &data)
-	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+	prior := data
+	r.toModel(ctx, api, &data, &response.Diagnostics)
+
+	if r.adapter.Refreshed != nil {
+		r.adapter.Refreshed(prior, &data)
+	}
+
+	response.Diagnostics.Append(r.setState(ctx, &response.State, data)...)
 }
 
 func (r *GenericResource[TFModel, APIRead]) Update(
@@ -202,6 +348,10 @@ func (r *GenericResource[TFModel, APIRead]) Update(
 	request resource.UpdateRequest,
 	response *resource.UpdateResponse,
 ) {
+	if RecordCreateOnlyChanges(request, response, r.adapter.CreateOnlyAttributes...) {
+		return
+	}
+
 	if r.adapter.Update == nil {
 		response.Diagnostics.AddError(
 			"Update Not Supported",
@@ -213,35 +363,209 @@ func (r *GenericResource[TFModel, APIRead]) Update(
 		return
 	}
 
+	ctx = r.client.WithProjectIDFrom(ctx, request.Plan.GetAttribute)
+	ctx = r.client.WithRegionIDFrom(ctx, request.Plan.GetAttribute)
+
+	defer ReconcileFailedUpdate(ctx, r.Read, response)
+
 	data, diagnostics := ReadTerraformState[TFModel](ctx, request.Plan.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
 
+	prior, diagnostics := ReadTerraformState[TFModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
 	id := r.adapter.IDFromModel(data)
 
-	operationTagKey, diagnostics := r.adapter.Update(ctx, r.client, id, data)
+	operationTagKey, diagnostics := r.adapter.Update(ctx, r.client, id, &prior, data)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
 
-	stateWatcher := UpdateStateWatcher[APIRead]{
+	var final *APIRead
+	var ok bool
+
+	if r.adapter.MetadataOnly != nil && r.adapter.MetadataOnly(prior, data) {
+		stateWatcher := r.metadataStateWatcher(id)
+		final, ok = stateWatcher.WaitFor(ctx, operationTagKey, metadataUpdateTimeout, &response.Diagnostics)
+	} else {
+		stateWatcher := r.updateStateWatcher(id, data)
+		final, ok = stateWatcher.Wait(ctx, operationTagKey, r.adapter.TimeoutsFromModel(data), response)
+	}
+	if !ok {
+		return
+	}
+
+	r.toModel(ctx, final, &data, &response.Diagnostics)
+	response.Diagnostics.Append(r.setState(ctx, &response.State, data)...)
+}
+
+// toModel maps api into data, and enriches it when the adapter does.
+func (r *GenericResource[TFModel, APIRead]) toModel(
+	ctx context.Context,
+	api *APIRead,
+	data *TFModel,
+	diagnostics *diag.Diagnostics,
+) {
+	r.adapter.ToModel(api, data)
+
+	if r.adapter.Enrich != nil {
+		diagnostics.Append(r.adapter.Enrich(ctx, r.client, data)...)
+	}
+}
+
+// setState writes data to state, leaving out the sensitive attributes when the
+// provider disallows them in state.
+func (r *GenericResource[TFModel, APIRead]) setState(
+	ctx context.Context,
+	state *tfsdk.State,
+	data TFModel,
+) diag.Diagnostics {
+	diagnostics := state.Set(ctx, data)
+	if diagnostics.HasError() {
+		return diagnostics
+	}
+
+	diagnostics.Append(r.client.omitSensitive(ctx, state, r.adapter.SensitiveAttributes)...)
+
+	return diagnostics
+}
+
+// updateStateWatcher watches the object for an update to plan, treating the
+// update as observed only once the adapter considers it settled.
+func (r *GenericResource[TFModel, APIRead]) updateStateWatcher(id string, plan TFModel) UpdateStateWatcher[APIRead] {
+	return UpdateStateWatcher[APIRead]{
+		ResourceTitle: r.adapter.Title,
+		ResourceName:  r.adapter.Name,
+		GetFunc: func(ctx context.Context) (*APIRead, ResourceStatus, error) {
+			result, status, err := r.adapter.Get(ctx, r.client, id)
+			if err == nil && r.adapter.Settled != nil && !r.adapter.Settled(result, plan) {
+				status.Tags = nil
+			}
+
+			return result, status, err
+		},
+	}
+}
+
+// metadataStateWatcher watches the object for an update that only changes its
+// metadata, which has nothing to settle.
+func (r *GenericResource[TFModel, APIRead]) metadataStateWatcher(id string) UpdateStateWatcher[APIRead] {
+	return UpdateStateWatcher[APIRead]{
 		ResourceTitle: r.adapter.Title,
 		ResourceName:  r.adapter.Name,
 		GetFunc: func(ctx context.Context) (*APIRead, ResourceStatus, error) {
 			return r.adapter.Get(ctx, r.client, id)
 		},
 	}
+}
+
+// RecordCreateOnlyChanges completes an update that only changes the timeouts
+// or the given top-level attributes, which are only consulted at create time,
+// by recording them in state without calling the API, so toggling
+// adopt_existing, for example, does not rewrite the object. It returns whether
+// the update was completed.
+func RecordCreateOnlyChanges(
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+	createOnly ...string,
+) bool {
+	recorded := append([]string{"timeouts"}, createOnly...)
+
+	// The prior state with the planned values of the recorded attributes.
+	candidate, err := tftypes.Transform(
+		request.State.Raw,
+		func(attributePath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+			steps := attributePath.Steps()
+			if len(steps) != 1 {
+				return value, nil
+			}
+
+			name, ok := steps[0].(tftypes.AttributeName)
+			if !ok || !slices.Contains(recorded, string(name)) {
+				return value, nil
+			}
+
+			return valueAt(request.Plan.Raw, attributePath, value), nil
+		},
+	)
+	if err != nil {
+		return false
+	}
+
+	// Any change leaves the computed attributes unknown in the plan, so those
+	// are compared as they are in state.
+	planned, err := tftypes.Transform(
+		request.Plan.Raw,
+		func(attributePath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+			if value.IsKnown() {
+				return value, nil
+			}
+
+			return valueAt(candidate, attributePath, value), nil
+		},
+	)
+	if err != nil || !planned.Equal(candidate) {
+		return false
+	}
+
+	response.State.Raw = candidate
+
+	return true
+}
+
+// valueAt returns the value at attributePath in root, or fallback when root
+// has none there.
+func valueAt(root tftypes.Value, attributePath *tftypes.AttributePath, fallback tftypes.Value) tftypes.Value {
+	found, _, err := tftypes.WalkAttributePath(root, attributePath)
+	if err != nil {
+		return fallback
+	}
 
-	final, ok := stateWatcher.Wait(ctx, operationTagKey, r.adapter.TimeoutsFromModel(data), response)
+	value, ok := found.(tftypes.Value)
 	if !ok {
+		return fallback
+	}
+
+	return value
+}
+
+// ReconcileFailedUpdate refreshes the state of a resource whose update failed,
+// using read, the resource's own Read. An update can fail after the API has
+// applied some or all of it, for example when a resize is accepted but the
+// wait for it times out, and keeping the prior state would then hide what
+// changed; the refreshed state lets the next plan show what is left to do. It
+// does nothing if the update succeeded, and keeps the prior state if the
+// refresh fails or finds the object gone.
+func ReconcileFailedUpdate(
+	ctx context.Context,
+	read func(context.Context, resource.ReadRequest, *resource.ReadResponse),
+	response *resource.UpdateResponse,
+) {
+	if !response.Diagnostics.HasError() {
 		return
 	}
 
-	r.adapter.ToModel(final, &data)
-	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+	readResponse := resource.ReadResponse{State: response.State, Private: response.Private}
+	read(ctx, resource.ReadRequest{State: response.State, Private: response.Private}, &readResponse)
+
+	if readResponse.Diagnostics.HasError() || readResponse.State.Raw.IsNull() {
+		response.Diagnostics.AddWarning(
+			"Failed to Refresh State After Update Error",
+			"The update failed and the resource could not be read back, so its state may not reflect changes "+
+				"the API applied before the failure. Run 'terraform refresh' or 'terraform apply' again once "+
+				"the resource is reachable.",
+		)
+		return
+	}
+
+	response.State = readResponse.State
 }
 
 func (r *GenericResource[TFModel, APIRead]) Delete(
@@ -249,6 +573,9 @@ func (r *GenericResource[TFModel, APIRead]) Delete(
 	request resource.DeleteRequest,
 	response *resource.DeleteResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+	ctx = r.client.WithRegionIDFrom(ctx, request.State.GetAttribute)
+
 	data, diagnostics := ReadTerraformState[TFModel](ctx, request.State.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -258,12 +585,9 @@ func (r *GenericResource[TFModel, APIRead]) Delete(
 	id := r.adapter.IDFromModel(data)
 
 	if err := r.adapter.Delete(ctx, r.client, id); err != nil {
-		if e, ok := AsAPIError(err); !ok || e.StatusCode != http.StatusNotFound {
+		if !IsAPIErrorNotFound(err) {
 			TerraformDebugLogAPIResponseBody(ctx, err)
-			response.Diagnostics.AddError(
-				fmt.Sprintf("Failed to Delete %s", r.adapter.Title),
-				fmt.Sprintf("An error occurred while deleting the %s: %s", r.adapter.Name, err),
-			)
+			apidiag.Add(&response.Diagnostics, apidiag.Delete, r.adapter.Title, r.adapter.Name, err)
 			return
 		}
 	}
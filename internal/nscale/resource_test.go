@@ -0,0 +1,261 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+)
+
+type testReconcileModel struct {
+	ID   types.String `tfsdk:"id"`
+	Size types.Int64  `tfsdk:"size"`
+}
+
+func TestReconcileFailedUpdate(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"size": schema.Int64Attribute{Required: true},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		updateError bool
+		readError   bool
+		readRemoved bool
+		wantSize    int64
+		wantWarning bool
+	}{
+		{name: "successful update is left alone", wantSize: 1},
+		{name: "failed update takes the refreshed object", updateError: true, wantSize: 2},
+		{
+			name:        "failed refresh keeps the prior state",
+			updateError: true,
+			readError:   true,
+			wantSize:    1,
+			wantWarning: true,
+		},
+		{
+			name:        "object gone keeps the prior state",
+			updateError: true,
+			readRemoved: true,
+			wantSize:    1,
+			wantWarning: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			response := resource.UpdateResponse{State: tfsdk.State{Schema: testSchema}}
+			prior := testReconcileModel{ID: types.StringValue("id"), Size: types.Int64Value(1)}
+			if diagnostics := response.State.Set(ctx, prior); diagnostics.HasError() {
+				t.Fatalf("failed to set the prior state: %v", diagnostics)
+			}
+
+			if testCase.updateError {
+				response.Diagnostics.AddError("Failed to Update", "the update timed out")
+			}
+
+			read := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+				var data testReconcileModel
+				response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+				switch {
+				case testCase.readError:
+					response.Diagnostics.AddError("Failed to Read", "the API is unavailable")
+				case testCase.readRemoved:
+					response.State.RemoveResource(ctx)
+				default:
+					data.Size = types.Int64Value(2)
+					response.Diagnostics.Append(response.State.Set(ctx, data)...)
+				}
+			}
+
+			ReconcileFailedUpdate(ctx, read, &response)
+
+			var got testReconcileModel
+			if diagnostics := response.State.Get(ctx, &got); diagnostics.HasError() {
+				t.Fatalf("failed to get the state: %v", diagnostics)
+			}
+
+			if got.Size.ValueInt64() != testCase.wantSize {
+				t.Fatalf("size = %d, want %d", got.Size.ValueInt64(), testCase.wantSize)
+			}
+
+			warned := response.Diagnostics.WarningsCount() > 0
+			if warned != testCase.wantWarning {
+				t.Fatalf("warned = %v, want %v: %v", warned, testCase.wantWarning, response.Diagnostics)
+			}
+
+			if response.Diagnostics.HasError() != testCase.updateError {
+				t.Fatalf("diagnostics = %v, want the update error kept", response.Diagnostics)
+			}
+		})
+	}
+}
+
+type testCreateOnlyModel struct {
+	ID            types.String `tfsdk:"id"`
+	Size          types.Int64  `tfsdk:"size"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+}
+
+func TestRecordCreateOnlyChanges(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":             schema.StringAttribute{Computed: true},
+			"size":           schema.Int64Attribute{Required: true},
+			"adopt_existing": schema.BoolAttribute{Optional: true},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		plannedSize  int64
+		wantRecorded bool
+	}{
+		{name: "only adopt_existing changes", plannedSize: 1, wantRecorded: true},
+		{name: "other attributes change too", plannedSize: 2},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			request := resource.UpdateRequest{
+				State: tfsdk.State{Schema: testSchema},
+				Plan:  tfsdk.Plan{Schema: testSchema},
+			}
+			prior := testCreateOnlyModel{ID: types.StringValue("id"), Size: types.Int64Value(1)}
+			if diagnostics := request.State.Set(ctx, prior); diagnostics.HasError() {
+				t.Fatalf("failed to set the prior state: %v", diagnostics)
+			}
+
+			// The id is computed, so any change leaves it unknown in the plan.
+			plan := testCreateOnlyModel{
+				ID:            types.StringUnknown(),
+				Size:          types.Int64Value(testCase.plannedSize),
+				AdoptExisting: types.BoolValue(true),
+			}
+			if diagnostics := request.Plan.Set(ctx, plan); diagnostics.HasError() {
+				t.Fatalf("failed to set the plan: %v", diagnostics)
+			}
+
+			response := resource.UpdateResponse{State: tfsdk.State{Schema: testSchema, Raw: request.Plan.Raw}}
+
+			if recorded := RecordCreateOnlyChanges(request, &response, "adopt_existing"); recorded != testCase.wantRecorded {
+				t.Fatalf("recorded = %v, want %v", recorded, testCase.wantRecorded)
+			}
+
+			if !testCase.wantRecorded {
+				return
+			}
+
+			var got testCreateOnlyModel
+			if diagnostics := response.State.Get(ctx, &got); diagnostics.HasError() {
+				t.Fatalf("failed to get the state: %v", diagnostics)
+			}
+
+			want := testCreateOnlyModel{ID: prior.ID, Size: prior.Size, AdoptExisting: types.BoolValue(true)}
+			if got != want {
+				t.Fatalf("state = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdateStateWatcherWaitsUntilSettled(t *testing.T) {
+	const operationTagKey = TerraformOperationTagPrefix + "update"
+
+	// The update is observed on the first read, but only takes effect on the
+	// third.
+	reads := 0
+	r := NewGenericResource(ResourceAdapter[testReconcileModel, int]{
+		Title: "Test",
+		Name:  "test",
+		Get: func(context.Context, *Client, string) (*int, ResourceStatus, error) {
+			reads++
+			read := reads
+			return &read, ResourceStatus{
+				ID:                 "id",
+				ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned,
+				Tags:               &coreapi.TagList{{Name: operationTagKey}},
+			}, nil
+		},
+		Settled: func(api *int, plan testReconcileModel) bool {
+			return int64(*api) >= plan.Size.ValueInt64()
+		},
+	})
+
+	stateWatcher := r.updateStateWatcher("id", testReconcileModel{Size: types.Int64Value(3)})
+
+	var diagnostics diag.Diagnostics
+	got, ok := stateWatcher.WaitFor(context.Background(), operationTagKey, time.Second, &diagnostics)
+	if !ok {
+		t.Fatalf("WaitFor() failed: %v", diagnostics)
+	}
+
+	if *got != 3 {
+		t.Fatalf("WaitFor() returned read %d, want the third, where the update settled", *got)
+	}
+}
+
+func TestMetadataStateWatcherDoesNotWaitToSettle(t *testing.T) {
+	const operationTagKey = TerraformOperationTagPrefix + "update"
+
+	reads := 0
+	r := NewGenericResource(ResourceAdapter[testReconcileModel, int]{
+		Title: "Test",
+		Name:  "test",
+		Get: func(context.Context, *Client, string) (*int, ResourceStatus, error) {
+			reads++
+			read := reads
+			return &read, ResourceStatus{
+				ID:                 "id",
+				ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned,
+				Tags:               &coreapi.TagList{{Name: operationTagKey}},
+			}, nil
+		},
+		Settled: func(*int, testReconcileModel) bool {
+			return false
+		},
+	})
+
+	stateWatcher := r.metadataStateWatcher("id")
+
+	var diagnostics diag.Diagnostics
+	got, ok := stateWatcher.WaitFor(context.Background(), operationTagKey, time.Second, &diagnostics)
+	if !ok {
+		t.Fatalf("WaitFor() failed: %v", diagnostics)
+	}
+
+	if *got != 1 {
+		t.Fatalf("WaitFor() returned read %d, want the first, where the update was observed", *got)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// errorResponse is the error body every Nscale service returns.
+type errorResponse struct {
+	Error            string  `json:"error"`
+	ErrorDescription string  `json:"error_description"`
+	TraceID          *string `json:"trace_id"`
+}
+
+// ReadJSONResponsePointer decodes a successful JSON response into a new T; see
+// ReadJSONResponseValue.
+func ReadJSONResponsePointer[T any](response *http.Response) (*T, error) {
+	data, err := ReadJSONResponseValue[T](response)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// ReadJSONResponseValue decodes a successful JSON response into a T. Every
+// failure is returned as an *APIError: a response outside the 2xx range is
+// decoded from the API's error body, and a body that cannot be read or decoded
+// is reported with the response's status code, and for decoding also with the
+// endpoint and raw body, which TerraformDebugLogAPIResponseBody logs. The caller
+// still owns closing the body.
+func ReadJSONResponseValue[T any](response *http.Response) (T, error) {
+	var data T
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		err := readErrorResponse(response)
+		return data, err
+	}
+
+	bodyBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		err = responseReadError(response, err)
+		return data, err
+	}
+
+	if err = json.Unmarshal(bodyBytes, &data); err != nil {
+		err = responseDecodeError(response, bodyBytes, err)
+		return data, err
+	}
+
+	return data, nil
+}
+
+// ReadResponseBody returns the body of a successful response that is not JSON,
+// such as a kubeconfig file, returning an *APIError as ReadJSONResponseValue
+// does otherwise.
+func ReadResponseBody(response *http.Response) ([]byte, error) {
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, readErrorResponse(response)
+	}
+
+	bodyBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, responseReadError(response, err)
+	}
+
+	return bodyBytes, nil
+}
+
+// ReadEmptyResponse checks a response whose body is not needed, returning an
+// *APIError, as ReadJSONResponseValue does, unless it is in the 2xx range.
+func ReadEmptyResponse(response *http.Response) error {
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return readErrorResponse(response)
+	}
+	return nil
+}
+
+// readErrorResponse decodes an unsuccessful response's error body.
+func readErrorResponse(response *http.Response) error {
+	bodyBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return responseReadError(response, err)
+	}
+
+	var data errorResponse
+	if err = json.Unmarshal(bodyBytes, &data); err != nil {
+		return responseDecodeError(response, bodyBytes, err)
+	}
+
+	return &APIError{
+		StatusCode: response.StatusCode,
+		Code:       data.Error,
+		Message:    data.ErrorDescription,
+		TraceID:    data.TraceID,
+		RequestID:  responseRequestID(response),
+		RetryAfter: responseRetryAfter(response),
+	}
+}
+
+// responseRequestID returns the request or correlation ID the backend attached
+// to a response, or an empty string when it sent none.
+func responseRequestID(response *http.Response) string {
+	for _, header := range []string{"X-Request-Id", "X-Correlation-Id"} {
+		if id := response.Header.Get(header); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// responseRetryAfter returns the delay a 503 response asked for in its
+// Retry-After header, given in seconds or as an HTTP date, or zero for any
+// other response. A delay that has already passed is rounded up to a second,
+// so the response is still recognised as a maintenance window.
+func responseRetryAfter(response *http.Response) time.Duration {
+	if response == nil || response.StatusCode != http.StatusServiceUnavailable {
+		return 0
+	}
+
+	header := response.Header.Get("Retry-After")
+	if header == "" {
+		return 0
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		delay = time.Until(date)
+	} else {
+		return 0
+	}
+
+	return max(delay, time.Second)
+}
+
+func responseReadError(response *http.Response, err error) error {
+	return &APIError{
+		StatusCode: response.StatusCode,
+		Message:    fmt.Sprintf("failed to read response body: %s", err),
+		RequestID:  responseRequestID(response),
+		RetryAfter: responseRetryAfter(response),
+	}
+}
+
+func responseDecodeError(response *http.Response, bodyBytes []byte, err error) error {
+	var endpoint string
+	if response.Request != nil {
+		endpoint = fmt.Sprintf("%s %s", response.Request.Method, response.Request.URL.Path)
+	}
+
+	return &APIError{
+		StatusCode: response.StatusCode,
+		Message:    fmt.Sprintf("failed to decode response: %s", err),
+		RequestID:  responseRequestID(response),
+		RetryAfter: responseRetryAfter(response),
+		Endpoint:   endpoint,
+		BodyBytes:  bodyBytes,
+	}
+}
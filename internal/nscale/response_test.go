@@ -0,0 +1,325 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/api/v2/networks"}},
+	}
+}
+
+func TestReadJSONResponseValue(t *testing.T) {
+	type value struct {
+		Name string `json:"name"`
+	}
+
+	testCases := []struct {
+		name         string
+		status       int
+		body         string
+		want         value
+		wantStatus   int
+		wantMessage  string
+		wantEndpoint string
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"name":"a"}`,
+			want:   value{Name: "a"},
+		},
+		{
+			name:        "error body",
+			status:      http.StatusNotFound,
+			body:        `{"error":"not_found","error_description":"network not found"}`,
+			wantStatus:  http.StatusNotFound,
+			wantMessage: "network not found",
+		},
+		{
+			name:        "undecodable error body",
+			status:      http.StatusBadGateway,
+			body:        "<html>bad gateway</html>",
+			wantStatus:  http.StatusBadGateway,
+			wantMessage: "failed to decode response",
+		},
+		{
+			name:         "undecodable success body",
+			status:       http.StatusOK,
+			body:         `{"name":`,
+			wantStatus:   http.StatusOK,
+			wantMessage:  "failed to decode response",
+			wantEndpoint: "GET /api/v2/networks",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ReadJSONResponseValue[value](testResponse(testCase.status, testCase.body))
+
+			if testCase.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("ReadJSONResponseValue() error = %v", err)
+				}
+				if got != testCase.want {
+					t.Fatalf("ReadJSONResponseValue() = %+v, want %+v", got, testCase.want)
+				}
+				return
+			}
+
+			apiError, ok := AsAPIError(err)
+			if !ok {
+				t.Fatalf("ReadJSONResponseValue() error = %v, want an *APIError", err)
+			}
+			if apiError.StatusCode != testCase.wantStatus || !strings.Contains(apiError.Message, testCase.wantMessage) {
+				t.Fatalf("ReadJSONResponseValue() error = %v, want status %d and message %q",
+					apiError, testCase.wantStatus, testCase.wantMessage)
+			}
+			if testCase.wantEndpoint != "" && (apiError.Endpoint != testCase.wantEndpoint || len(apiError.BodyBytes) == 0) {
+				t.Fatalf("ReadJSONResponseValue() endpoint = %q with %d body bytes, want %q and the body",
+					apiError.Endpoint, len(apiError.BodyBytes), testCase.wantEndpoint)
+			}
+		})
+	}
+}
+
+func TestReadJSONResponsePointer(t *testing.T) {
+	got, err := ReadJSONResponsePointer[map[string]string](testResponse(http.StatusCreated, `{"id":"a"}`))
+	if err != nil || got == nil || (*got)["id"] != "a" {
+		t.Fatalf("ReadJSONResponsePointer() = %v, %v, want the decoded body", got, err)
+	}
+
+	if got, err = ReadJSONResponsePointer[map[string]string](testResponse(http.StatusConflict, `{}`)); got != nil ||
+		!IsAPIErrorStatus(err, http.StatusConflict) {
+		t.Fatalf("ReadJSONResponsePointer() = %v, %v, want nil and a conflict", got, err)
+	}
+}
+
+func TestReadResponseBody(t *testing.T) {
+	got, err := ReadResponseBody(testResponse(http.StatusOK, "apiVersion: v1\nkind: Config\n"))
+	if err != nil || string(got) != "apiVersion: v1\nkind: Config\n" {
+		t.Fatalf("ReadResponseBody() = %q, %v, want the raw body", got, err)
+	}
+
+	if got, err = ReadResponseBody(testResponse(http.StatusNotFound, `{}`)); got != nil ||
+		!IsAPIErrorStatus(err, http.StatusNotFound) {
+		t.Fatalf("ReadResponseBody() = %q, %v, want nil and a not found", got, err)
+	}
+}
+
+func TestIsAPIErrorStatus(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+
+	testCases := []struct {
+		name        string
+		err         error
+		statusCodes []int
+		want        bool
+	}{
+		{name: "matching", err: notFound, statusCodes: []int{http.StatusNotFound}, want: true},
+		{
+			name:        "any of several",
+			err:         notFound,
+			statusCodes: []int{http.StatusGone, http.StatusNotFound},
+			want:        true,
+		},
+		{name: "wrapped", err: fmt.Errorf("reading: %w", notFound), statusCodes: []int{http.StatusNotFound}, want: true},
+		{name: "other status", err: notFound, statusCodes: []int{http.StatusConflict}},
+		{name: "not an API error", err: errors.New("connection refused"), statusCodes: []int{http.StatusNotFound}},
+		{name: "nil", statusCodes: []int{http.StatusNotFound}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := IsAPIErrorStatus(testCase.err, testCase.statusCodes...); got != testCase.want {
+				t.Fatalf("IsAPIErrorStatus() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+
+	if !IsAPIErrorNotFound(notFound) || IsAPIErrorNotFound(&APIError{StatusCode: http.StatusForbidden}) {
+		t.Fatal("IsAPIErrorNotFound() does not match only not found errors")
+	}
+}
+
+func TestReadEmptyResponseCapturesRequestID(t *testing.T) {
+	testCases := []struct {
+		name          string
+		header        http.Header
+		body          string
+		wantRequestID string
+	}{
+		{
+			name:          "request ID header on a decodable error",
+			header:        http.Header{"X-Request-Id": []string{"req-123"}},
+			body:          `{"error":"conflict","error_description":"name in use"}`,
+			wantRequestID: "req-123",
+		},
+		{
+			name:          "correlation ID header on an undecodable error",
+			header:        http.Header{"X-Correlation-Id": []string{"corr-456"}},
+			body:          "<html>bad gateway</html>",
+			wantRequestID: "corr-456",
+		},
+		{
+			name:          "no header",
+			header:        http.Header{},
+			body:          `{"error":"conflict"}`,
+			wantRequestID: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := &http.Response{
+				StatusCode: http.StatusConflict,
+				Header:     testCase.header,
+				Body:       io.NopCloser(strings.NewReader(testCase.body)),
+			}
+
+			apiError, ok := AsAPIError(ReadEmptyResponse(response))
+			if !ok {
+				t.Fatalf("expected an *APIError")
+			}
+			if apiError.RequestID != testCase.wantRequestID {
+				t.Fatalf("request ID = %q, want %q", apiError.RequestID, testCase.wantRequestID)
+			}
+
+			hasRequestID := strings.Contains(apiError.Error(), "request_id: ")
+			if hasRequestID != (testCase.wantRequestID != "") {
+				t.Fatalf("error message %q, want request ID included: %t", apiError.Error(), !hasRequestID)
+			}
+		})
+	}
+}
+
+func TestReadEmptyResponseCapturesRetryAfter(t *testing.T) {
+	testCases := []struct {
+		name           string
+		status         int
+		retryAfter     string
+		body           string
+		wantRetryAfter time.Duration
+	}{
+		{
+			name:           "seconds on a decodable error",
+			status:         http.StatusServiceUnavailable,
+			retryAfter:     "30",
+			body:           `{"error":"unavailable","error_description":"down for maintenance"}`,
+			wantRetryAfter: 30 * time.Second,
+		},
+		{
+			name:           "seconds on a maintenance page",
+			status:         http.StatusServiceUnavailable,
+			retryAfter:     "120",
+			body:           "<html>maintenance</html>",
+			wantRetryAfter: 2 * time.Minute,
+		},
+		{
+			name:           "date in the past",
+			status:         http.StatusServiceUnavailable,
+			retryAfter:     "Fri, 31 Dec 1999 23:59:59 GMT",
+			body:           "<html>maintenance</html>",
+			wantRetryAfter: time.Second,
+		},
+		{
+			name:       "unparseable header",
+			status:     http.StatusServiceUnavailable,
+			retryAfter: "soon",
+			body:       "<html>maintenance</html>",
+		},
+		{
+			name:   "no header",
+			status: http.StatusServiceUnavailable,
+			body:   "<html>maintenance</html>",
+		},
+		{
+			name:       "rate limited",
+			status:     http.StatusTooManyRequests,
+			retryAfter: "30",
+			body:       `{"error":"rate_limited"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := testResponse(testCase.status, testCase.body)
+			if testCase.retryAfter != "" {
+				response.Header.Set("Retry-After", testCase.retryAfter)
+			}
+
+			err := ReadEmptyResponse(response)
+
+			delay, ok := APIMaintenanceDelay(err)
+			if delay != testCase.wantRetryAfter || ok != (testCase.wantRetryAfter > 0) {
+				t.Fatalf("APIMaintenanceDelay() = %v, %v, want %v", delay, ok, testCase.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyRetriesMaintenanceWindows(t *testing.T) {
+	maintenance := func() *http.Response {
+		response := testResponse(http.StatusServiceUnavailable, "<html>maintenance</html>")
+		response.Header.Set("Retry-After", "30")
+		return response
+	}
+
+	testCases := []struct {
+		name     string
+		response *http.Response
+		want     bool
+	}{
+		{name: "maintenance window", response: maintenance(), want: true},
+		{name: "unavailable without Retry-After", response: testResponse(http.StatusServiceUnavailable, "")},
+		{name: "internal server error", response: testResponse(http.StatusInternalServerError, "")},
+		{name: "rate limited", response: testResponse(http.StatusTooManyRequests, ""), want: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got, err := retryPolicy(context.Background(), testCase.response, nil); err != nil || got != testCase.want {
+				t.Fatalf("retryPolicy() = %v, %v, want %v", got, err, testCase.want)
+			}
+		})
+	}
+
+	// Once the retries run out, the maintenance response is passed on for the
+	// state watchers to read its Retry-After delay from.
+	response, err := retryErrorHandler(maintenance(), nil, 5)
+	if err != nil || response == nil {
+		t.Fatalf("retryErrorHandler() = %v, %v, want the maintenance response", response, err)
+	}
+
+	if _, err = retryErrorHandler(testResponse(http.StatusTooManyRequests, ""), nil, 5); err == nil {
+		t.Fatal("retryErrorHandler() returned no error for an exhausted rate limit")
+	}
+}
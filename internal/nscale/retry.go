@@ -61,7 +61,7 @@ func RetryDelete(ctx context.Context, timeout time.Duration, deleteFn func(conte
 		if err == nil {
 			return nil
 		}
-		if e, ok := AsAPIError(err); ok && e.StatusCode == http.StatusNotFound {
+		if IsAPIErrorNotFound(err) {
 			return nil
 		}
 		if retryable {
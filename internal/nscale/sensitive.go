@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SensitiveValue returns value, a secret, for an attribute stored in state, or
+// null when the provider is configured with disallow_sensitive_in_state.
+func (c *Client) SensitiveValue(value types.String) types.String {
+	if c.DisallowSensitiveInState {
+		return types.StringNull()
+	}
+	return value
+}
+
+// omitSensitive nulls the given string attributes of state when the provider
+// is configured with disallow_sensitive_in_state.
+func (c *Client) omitSensitive(ctx context.Context, state *tfsdk.State, attributes []path.Path) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if !c.DisallowSensitiveInState {
+		return diagnostics
+	}
+
+	for _, attribute := range attributes {
+		diagnostics.Append(state.SetAttribute(ctx, attribute, types.StringNull())...)
+	}
+
+	return diagnostics
+}
+
+// AddSensitiveInStateError reports that what, a secret, would have to be
+// stored in state, which disallow_sensitive_in_state forbids. alternative
+// names the ephemeral resource that reads the secret without storing it, if
+// there is one.
+func AddSensitiveInStateError(diagnostics *diag.Diagnostics, what, alternative string) {
+	detail := fmt.Sprintf(
+		"The provider is configured with disallow_sensitive_in_state, so Terraform state cannot hold %s.",
+		what,
+	)
+	if alternative != "" {
+		detail += fmt.Sprintf(" Use the %s ephemeral resource instead, which Terraform does not store.", alternative)
+	}
+
+	diagnostics.AddError("Sensitive Value Not Allowed in State", detail)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testSecretModel struct {
+	ID     types.String `tfsdk:"id"`
+	Secret types.String `tfsdk:"secret"`
+}
+
+func TestSetStateOmitsSensitiveAttributes(t *testing.T) {
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":     schema.StringAttribute{Computed: true},
+			"secret": schema.StringAttribute{Computed: true, Sensitive: true},
+		},
+	}
+
+	for _, disallow := range []bool{false, true} {
+		r := NewGenericResource(ResourceAdapter[testSecretModel, struct{}]{
+			SensitiveAttributes: []path.Path{path.Root("secret")},
+		})
+		r.client = &Client{DisallowSensitiveInState: disallow}
+
+		state := tfsdk.State{Schema: testSchema}
+		data := testSecretModel{ID: types.StringValue("id"), Secret: types.StringValue("secret")}
+		if diagnostics := r.setState(context.Background(), &state, data); diagnostics.HasError() {
+			t.Fatalf("setState() diagnostics = %v", diagnostics)
+		}
+
+		var got testSecretModel
+		if diagnostics := state.Get(context.Background(), &got); diagnostics.HasError() {
+			t.Fatalf("Get() diagnostics = %v", diagnostics)
+		}
+
+		if got.ID.ValueString() != "id" || got.Secret.IsNull() != disallow {
+			t.Fatalf("state when disallowed = %v is %v, want the secret null only when disallowed", disallow, got)
+		}
+	}
+}
+
+func TestGenericDataSourceRefusesSecretsWhenDisallowed(t *testing.T) {
+	s := NewGenericDataSource(DataSourceAdapter[testSecretModel, struct{}]{
+		Name:                 "secret",
+		EphemeralAlternative: "nscale_secret",
+	})
+	s.client = &Client{DisallowSensitiveInState: true}
+
+	var response datasource.ReadResponse
+	s.Read(context.Background(), datasource.ReadRequest{}, &response)
+
+	if !response.Diagnostics.HasError() ||
+		response.Diagnostics.Errors()[0].Summary() != "Sensitive Value Not Allowed in State" {
+		t.Fatalf("Read() diagnostics = %v, want the secret refused", response.Diagnostics)
+	}
+}
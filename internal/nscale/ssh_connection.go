@@ -0,0 +1,155 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+)
+
+//nolint:gochecknoglobals // constant attribute type.
+var SSHConnectionAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"host":        types.StringType,
+		"user":        types.StringType,
+		"private_key": types.StringType,
+	},
+}
+
+// distroLoginUsers are the users the cloud images of each distribution create
+// for the SSH key they are given.
+//
+//nolint:gochecknoglobals // constant lookup table.
+var distroLoginUsers = map[string]string{
+	"almalinux": "almalinux",
+	"centos":    "centos",
+	"debian":    "debian",
+	"fedora":    "fedora",
+	"flatcar":   "core",
+	"rhel":      "cloud-user",
+	"rocky":     "rocky",
+	"ubuntu":    "ubuntu",
+}
+
+// SSHConnectionDescription returns the description of the ssh_connection
+// attribute of what, such as "instance".
+func SSHConnectionDescription(what string) string {
+	return fmt.Sprintf(
+		"The details to connect to the %s over SSH with, in the shape of a `connection` block, for provisioners and for tools such as Ansible reading them with `terraform output`. `host` is the public IP address, or the private one without it. `user` is the default user of the image's distribution, and null when the distribution is not known. `private_key` is the generated SSH private key, and null without one or when the provider is configured with `disallow_sensitive_in_state`.",
+		what,
+	)
+}
+
+// NewSSHConnection returns an ssh_connection value for a machine with the
+// given addresses; see SSHHost.
+func NewSSHConnection(publicIP, privateIP, user, privateKey types.String) types.Object {
+	return types.ObjectValueMust(SSHConnectionAttributeType.AttrTypes, map[string]attr.Value{
+		"host":        SSHHost(publicIP, privateIP),
+		"user":        user,
+		"private_key": privateKey,
+	})
+}
+
+// SSHHost returns the address to connect to a machine with the given addresses
+// over SSH, which is the public one when it has one.
+func SSHHost(publicIP, privateIP types.String) types.String {
+	if publicIP.IsNull() || publicIP.IsUnknown() {
+		return privateIP
+	}
+
+	return publicIP
+}
+
+// SSHConnectionCredentials returns the user and private key of an
+// ssh_connection value, or nulls when it is not known.
+func SSHConnectionCredentials(connection types.Object) (types.String, types.String) {
+	user, privateKey := types.StringNull(), types.StringNull()
+	if connection.IsNull() || connection.IsUnknown() {
+		return user, privateKey
+	}
+
+	if value, ok := connection.Attributes()["user"].(types.String); ok && !value.IsUnknown() {
+		user = value
+	}
+	if value, ok := connection.Attributes()["private_key"].(types.String); ok && !value.IsUnknown() {
+		privateKey = value
+	}
+
+	return user, privateKey
+}
+
+// SSHPrivateKey returns privateKey for an ssh_connection value, or null when
+// the provider disallows sensitive values in state.
+func (c *Client) SSHPrivateKey(privateKey types.String) types.String {
+	if c.DisallowSensitiveInState {
+		return types.StringNull()
+	}
+
+	return privateKey
+}
+
+// ImageLoginUsers returns the login user of each image available in a region
+// by image ID, for the images of distributions whose user is known.
+func (c *Client) ImageLoginUsers(ctx context.Context, rawRegionID string) (map[string]string, error) {
+	regionID, err := regionids.ParseRegionID(rawRegionID)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := c.ListAvailableImages(ctx, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[string]string, len(images))
+	for _, image := range images {
+		if user, ok := distroLoginUsers[image.Spec.Os.Distro]; ok {
+			users[image.Metadata.Id] = user
+		}
+	}
+
+	return users, nil
+}
+
+// LoginUser returns the login user of an image from users, as returned by
+// ImageLoginUsers, or prior when the image is not listed, as once it is no
+// longer available, since the user of a machine created from it is the same.
+func LoginUser(users map[string]string, imageID string, prior types.String) types.String {
+	if user, ok := users[imageID]; ok {
+		return types.StringValue(user)
+	}
+
+	return prior
+}
+
+// AddSSHConnectionWarning reports that the user of the ssh_connection values
+// of what could not be determined, and is kept as it was.
+func AddSSHConnectionWarning(diagnostics *diag.Diagnostics, what string, err error) {
+	diagnostics.AddWarning(
+		"Unable to Determine SSH User",
+		fmt.Sprintf(
+			"The images could not be listed to determine the user to connect to the %s with, so the user in ssh_connection is unchanged: %s",
+			what,
+			err,
+		),
+	)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewSSHConnection(t *testing.T) {
+	testCases := []struct {
+		name      string
+		publicIP  types.String
+		privateIP types.String
+		want      types.String
+	}{
+		{
+			name:      "public address",
+			publicIP:  types.StringValue("203.0.113.10"),
+			privateIP: types.StringValue("10.0.0.10"),
+			want:      types.StringValue("203.0.113.10"),
+		},
+		{
+			name:      "private address without a public one",
+			publicIP:  types.StringNull(),
+			privateIP: types.StringValue("10.0.0.10"),
+			want:      types.StringValue("10.0.0.10"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			connection := NewSSHConnection(
+				testCase.publicIP, testCase.privateIP, types.StringValue("ubuntu"), types.StringNull(),
+			)
+
+			if host := connection.Attributes()["host"]; !host.Equal(testCase.want) {
+				t.Fatalf("host = %s, want %s", host, testCase.want)
+			}
+
+			user, privateKey := SSHConnectionCredentials(connection)
+			if user.ValueString() != "ubuntu" || !privateKey.IsNull() {
+				t.Fatalf("SSHConnectionCredentials() = %s, %s, want \"ubuntu\", null", user, privateKey)
+			}
+		})
+	}
+}
+
+func TestLoginUser(t *testing.T) {
+	users := map[string]string{"image": "ubuntu"}
+
+	if got := LoginUser(users, "image", types.StringNull()); got.ValueString() != "ubuntu" {
+		t.Fatalf("LoginUser() = %s, want \"ubuntu\"", got)
+	}
+
+	// An image no longer listed keeps the user the machine already had.
+	if got := LoginUser(users, "retired", types.StringValue("rocky")); got.ValueString() != "rocky" {
+		t.Fatalf("LoginUser() = %s, want \"rocky\"", got)
+	}
+
+	if got := LoginUser(nil, "image", types.StringNull()); !got.IsNull() {
+		t.Fatalf("LoginUser() = %s, want null", got)
+	}
+}
+
+func TestSSHPrivateKeyDisallowedInState(t *testing.T) {
+	client := &Client{DisallowSensitiveInState: true}
+
+	if got := client.SSHPrivateKey(types.StringValue("key")); !got.IsNull() {
+		t.Fatalf("SSHPrivateKey() = %s, want null", got)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// stateWaiter runs the poll loop of a state watcher's conf until it reaches
+// a target state. It is an interface so that tests can run the watchers on a
+// fake clock instead of waiting out their poll schedules.
+type stateWaiter interface {
+	WaitForState(ctx context.Context, conf *retry.StateChangeConf) (any, error)
+}
+
+// backoffWaiter runs conf in real time, polling on backoff.
+type backoffWaiter struct {
+	backoff pollBackoff
+}
+
+func (w backoffWaiter) WaitForState(ctx context.Context, conf *retry.StateChangeConf) (any, error) {
+	w.backoff.apply(ctx, conf)
+	return conf.WaitForStateContext(ctx)
+}
+
+// waitForState runs conf on waiter, or in real time on stateWatcherPollBackoff
+// when waiter is nil, as it is outside tests.
+func waitForState(ctx context.Context, waiter stateWaiter, conf *retry.StateChangeConf) (any, error) {
+	if waiter == nil {
+		waiter = backoffWaiter{backoff: stateWatcherPollBackoff}
+	}
+
+	return waiter.WaitForState(ctx, conf)
+}
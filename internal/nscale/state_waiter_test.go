@@ -0,0 +1,334 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+)
+
+// fakeClockWaiter runs a state watcher's conf as StateChangeConf does, but
+// advances a fake clock by each poll interval instead of sleeping, so that the
+// watchers can be tested on the production poll schedule and timeouts.
+type fakeClockWaiter struct {
+	backoff pollBackoff
+	elapsed time.Duration
+}
+
+func newFakeClockWaiter() *fakeClockWaiter {
+	return &fakeClockWaiter{backoff: pollBackoff{Initial: 5 * time.Second, Max: time.Minute}}
+}
+
+func (w *fakeClockWaiter) WaitForState(ctx context.Context, conf *retry.StateChangeConf) (any, error) {
+	w.backoff.apply(ctx, conf)
+
+	notFoundChecks := conf.NotFoundChecks
+	if notFoundChecks == 0 {
+		notFoundChecks = 20
+	}
+
+	var (
+		notFound  int
+		lastState string
+	)
+
+	for wait := conf.Delay; ; wait = conf.PollInterval {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if w.elapsed+wait > conf.Timeout {
+			return nil, &retry.TimeoutError{LastState: lastState, Timeout: conf.Timeout, ExpectedState: conf.Target}
+		}
+		w.elapsed += wait
+
+		result, state, err := conf.Refresh()
+		if err != nil {
+			return nil, err
+		}
+		lastState = state
+
+		// As StateChangeConf does, a nil result is a resource not found, and
+		// only fails the wait once it has not been found too many times.
+		if result == nil {
+			notFound++
+			if notFound > notFoundChecks {
+				return nil, &retry.NotFoundError{Retries: notFound}
+			}
+			continue
+		}
+		notFound = 0
+
+		if slices.Contains(conf.Target, state) {
+			return result, nil
+		}
+
+		if !slices.Contains(conf.Pending, state) {
+			return nil, &retry.UnexpectedStateError{State: state, ExpectedState: conf.Target}
+		}
+	}
+}
+
+// testWatcherStatus returns the status of a watched resource, with tags when
+// given.
+func testWatcherStatus(status coreapi.ResourceProvisioningStatus, tags ...coreapi.Tag) ResourceStatus {
+	metadata := &coreapi.ProjectScopedResourceReadMetadata{
+		Id:                 "0f3b6e1c-3d6a-4b8e-9c1f-7a2d5e8b4c60",
+		ProvisioningStatus: status,
+	}
+	if len(tags) > 0 {
+		metadata.Tags = &coreapi.TagList{}
+		*metadata.Tags = append(*metadata.Tags, tags...)
+	}
+
+	return StatusFromProjectScoped(metadata)
+}
+
+// testWatcherReads returns a GetFunc that returns each of reads in turn, and
+// then the last of them again, counting its calls in calls.
+func testWatcherReads(calls *int, reads ...func() (*waitTestResource, ResourceStatus, error)) func(
+	context.Context,
+) (*waitTestResource, ResourceStatus, error) {
+	return func(context.Context) (*waitTestResource, ResourceStatus, error) {
+		read := reads[min(*calls, len(reads)-1)]
+		*calls++
+		return read()
+	}
+}
+
+func testWatcherFound(status coreapi.ResourceProvisioningStatus, tags ...coreapi.Tag) func() (
+	*waitTestResource, ResourceStatus, error,
+) {
+	return func() (*waitTestResource, ResourceStatus, error) {
+		return &waitTestResource{name: string(status)}, testWatcherStatus(status, tags...), nil
+	}
+}
+
+func testWatcherError(statusCode int) func() (*waitTestResource, ResourceStatus, error) {
+	return func() (*waitTestResource, ResourceStatus, error) {
+		return nil, ResourceStatus{}, &APIError{StatusCode: statusCode, Message: http.StatusText(statusCode)}
+	}
+}
+
+func TestCreateStateWatcherWaitsThroughNotFound(t *testing.T) {
+	var calls int
+
+	waiter := newFakeClockWaiter()
+	watcher := CreateStateWatcher[waitTestResource]{
+		ResourceTitle: "Test Resource",
+		ResourceName:  "test resource",
+		GetFunc: testWatcherReads(&calls,
+			testWatcherError(http.StatusNotFound),
+			testWatcherError(http.StatusNotFound),
+			testWatcherFound(coreapi.ResourceProvisioningStatusProvisioned),
+		),
+		waiter: waiter,
+	}
+
+	var response resource.CreateResponse
+
+	got, ok := watcher.Wait(context.Background(), tftimeouts.Value{}, &response)
+	if !ok {
+		t.Fatalf("Wait() returned ok=false with diagnostics: %#v", response.Diagnostics)
+	}
+
+	if got.name != string(coreapi.ResourceProvisioningStatusProvisioned) {
+		t.Fatalf("Wait() returned %q, want the provisioned resource", got.name)
+	}
+
+	if calls != 3 || waiter.elapsed != 15*time.Second {
+		t.Fatalf("Wait() polled %d times over %s, want 3 times over 15s", calls, waiter.elapsed)
+	}
+}
+
+func TestCreateStateWatcherDoesNotTreatNotFoundAsCreated(t *testing.T) {
+	var calls int
+
+	waiter := newFakeClockWaiter()
+	watcher := CreateStateWatcher[waitTestResource]{
+		ResourceTitle: "Test Resource",
+		ResourceName:  "test resource",
+		GetFunc:       testWatcherReads(&calls, testWatcherError(http.StatusNotFound)),
+		waiter:        waiter,
+	}
+
+	var response resource.CreateResponse
+
+	if _, ok := watcher.Wait(context.Background(), tftimeouts.Value{}, &response); ok {
+		t.Fatal("Wait() returned ok=true for a resource that was never found")
+	}
+
+	errs := response.Diagnostics.Errors()
+	if len(errs) != 1 || errs[0].Summary() != "Failed to Wait for Test Resource to be Created" {
+		t.Fatalf("Wait() diagnostics = %#v, want a single wait failure", response.Diagnostics)
+	}
+
+	// The watcher gives up once the resource has not been found too many
+	// times in a row, well before the create timeout.
+	if calls != 21 || waiter.elapsed >= defaultStateWatcherTimeout {
+		t.Fatalf("Wait() polled %d times over %s, want 21 times inside the timeout", calls, waiter.elapsed)
+	}
+}
+
+func TestCreateStateWatcherTimesOut(t *testing.T) {
+	var calls int
+
+	waiter := newFakeClockWaiter()
+	watcher := CreateStateWatcher[waitTestResource]{
+		ResourceTitle: "Test Resource",
+		ResourceName:  "test resource",
+		GetFunc:       testWatcherReads(&calls, testWatcherFound(coreapi.ResourceProvisioningStatusProvisioning)),
+		waiter:        waiter,
+	}
+
+	var response resource.CreateResponse
+
+	if _, ok := watcher.Wait(context.Background(), tftimeouts.Value{}, &response); ok {
+		t.Fatal("Wait() returned ok=true for a resource that never finished provisioning")
+	}
+
+	errs := response.Diagnostics.Errors()
+	if len(errs) != 1 || !strings.Contains(errs[0].Detail(), "timeout while waiting") {
+		t.Fatalf("Wait() diagnostics = %#v, want a timeout", response.Diagnostics)
+	}
+
+	// Polls settle at one a minute: 0s, 5s, 15s, 35s, 75s, then every 60s up
+	// to the last poll before the 30-minute timeout, at 1755s.
+	if calls != 33 || waiter.elapsed != 1755*time.Second {
+		t.Fatalf("Wait() polled %d times over %s, want 33 times over 29m15s", calls, waiter.elapsed)
+	}
+}
+
+func TestUpdateStateWatcherWaitsForItsOperationTag(t *testing.T) {
+	const operationTagKey = TerraformOperationTagPrefix + "this-op"
+
+	var calls int
+
+	waiter := newFakeClockWaiter()
+	watcher := UpdateStateWatcher[waitTestResource]{
+		ResourceTitle: "Test Resource",
+		ResourceName:  "test resource",
+		GetFunc: testWatcherReads(&calls,
+			testWatcherFound(coreapi.ResourceProvisioningStatusProvisioned),
+			testWatcherFound(
+				coreapi.ResourceProvisioningStatusProvisioned,
+				coreapi.Tag{Name: TerraformOperationTagPrefix + "other-op", Value: "1"},
+			),
+			testWatcherFound(
+				coreapi.ResourceProvisioningStatusProvisioned,
+				coreapi.Tag{Name: operationTagKey, Value: "1"},
+			),
+		),
+		waiter: waiter,
+	}
+
+	var diagnostics diag.Diagnostics
+
+	if _, ok := watcher.WaitFor(context.Background(), operationTagKey, time.Minute, &diagnostics); !ok {
+		t.Fatalf("WaitFor() returned ok=false with diagnostics: %#v", diagnostics)
+	}
+
+	// Another operation's tag is not this update being observed.
+	if calls != 3 {
+		t.Fatalf("WaitFor() polled %d times, want 3", calls)
+	}
+}
+
+func TestUpdateStateWatcherFailsOnNotFound(t *testing.T) {
+	var calls int
+
+	watcher := UpdateStateWatcher[waitTestResource]{
+		ResourceTitle: "Test Resource",
+		ResourceName:  "test resource",
+		GetFunc:       testWatcherReads(&calls, testWatcherError(http.StatusNotFound)),
+		waiter:        newFakeClockWaiter(),
+	}
+
+	var diagnostics diag.Diagnostics
+
+	if _, ok := watcher.WaitFor(context.Background(), TerraformOperationTagPrefix+"op", time.Minute, &diagnostics); ok {
+		t.Fatal("WaitFor() returned ok=true for a resource that was not found")
+	}
+
+	if calls != 1 {
+		t.Fatalf("WaitFor() polled %d times, want to fail on the first", calls)
+	}
+}
+
+func TestDeleteStateWatcherTreatsNotFoundAsDeleted(t *testing.T) {
+	testCases := []struct {
+		name      string
+		reads     []func() (*waitTestResource, ResourceStatus, error)
+		wantOK    bool
+		wantCalls int
+	}{
+		{
+			name: "deleted once not found",
+			reads: []func() (*waitTestResource, ResourceStatus, error){
+				testWatcherFound(coreapi.ResourceProvisioningStatusDeprovisioning),
+				testWatcherFound(coreapi.ResourceProvisioningStatusDeprovisioning),
+				testWatcherError(http.StatusNotFound),
+			},
+			wantOK:    true,
+			wantCalls: 3,
+		},
+		{
+			name: "other errors fail",
+			reads: []func() (*waitTestResource, ResourceStatus, error){
+				testWatcherError(http.StatusInternalServerError),
+			},
+			wantCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls int
+
+			get := testWatcherReads(&calls, testCase.reads...)
+
+			watcher := DeleteStateWatcher{
+				ResourceTitle: "Test Resource",
+				ResourceName:  "test resource",
+				GetFunc: func(ctx context.Context) (any, ResourceStatus, error) {
+					return get(ctx)
+				},
+				waiter: newFakeClockWaiter(),
+			}
+
+			var response resource.DeleteResponse
+
+			if ok := watcher.Wait(context.Background(), tftimeouts.Value{}, &response); ok != testCase.wantOK {
+				t.Fatalf("Wait() = %t, want %t, with diagnostics: %#v", ok, testCase.wantOK, response.Diagnostics)
+			}
+
+			if calls != testCase.wantCalls {
+				t.Fatalf("Wait() polled %d times, want %d", calls, testCase.wantCalls)
+			}
+		})
+	}
+}
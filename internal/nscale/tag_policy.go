@@ -0,0 +1,82 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MissingRequiredTags returns the provider's required tag keys that are absent
+// from tags, in the order they were configured. An unknown map yields nothing:
+// its keys cannot be checked until apply, when the plan is re-run with the
+// final values.
+func (c *Client) MissingRequiredTags(tags types.Map) []string {
+	if len(c.RequiredTags) == 0 || tags.IsUnknown() {
+		return nil
+	}
+
+	elements := tags.Elements()
+
+	var missing []string
+	for _, key := range c.RequiredTags {
+		if _, ok := elements[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	return missing
+}
+
+// EnforceRequiredTags fails the plan of a tagged resource whose configuration
+// lacks any of the provider's required_tags. It reads the configuration rather
+// than the plan because tags is Optional+Computed on most resources, so an
+// omitted map plans as unknown on create and would otherwise slip through.
+func EnforceRequiredTags(
+	ctx context.Context,
+	client *Client,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	// Nothing to enforce on destroy, or before the provider is configured.
+	if request.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+
+	var tags types.Map
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if missing := client.MissingRequiredTags(tags); len(missing) > 0 {
+		response.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"Missing Required Tags",
+			fmt.Sprintf(
+				"The provider requires every taggable resource to set the tags [%s]. This resource is missing: %s.",
+				strings.Join(client.RequiredTags, ", "),
+				strings.Join(missing, ", "),
+			),
+		)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nscale
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMissingRequiredTags(t *testing.T) {
+	tagged := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"cost-center": types.StringValue("1234"),
+		"team":        types.StringUnknown(),
+	})
+
+	testCases := []struct {
+		name         string
+		requiredTags []string
+		tags         types.Map
+		wantMissing  []string
+	}{
+		{
+			name:         "no policy configured",
+			requiredTags: nil,
+			tags:         types.MapNull(types.StringType),
+			wantMissing:  nil,
+		},
+		{
+			name:         "all required keys present, including one with an unknown value",
+			requiredTags: []string{"team", "cost-center"},
+			tags:         tagged,
+			wantMissing:  nil,
+		},
+		{
+			name:         "reports missing keys in configured order",
+			requiredTags: []string{"owner", "cost-center", "env"},
+			tags:         tagged,
+			wantMissing:  []string{"owner", "env"},
+		},
+		{
+			name:         "null map is missing everything",
+			requiredTags: []string{"cost-center"},
+			tags:         types.MapNull(types.StringType),
+			wantMissing:  []string{"cost-center"},
+		},
+		{
+			name:         "unknown map is deferred to apply",
+			requiredTags: []string{"cost-center"},
+			tags:         types.MapUnknown(types.StringType),
+			wantMissing:  nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := &Client{RequiredTags: testCase.requiredTags}
+
+			got := client.MissingRequiredTags(testCase.tags)
+			if !slices.Equal(got, testCase.wantMissing) {
+				t.Fatalf("MissingRequiredTags() = %v, want %v", got, testCase.wantMissing)
+			}
+		})
+	}
+}
@@ -21,23 +21,37 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/functions"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/computecluster"
+	"github.com/nscaledev/terraform-provider-nscale/internal/services/event"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/filestorage"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/identity"
+	"github.com/nscaledev/terraform-provider-nscale/internal/services/image"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/instance"
+	"github.com/nscaledev/terraform-provider-nscale/internal/services/keypair"
+	"github.com/nscaledev/terraform-provider-nscale/internal/services/kubernetescluster"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/network"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/objectstorage"
+	"github.com/nscaledev/terraform-provider-nscale/internal/services/providerinfo"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/region"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/reservation"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/securitygroup"
 	"github.com/nscaledev/terraform-provider-nscale/internal/services/sshca"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 	"github.com/nscaledev/terraform-provider-nscale/version"
 )
 
@@ -47,9 +61,14 @@ const (
 	DefaultNscaleIdentityServiceAPIEndpoint    = "https://identity.unikorn.nscale.com"
 	DefaultNscaleReservationServiceAPIEndpoint = "https://reservation.unikorn.nscale.com"
 	DefaultNscaleStorageServiceAPIEndpoint     = "https://storage.unikorn.nscale.com"
+	DefaultNscaleKubernetesServiceAPIEndpoint  = "https://kubernetes.unikorn.nscale.com"
 )
 
-var _ provider.Provider = NscaleProvider{}
+var (
+	_ provider.Provider                       = NscaleProvider{}
+	_ provider.ProviderWithFunctions          = NscaleProvider{}
+	_ provider.ProviderWithEphemeralResources = NscaleProvider{}
+)
 
 type NscaleProviderModel struct {
 	RegionServiceAPIEndpoint      types.String `tfsdk:"region_service_api_endpoint"`
@@ -57,10 +76,20 @@ type NscaleProviderModel struct {
 	IdentityServiceAPIEndpoint    types.String `tfsdk:"identity_service_api_endpoint"`
 	ReservationServiceAPIEndpoint types.String `tfsdk:"reservation_service_api_endpoint"`
 	StorageServiceAPIEndpoint     types.String `tfsdk:"storage_service_api_endpoint"`
+	KubernetesServiceAPIEndpoint  types.String `tfsdk:"kubernetes_service_api_endpoint"`
 	ServiceToken                  types.String `tfsdk:"service_token"`
+	ProjectServiceTokens          types.Map    `tfsdk:"project_service_tokens"`
+	OIDCTokenFile                 types.String `tfsdk:"oidc_token_file"`
+	OIDCRequestURL                types.String `tfsdk:"oidc_request_url"`
+	OIDCRequestToken              types.String `tfsdk:"oidc_request_token"`
+	OIDCAudience                  types.String `tfsdk:"oidc_audience"`
 	RegionID                      types.String `tfsdk:"region_id"`
 	OrganizationID                types.String `tfsdk:"organization_id"`
 	ProjectID                     types.String `tfsdk:"project_id"`
+	RequiredTags                  types.List   `tfsdk:"required_tags"`
+	ValidateRegion                types.Bool   `tfsdk:"validate_region"`
+	WaitForDependencies           types.Bool   `tfsdk:"wait_for_dependencies"`
+	DisallowSensitiveInState      types.Bool   `tfsdk:"disallow_sensitive_in_state"`
 }
 
 type NscaleProvider struct{}
@@ -101,11 +130,41 @@ func (p NscaleProvider) Schema(ctx context.Context, request provider.SchemaReque
 				MarkdownDescription: "The endpoint of the Nscale Storage Service API server.",
 				Optional:            true,
 			},
+			"kubernetes_service_api_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The endpoint of the Nscale Kubernetes Service API server.",
+				Optional:            true,
+			},
 			"service_token": schema.StringAttribute{
 				MarkdownDescription: "The service token for authenticating with the Nscale API server.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"oidc_token_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a file holding an OIDC identity token issued by the CI platform, which is exchanged with the Nscale identity service for an API token when no service_token is set. Can also be set with the NSCALE_OIDC_TOKEN_FILE environment variable.",
+				Optional:            true,
+			},
+			"oidc_request_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to request an OIDC identity token from, which is exchanged with the Nscale identity service for an API token when no service_token or oidc_token_file is set. Can also be set with the NSCALE_OIDC_REQUEST_URL environment variable, and defaults to ACTIONS_ID_TOKEN_REQUEST_URL in GitHub Actions.",
+				Optional:            true,
+			},
+			"oidc_request_token": schema.StringAttribute{
+				MarkdownDescription: "The bearer token authenticating the request to oidc_request_url. Can also be set with the NSCALE_OIDC_REQUEST_TOKEN environment variable, and defaults to ACTIONS_ID_TOKEN_REQUEST_TOKEN in GitHub Actions.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oidc_audience": schema.StringAttribute{
+				MarkdownDescription: "The audience requested for the identity token from oidc_request_url. Can also be set with the NSCALE_OIDC_AUDIENCE environment variable. Defaults to the CI platform's default audience.",
+				Optional:            true,
+			},
+			"project_service_tokens": schema.MapAttribute{
+				MarkdownDescription: "A map of project identifiers to the service tokens to use for them, for projects whose resources service_token cannot manage. Requests for a resource in one of these projects use its token; all other requests, including organization-level ones, use service_token.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
 			"region_id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the region for which resources are managed. Regional resources include a top-level region_id field, allowing the region to be explicitly specified and to override the default region when provided.",
 				Optional:            true,
@@ -118,6 +177,30 @@ func (p NscaleProvider) Schema(ctx context.Context, request provider.SchemaReque
 				MarkdownDescription: "The default project identifier for project-scoped resources that do not set their own project_id. Optional: org-level workflows and configurations that set project_id on every resource do not need it.",
 				Optional:            true,
 			},
+			"validate_region": schema.BoolAttribute{
+				MarkdownDescription: "Whether to check, when the provider is configured, that region_id names a region available to the organization. A misconfigured region then fails early with the list of available regions, instead of surfacing as a not found error when a resource is created. Costs one API request each time the provider is configured. Defaults to `false`.",
+				Optional:            true,
+			},
+			"wait_for_dependencies": schema.BoolAttribute{
+				MarkdownDescription: "Whether to retry, within the resource's create timeout, the create of an instance, bastion or file storage that fails with a not found error. The API is eventually consistent, so a create that closely follows the creation of its network or security groups can fail while they are not yet visible to the service doing the create. Costs one API request per retry. Defaults to `false`.",
+				Optional:            true,
+			},
+			"disallow_sensitive_in_state": schema.BoolAttribute{
+				MarkdownDescription: "Whether to keep secrets out of Terraform state. The `ssh_private_key` of compute clusters and the `kubeconfig` of Kubernetes clusters are then stored as null, the `nscale_instance_ssh_key` and `nscale_compute_cluster_ssh_key` data sources fail in favour of the ephemeral resources of the same names, which Terraform does not store, and plans that create an `nscale_object_storage_access_key`, whose secret can only be kept in state, fail. Defaults to `false`.",
+				Optional:            true,
+			},
+			"required_tags": schema.ListAttribute{
+				MarkdownDescription: "A list of tag keys that every resource supporting tags must set. A resource missing any of them fails at plan time.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+						validators.NoReservedPrefix(nscale.TerraformOperationTagPrefix),
+					),
+				},
+			},
 		},
 	}
 }
@@ -136,6 +219,114 @@ func resolveValue(configValue, envVar, fallback string) string {
 	return value
 }
 
+// resolveOIDCCredentials returns where to get the CI platform's OIDC identity
+// token from, or nil when neither a token file nor a request URL is set. In
+// GitHub Actions the request URL and token default to the ones the runner
+// provides to jobs with the id-token: write permission.
+func resolveOIDCCredentials(data NscaleProviderModel) *nscale.OIDCCredentials {
+	credentials := nscale.OIDCCredentials{
+		TokenFile: resolveValue(data.OIDCTokenFile.ValueString(), "NSCALE_OIDC_TOKEN_FILE", ""),
+		RequestURL: resolveValue(
+			data.OIDCRequestURL.ValueString(),
+			"NSCALE_OIDC_REQUEST_URL",
+			os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"),
+		),
+		RequestToken: resolveValue(
+			data.OIDCRequestToken.ValueString(),
+			"NSCALE_OIDC_REQUEST_TOKEN",
+			os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN"),
+		),
+		Audience: resolveValue(data.OIDCAudience.ValueString(), "NSCALE_OIDC_AUDIENCE", ""),
+	}
+
+	if credentials.TokenFile == "" && credentials.RequestURL == "" {
+		return nil
+	}
+
+	return &credentials
+}
+
+// unknownSettings returns the provider settings whose configured value is not
+// known yet, typically because it refers to a resource that has not been
+// created. A setting overridden by its environment variable is not reported,
+// since the configured value would not be used anyway, and neither are the
+// settings of the authentication method that is not chosen. The second result
+// lists the settings the provider cannot be configured without.
+func unknownSettings(data NscaleProviderModel) (unknown, required []string) {
+	// A known service token takes precedence; otherwise OIDC is chosen once a
+	// token file or request URL is known. If neither is, both stay relevant.
+	usesServiceToken := resolveValue(data.ServiceToken.ValueString(), "NSCALE_SERVICE_TOKEN", "") != ""
+	usesOIDC := !usesServiceToken && resolveOIDCCredentials(data) != nil
+	unused := map[string]bool{
+		"service_token":      usesOIDC,
+		"oidc_token_file":    usesServiceToken,
+		"oidc_request_url":   usesServiceToken,
+		"oidc_request_token": usesServiceToken,
+		"oidc_audience":      usesServiceToken,
+	}
+
+	settings := []struct {
+		attribute string
+		value     types.String
+		envVar    string
+		optional  bool
+	}{
+		{"region_service_api_endpoint", data.RegionServiceAPIEndpoint, "NSCALE_REGION_SERVICE_API_ENDPOINT", false},
+		{"compute_service_api_endpoint", data.ComputeServiceAPIEndpoint, "NSCALE_COMPUTE_SERVICE_API_ENDPOINT", false},
+		{"identity_service_api_endpoint", data.IdentityServiceAPIEndpoint, "NSCALE_IDENTITY_SERVICE_API_ENDPOINT", false},
+		{
+			"reservation_service_api_endpoint",
+			data.ReservationServiceAPIEndpoint,
+			"NSCALE_RESERVATION_SERVICE_API_ENDPOINT",
+			false,
+		},
+		{"storage_service_api_endpoint", data.StorageServiceAPIEndpoint, "NSCALE_STORAGE_SERVICE_API_ENDPOINT", false},
+		{
+			"kubernetes_service_api_endpoint",
+			data.KubernetesServiceAPIEndpoint,
+			"NSCALE_KUBERNETES_SERVICE_API_ENDPOINT",
+			false,
+		},
+		{"service_token", data.ServiceToken, "NSCALE_SERVICE_TOKEN", false},
+		{"oidc_token_file", data.OIDCTokenFile, "NSCALE_OIDC_TOKEN_FILE", false},
+		{"oidc_request_url", data.OIDCRequestURL, "NSCALE_OIDC_REQUEST_URL", false},
+		{"oidc_request_token", data.OIDCRequestToken, "NSCALE_OIDC_REQUEST_TOKEN", false},
+		{"oidc_audience", data.OIDCAudience, "NSCALE_OIDC_AUDIENCE", false},
+		{"region_id", data.RegionID, "NSCALE_REGION_ID", false},
+		{"organization_id", data.OrganizationID, "NSCALE_ORGANIZATION_ID", false},
+		{"project_id", data.ProjectID, "NSCALE_PROJECT_ID", true},
+	}
+
+	for _, setting := range settings {
+		if unused[setting.attribute] || !setting.value.IsUnknown() {
+			continue
+		}
+		if _, ok := os.LookupEnv(setting.envVar); ok {
+			continue
+		}
+		unknown = append(unknown, setting.attribute)
+		if !setting.optional {
+			required = append(required, setting.attribute)
+		}
+	}
+
+	if data.RequiredTags.IsUnknown() {
+		unknown = append(unknown, "required_tags")
+	}
+
+	// A project's token may be unknown even when the map itself is not.
+	tokensUnknown := data.ProjectServiceTokens.IsUnknown()
+	for _, token := range data.ProjectServiceTokens.Elements() {
+		tokensUnknown = tokensUnknown || token.IsUnknown()
+	}
+	if tokensUnknown {
+		unknown = append(unknown, "project_service_tokens")
+		required = append(required, "project_service_tokens")
+	}
+
+	return unknown, required
+}
+
 func (p NscaleProvider) Configure(
 	ctx context.Context,
 	request provider.ConfigureRequest,
@@ -148,6 +339,35 @@ func (p NscaleProvider) Configure(
 		return
 	}
 
+	// Settings that come from other resources, such as an organization_id or
+	// project_id created in the same configuration, are unknown until apply.
+	// Terraform versions that support deferred actions plan the rest of the
+	// configuration first and defer everything managed by this provider to a
+	// later plan, once the values are known.
+	unknown, required := unknownSettings(data)
+	if len(unknown) > 0 && request.ClientCapabilities.DeferralAllowed {
+		response.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+		return
+	}
+
+	// Without deferral an unknown optional setting is treated as unset, as it
+	// always has been, but a required one cannot be guessed.
+	for _, attribute := range required {
+		response.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Unknown Provider Configuration",
+			fmt.Sprintf(
+				"The value of %s is not known until apply, so the provider cannot be configured. "+
+					"Set it to a known value, apply the resources it depends on first, "+
+					"or use a Terraform version that supports deferred actions.",
+				attribute,
+			),
+		)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	regionServiceAPIEndpoint := resolveValue(
 		data.RegionServiceAPIEndpoint.ValueString(),
 		"NSCALE_REGION_SERVICE_API_ENDPOINT",
@@ -175,11 +395,23 @@ func (p NscaleProvider) Configure(
 		DefaultNscaleStorageServiceAPIEndpoint,
 	)
 
-	serviceToken := resolveValue(data.ServiceToken.ValueString(), "NSCALE_SERVICE_TOKEN", "")
-	if serviceToken == "" {
+	kubernetesServiceAPIEndpoint := resolveValue(
+		data.KubernetesServiceAPIEndpoint.ValueString(),
+		"NSCALE_KUBERNETES_SERVICE_API_ENDPOINT",
+		DefaultNscaleKubernetesServiceAPIEndpoint,
+	)
+
+	credentials := nscale.Credentials{
+		ServiceToken: resolveValue(data.ServiceToken.ValueString(), "NSCALE_SERVICE_TOKEN", ""),
+	}
+	if credentials.ServiceToken == "" {
+		credentials.OIDC = resolveOIDCCredentials(data)
+	}
+	if credentials.ServiceToken == "" && credentials.OIDC == nil {
 		response.Diagnostics.AddError(
 			"Missing Service Token",
-			"Please provide a service token either through the configuration or the NSCALE_SERVICE_TOKEN environment variable.",
+			"Please provide a service token either through the configuration or the NSCALE_SERVICE_TOKEN environment variable, "+
+				"or configure oidc_token_file or oidc_request_url to authenticate with the CI platform's OIDC identity token.",
 		)
 		return
 	}
@@ -208,6 +440,21 @@ func (p NscaleProvider) Configure(
 	// value here is valid and keeps org-level and fully-explicit workflows working.
 	projectID := resolveValue(data.ProjectID.ValueString(), "NSCALE_PROJECT_ID", "")
 
+	var requiredTags []string
+	if !data.RequiredTags.IsNull() && !data.RequiredTags.IsUnknown() {
+		response.Diagnostics.Append(data.RequiredTags.ElementsAs(ctx, &requiredTags, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.ProjectServiceTokens.IsNull() {
+		response.Diagnostics.Append(data.ProjectServiceTokens.ElementsAs(ctx, &credentials.ProjectServiceTokens, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	userAgent := fmt.Sprintf(
 		"Terraform/%s terraform-provider-nscale/%s",
 		request.TerraformVersion,
@@ -220,11 +467,12 @@ func (p NscaleProvider) Configure(
 		identityServiceAPIEndpoint,
 		reservationServiceAPIEndpoint,
 		storageServiceAPIEndpoint,
-		serviceToken,
+		kubernetesServiceAPIEndpoint,
 		organizationID,
 		projectID,
 		regionID,
 		userAgent,
+		credentials,
 	)
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -234,8 +482,24 @@ func (p NscaleProvider) Configure(
 		return
 	}
 
+	client.RequiredTags = requiredTags
+	client.WaitForDependencies = data.WaitForDependencies.ValueBool()
+	client.DisallowSensitiveInState = data.DisallowSensitiveInState.ValueBool()
+
+	if data.ValidateRegion.ValueBool() {
+		if err := client.ValidateRegion(ctx); err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("region_id"),
+				"Invalid Region ID",
+				fmt.Sprintf("The configured region could not be validated: %s", err),
+			)
+			return
+		}
+	}
+
 	response.DataSourceData = client
 	response.ResourceData = client
+	response.EphemeralResourceData = client
 }
 
 func (p NscaleProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
@@ -243,13 +507,19 @@ func (p NscaleProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		region.NewRegionDataSource,
 		network.NewNetworkDataSource,
 		securitygroup.NewSecurityGroupDataSource,
+		securitygroup.NewSecurityGroupPresetDataSource,
 		filestorage.NewFileStorageClassDataSource,
 		filestorage.NewFileStorageDataSource,
 		instance.NewInstanceFlavorDataSource,
 		instance.NewInstanceDataSource,
 		instance.NewInstanceSSHKeyDataSource,
+		image.NewCatalogImagesDataSource,
 		sshca.NewSSHCertificateAuthorityDataSource,
 		computecluster.NewComputeClusterDataSource,
+		computecluster.NewComputeClusterSSHKeyDataSource,
+		keypair.NewKeypairDataSource,
+		event.NewResourceEventsDataSource,
+		providerinfo.NewProviderInfoDataSource,
 		objectstorage.NewObjectStorageEndpointClassDataSource,
 		objectstorage.NewObjectStorageEndpointDataSource,
 		objectstorage.NewObjectStorageAccessKeyDataSource,
@@ -263,16 +533,38 @@ func (p NscaleProvider) DataSources(ctx context.Context) []func() datasource.Dat
 func (p NscaleProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		network.NewNetworkResource,
+		network.NewIPAMPoolResource,
 		securitygroup.NewSecurityGroupResource,
+		securitygroup.NewSecurityGroupRuleResource,
 		filestorage.NewFileStorageResource,
 		instance.NewInstanceResource,
+		instance.NewBastionResource,
+		image.NewImageResource,
+		image.NewInstanceSnapshotResource,
 		sshca.NewSSHCertificateAuthorityResource,
 		computecluster.NewComputeClusterResource,
+		computecluster.NewComputeClusterWorkloadPoolResource,
+		kubernetescluster.NewKubernetesClusterResource,
 		objectstorage.NewObjectStorageEndpointResource,
 		objectstorage.NewObjectStorageAccessKeyResource,
 		identity.NewProjectResource,
 		identity.NewGroupResource,
+		identity.NewServiceAccountResource,
 		reservation.NewReservationResource,
 		reservation.NewPlacementResource,
 	}
 }
+
+func (p NscaleProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		instance.NewInstanceSSHKeyEphemeralResource,
+		computecluster.NewComputeClusterSSHKeyEphemeralResource,
+	}
+}
+
+func (p NscaleProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewIsValidNameFunction,
+		functions.NewNormalizeNameFunction,
+	}
+}
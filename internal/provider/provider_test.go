@@ -0,0 +1,215 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// providerEnvVars are the environment variables that override the provider
+// configuration; they are cleared so the host environment cannot leak in.
+//
+//nolint:gochecknoglobals // test fixture.
+var providerEnvVars = []string{
+	"NSCALE_REGION_SERVICE_API_ENDPOINT",
+	"NSCALE_COMPUTE_SERVICE_API_ENDPOINT",
+	"NSCALE_IDENTITY_SERVICE_API_ENDPOINT",
+	"NSCALE_RESERVATION_SERVICE_API_ENDPOINT",
+	"NSCALE_STORAGE_SERVICE_API_ENDPOINT",
+	"NSCALE_KUBERNETES_SERVICE_API_ENDPOINT",
+	"NSCALE_SERVICE_TOKEN",
+	"NSCALE_REGION_ID",
+	"NSCALE_ORGANIZATION_ID",
+	"NSCALE_PROJECT_ID",
+	"NSCALE_OIDC_TOKEN_FILE",
+	"NSCALE_OIDC_REQUEST_URL",
+	"NSCALE_OIDC_REQUEST_TOKEN",
+	"NSCALE_OIDC_AUDIENCE",
+	"ACTIONS_ID_TOKEN_REQUEST_URL",
+	"ACTIONS_ID_TOKEN_REQUEST_TOKEN",
+}
+
+func clearProviderEnv(t *testing.T) {
+	t.Helper()
+
+	for _, envVar := range providerEnvVars {
+		t.Setenv(envVar, "")
+		os.Unsetenv(envVar)
+	}
+}
+
+// testConfig returns a provider configuration with the minimum settings known,
+// overridden by values.
+func testConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var schemaResponse provider.SchemaResponse
+	New().Schema(context.Background(), provider.SchemaRequest{}, &schemaResponse)
+
+	objectType, ok := schemaResponse.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatal("provider schema is not an object")
+	}
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["service_token"] = tftypes.NewValue(tftypes.String, "token")
+	attributes["region_id"] = tftypes.NewValue(tftypes.String, "region")
+	attributes["organization_id"] = tftypes.NewValue(tftypes.String, "organization")
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	unknownString := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	testCases := []struct {
+		name            string
+		values          map[string]tftypes.Value
+		env             map[string]string
+		deferralAllowed bool
+		wantDeferred    bool
+		wantError       bool
+	}{
+		{
+			name:   "all known",
+			values: nil,
+		},
+		{
+			name:            "unknown organization deferred",
+			values:          map[string]tftypes.Value{"organization_id": unknownString},
+			deferralAllowed: true,
+			wantDeferred:    true,
+		},
+		{
+			name:      "unknown organization without deferral",
+			values:    map[string]tftypes.Value{"organization_id": unknownString},
+			wantError: true,
+		},
+		{
+			name:   "unknown organization overridden by the environment",
+			values: map[string]tftypes.Value{"organization_id": unknownString},
+			env:    map[string]string{"NSCALE_ORGANIZATION_ID": "organization"},
+		},
+		{
+			name:            "unknown project deferred",
+			values:          map[string]tftypes.Value{"project_id": unknownString},
+			deferralAllowed: true,
+			wantDeferred:    true,
+		},
+		{
+			name:   "unknown project without deferral is treated as unset",
+			values: map[string]tftypes.Value{"project_id": unknownString},
+		},
+		{
+			name:      "no service token",
+			values:    map[string]tftypes.Value{"service_token": tftypes.NewValue(tftypes.String, nil)},
+			wantError: true,
+		},
+		{
+			name:   "no service token in GitHub Actions",
+			values: map[string]tftypes.Value{"service_token": tftypes.NewValue(tftypes.String, nil)},
+			env: map[string]string{
+				"ACTIONS_ID_TOKEN_REQUEST_URL":   "https://token.actions.example.com/",
+				"ACTIONS_ID_TOKEN_REQUEST_TOKEN": "request-token",
+			},
+		},
+		{
+			name: "unknown service token with OIDC",
+			values: map[string]tftypes.Value{
+				"service_token":   unknownString,
+				"oidc_token_file": tftypes.NewValue(tftypes.String, "/var/run/oidc/token"),
+			},
+		},
+		{
+			name: "unknown service token with OIDC deferral allowed",
+			values: map[string]tftypes.Value{
+				"service_token":   unknownString,
+				"oidc_token_file": tftypes.NewValue(tftypes.String, "/var/run/oidc/token"),
+			},
+			deferralAllowed: true,
+		},
+		{
+			name:   "unknown OIDC audience with a service token",
+			values: map[string]tftypes.Value{"oidc_audience": unknownString},
+		},
+		{
+			name:            "unknown OIDC audience with a service token deferral allowed",
+			values:          map[string]tftypes.Value{"oidc_audience": unknownString},
+			deferralAllowed: true,
+		},
+		{
+			name: "unknown OIDC audience with OIDC",
+			values: map[string]tftypes.Value{
+				"service_token":   tftypes.NewValue(tftypes.String, nil),
+				"oidc_token_file": tftypes.NewValue(tftypes.String, "/var/run/oidc/token"),
+				"oidc_audience":   unknownString,
+			},
+			wantError: true,
+		},
+		{
+			name:      "unknown service token without OIDC",
+			values:    map[string]tftypes.Value{"service_token": unknownString},
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			clearProviderEnv(t)
+			for envVar, value := range testCase.env {
+				t.Setenv(envVar, value)
+			}
+
+			request := provider.ConfigureRequest{
+				Config: testConfig(t, testCase.values),
+				ClientCapabilities: provider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: testCase.deferralAllowed,
+				},
+			}
+
+			var response provider.ConfigureResponse
+			New().Configure(context.Background(), request, &response)
+
+			if got := response.Diagnostics.HasError(); got != testCase.wantError {
+				t.Fatalf("Configure() error = %v, want %v: %v", got, testCase.wantError, response.Diagnostics)
+			}
+
+			if got := response.Deferred != nil; got != testCase.wantDeferred {
+				t.Fatalf("Configure() deferred = %v, want %v", got, testCase.wantDeferred)
+			}
+
+			if configured := response.ResourceData != nil; configured == (testCase.wantError || testCase.wantDeferred) {
+				t.Fatalf("Configure() configured a client = %v, want %v", configured, !configured)
+			}
+		})
+	}
+}
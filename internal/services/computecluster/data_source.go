@@ -19,35 +19,70 @@ package computecluster
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
 
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 )
 
 var _ datasource.DataSourceWithConfigure = &ComputeClusterDataSource{}
 
+// ComputeClusterDataSourceModel adds the cluster's project, by which the data
+// source looks it up, to the model it shares with the resource.
+type ComputeClusterDataSourceModel struct {
+	ComputeClusterModel
+
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+func NewComputeClusterDataSourceModel(source *computeapi.ComputeClusterRead) ComputeClusterDataSourceModel {
+	model := ComputeClusterDataSourceModel{
+		ComputeClusterModel: NewComputeClusterModel(source),
+		ProjectID:           types.StringValue(source.Metadata.ProjectId),
+	}
+
+	// The data source always lists the machines, which the summaries would
+	// only repeat.
+	model.WorkloadPools = withoutMachineSummaries(model.WorkloadPools)
+
+	return model
+}
+
 // ComputeClusterDataSource embeds the generic read+map base; only Schema and
 // the adapter wiring below are compute-cluster-specific.
 type ComputeClusterDataSource struct {
-	*nscale.GenericDataSource[ComputeClusterModel, computeapi.ComputeClusterRead]
+	*nscale.GenericDataSource[ComputeClusterDataSourceModel, computeapi.ComputeClusterRead]
 }
 
 func NewComputeClusterDataSource() datasource.DataSource {
 	return &ComputeClusterDataSource{
 		GenericDataSource: nscale.NewGenericDataSource(
-			nscale.DataSourceAdapter[ComputeClusterModel, computeapi.ComputeClusterRead]{
-				TypeNameSuffix: "_compute_cluster",
-				Title:          "Compute Cluster",
-				Name:           "compute cluster",
+			nscale.DataSourceAdapter[ComputeClusterDataSourceModel, computeapi.ComputeClusterRead]{
+				TypeNameSuffix:  "_compute_cluster",
+				Title:           "Compute Cluster",
+				Name:            "compute cluster",
+				RequiredFeature: nscale.ComputeClusterAPIV1,
 				Get: func(ctx context.Context, client *nscale.Client, id string) (*computeapi.ComputeClusterRead, error) {
-					cluster, _, err := getComputeCluster(ctx, client.OrganizationID, id, client)
+					cluster, _, err := getComputeCluster(ctx, client.OrganizationID, nscale.ProjectIDFromContext(ctx), id, client)
 					return cluster, err
 				},
-				ToModel:     NewComputeClusterModel,
-				IDFromModel: func(m ComputeClusterModel) string { return m.ID.ValueString() },
+				ToModel: NewComputeClusterDataSourceModel,
+				Enrich: func(ctx context.Context, client *nscale.Client, dst *ComputeClusterDataSourceModel) diag.Diagnostics {
+					var diagnostics diag.Diagnostics
+					dst.WorkloadPools, diagnostics = withSSHConnections(
+						ctx, client, dst.RegionID.ValueString(), dst.WorkloadPools,
+					)
+					return diagnostics
+				},
+				IDFromModel:         func(m ComputeClusterDataSourceModel) string { return m.ID.ValueString() },
+				SensitiveAttributes: []path.Path{path.Root("ssh_private_key")},
 			},
 		),
 	}
@@ -58,6 +93,37 @@ func (s *ComputeClusterDataSource) Schema(
 	request datasource.SchemaRequest,
 	response *datasource.SchemaResponse,
 ) {
+	machine := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the machine.",
+				Computed:            true,
+			},
+			"private_ip": schema.StringAttribute{
+				MarkdownDescription: "The private IP address of the machine.",
+				Computed:            true,
+			},
+			"public_ip": schema.StringAttribute{
+				MarkdownDescription: "The public IP address of the machine, if assigned.",
+				Computed:            true,
+			},
+			"ssh_connection": schema.SingleNestedAttribute{
+				MarkdownDescription: machineSSHConnectionDescription,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The address to connect to.",
+						Computed:            true,
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "The user to connect as.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
 	response.Schema = schema.Schema{
 		DeprecationMessage:  "The nscale_compute_cluster data source is deprecated and will be removed in a future release.",
 		MarkdownDescription: "Nscale Compute Cluster",
@@ -66,6 +132,11 @@ func (s *ComputeClusterDataSource) Schema(
 				MarkdownDescription: "A unique identifier for the compute cluster.",
 				Required:            true,
 			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project the compute cluster belongs to. Defaults to the provider's `project_id`. When neither is set, the cluster is looked up across all projects of the organization.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the compute cluster.",
 				Computed:            true,
@@ -100,13 +171,51 @@ func (s *ComputeClusterDataSource) Schema(
 						// 	Computed:            true,
 						// },
 						"user_data": schema.StringAttribute{
-							MarkdownDescription: "The data to pass to the VMs at boot time.",
+							MarkdownDescription: "The base64-encoded data to pass to the VMs at boot time.",
+							CustomType:          tftypes.Base64StringType{},
+							Computed:            true,
+						},
+						"hostname_pattern": schema.StringAttribute{
+							MarkdownDescription: "The hostname pattern applied to the VMs in this workload pool, if any.",
+							Computed:            true,
+						},
+						"extra_spec_json": schema.StringAttribute{
+							MarkdownDescription: "Always null: extra specs are not read back from the API.",
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+						},
+						"image_update_policy": schema.StringAttribute{
+							MarkdownDescription: "Always null: image update policies are kept by the resource managing the workload pool, not by the API.",
+							Computed:            true,
+						},
+						"gpu_driver_version": schema.StringAttribute{
+							MarkdownDescription: "Always null: pinned GPU driver versions are kept by the resource managing the workload pool, not by the API.",
+							Computed:            true,
+						},
+						"cuda_version": schema.StringAttribute{
+							MarkdownDescription: "Always null: pinned CUDA versions are kept by the resource managing the workload pool, not by the API.",
 							Computed:            true,
 						},
 						"enable_public_ip": schema.BoolAttribute{
 							MarkdownDescription: "Whether to assign a public IP address to each VM in this workload pool.",
 							Computed:            true,
 						},
+						"allowed_address_pairs": schema.SetNestedAttribute{
+							MarkdownDescription: "Allowed addresses that can pass through this workload pool's network ports.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"cidr": schema.StringAttribute{
+										MarkdownDescription: "The allowed CIDR prefix.",
+										Computed:            true,
+									},
+									"mac_address": schema.StringAttribute{
+										MarkdownDescription: "The allowed MAC address, if any.",
+										Computed:            true,
+									},
+								},
+							},
+						},
 						"firewall_rules": schema.ListNestedAttribute{
 							MarkdownDescription: "A list of firewall rules applied to the VMs in this workload pool.",
 							Computed:            true,
@@ -124,6 +233,14 @@ func (s *ComputeClusterDataSource) Schema(
 										MarkdownDescription: "The ports to which this firewall rule applies. This can be a single port, or a range of ports.",
 										Computed:            true,
 									},
+									"from_port": schema.Int32Attribute{
+										MarkdownDescription: "The first port of the range of ports to which this firewall rule applies.",
+										Computed:            true,
+									},
+									"to_port": schema.Int32Attribute{
+										MarkdownDescription: "The last port of the range of ports to which this firewall rule applies.",
+										Computed:            true,
+									},
 									"prefixes": schema.SetAttribute{
 										MarkdownDescription: "A set of CIDR prefixes to which this firewall rule applies.",
 										ElementType:         types.StringType,
@@ -135,28 +252,34 @@ func (s *ComputeClusterDataSource) Schema(
 						"machines": schema.ListNestedAttribute{
 							MarkdownDescription: "A list of machines in this workload pool.",
 							Computed:            true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"hostname": schema.StringAttribute{
-										MarkdownDescription: "The hostname of the machine.",
-										Computed:            true,
-									},
-									"private_ip": schema.StringAttribute{
-										MarkdownDescription: "The private IP address of the machine.",
-										Computed:            true,
-									},
-									"public_ip": schema.StringAttribute{
-										MarkdownDescription: "The public IP address of the machine, if assigned.",
-										Computed:            true,
-									},
-								},
-							},
+							NestedObject:        machine,
+						},
+						"machines_by_hostname": schema.MapNestedAttribute{
+							MarkdownDescription: "The machines in this workload pool by hostname, for `for_each` in other resources that keeps each machine's key when the pool is scaled.",
+							Computed:            true,
+							NestedObject:        machine,
+						},
+						"machine_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of machines in this workload pool. " + machineSummaryDescription,
+							Computed:            true,
+						},
+						"private_ips": schema.ListAttribute{
+							MarkdownDescription: "The private IP addresses of the machines in this workload pool. " +
+								machineSummaryDescription,
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"public_ips": schema.ListAttribute{
+							MarkdownDescription: "The public IP addresses of the machines in this workload pool that have one. " +
+								machineSummaryDescription,
+							ElementType: types.StringType,
+							Computed:    true,
 						},
 					},
 				},
 			},
 			"ssh_private_key": schema.StringAttribute{
-				MarkdownDescription: "The SSH private key for accessing the compute cluster.",
+				MarkdownDescription: "The SSH private key for accessing the compute cluster. Null when the provider is configured with `disallow_sensitive_in_state`.",
 				Computed:            true,
 				Sensitive:           true,
 			},
@@ -173,8 +296,26 @@ func (s *ComputeClusterDataSource) Schema(
 				MarkdownDescription: "The provisioning status of the compute cluster.",
 				Computed:            true,
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("compute cluster"),
+				Computed:            true,
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the compute cluster was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the compute cluster.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the compute cluster.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the compute cluster was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
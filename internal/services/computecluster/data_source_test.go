@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+)
+
+// TestDataSourceSchemaMatchesModel sets a fully populated model into the data
+// source's state, which fails if the schema lacks any attribute of the model.
+func TestDataSourceSchemaMatchesModel(t *testing.T) {
+	var schemaResponse datasource.SchemaResponse
+	NewComputeClusterDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &schemaResponse)
+
+	cluster := testComputeCluster()
+	cluster.Spec.WorkloadPools[0].Machine.AllowedAddressPairs = &computeapi.AllowedAddressPairList{
+		{Cidr: "10.0.0.0/24"},
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(context.Background()), nil),
+	}
+
+	model := NewComputeClusterDataSourceModel(cluster)
+	if diagnostics := state.Set(context.Background(), &model); diagnostics.HasError() {
+		t.Fatalf("the data source schema does not match ComputeClusterDataSourceModel: %v", diagnostics)
+	}
+}
+
+func TestSSHKeyDataSourceSchemaMatchesModel(t *testing.T) {
+	var schemaResponse datasource.SchemaResponse
+	NewComputeClusterSSHKeyDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &schemaResponse)
+
+	privateKey := "private-key"
+	cluster := testComputeCluster()
+	cluster.Status.SshPrivateKey = &privateKey
+
+	state := tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(context.Background()), nil),
+	}
+
+	model := NewComputeClusterSSHKeyModel(cluster)
+	if diagnostics := state.Set(context.Background(), &model); diagnostics.HasError() {
+		t.Fatalf("the data source schema does not match ComputeClusterSSHKeyModel: %v", diagnostics)
+	}
+
+	if model.ClusterID.ValueString() != cluster.Metadata.Id || model.PrivateKey.ValueString() != privateKey {
+		t.Fatalf("model = %+v, want the cluster ID and its private key", model)
+	}
+}
@@ -17,46 +17,491 @@ limitations under the License.
 package computecluster
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	common "github.com/nscaledev/nscale-sdk-go/common"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
 
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 )
 
+// getComputeCluster reads a compute cluster. Given the cluster's project it
+// reads the cluster directly; otherwise it finds the cluster in the list of the
+// organization's clusters, which is far larger and may span many projects.
 func getComputeCluster(
 	ctx context.Context,
-	organizationID, id string,
+	organizationID, projectID, id string,
 	client *nscale.Client,
 ) (*computeapi.ComputeClusterRead, *common.ProjectScopedResourceReadMetadata, error) {
+	computeCluster, err := readComputeCluster(ctx, organizationID, projectID, id, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &computeCluster.ComputeClusterRead, commonReadMetadataFromLegacy(&computeCluster.Metadata), nil
+}
+
+// getComputeClusterForWrite reads a compute cluster as getComputeCluster does,
+// along with its workload pools as the API returned them, keyed by name. A write
+// built from the cluster passes the pools to clusterRequestBody, which keeps the
+// fields the client does not model, such as those set by extra specs.
+func getComputeClusterForWrite(
+	ctx context.Context,
+	client *nscale.Client,
+	id string,
+) (*computeapi.ComputeClusterRead, map[string]map[string]any, error) {
+	computeCluster, err := readComputeCluster(ctx, client.OrganizationID, client.ProjectID, id, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &computeCluster.ComputeClusterRead, computeCluster.workloadPools, nil
+}
+
+// computeClusterJSON is a compute cluster decoded both into the client's type
+// and, for its workload pools, into generic JSON objects keyed by pool name.
+type computeClusterJSON struct {
+	computeapi.ComputeClusterRead
+
+	workloadPools map[string]map[string]any
+}
+
+func (c *computeClusterJSON) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.ComputeClusterRead); err != nil {
+		return err
+	}
+
+	var object struct {
+		Spec struct {
+			WorkloadPools []map[string]any `json:"workloadPools"`
+		} `json:"spec"`
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&object); err != nil {
+		return err
+	}
+
+	c.workloadPools = map[string]map[string]any{}
+	for _, pool := range object.Spec.WorkloadPools {
+		if name, ok := pool["name"].(string); ok {
+			c.workloadPools[name] = pool
+		}
+	}
+
+	return nil
+}
+
+// readComputeCluster reads a compute cluster for getComputeCluster and
+// getComputeClusterForWrite.
+func readComputeCluster(
+	ctx context.Context,
+	organizationID, projectID, id string,
+	client *nscale.Client,
+) (*computeClusterJSON, error) {
+	if projectID != "" {
+		computeClusterResponse, err := client.LegacyCompute.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(
+			ctx,
+			organizationID,
+			projectID,
+			id,
+		)
+		if err != nil {
+			return nil, err
+		}
+		defer computeClusterResponse.Body.Close()
+
+		return nscale.ReadJSONResponsePointer[computeClusterJSON](computeClusterResponse)
+	}
+
 	computeClusterListResponse, err := client.LegacyCompute.GetApiV1OrganizationsOrganizationIDClusters(
 		ctx,
 		organizationID,
 		nil,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	defer computeClusterListResponse.Body.Close()
 
-	computeClusters, err := nscale.ReadJSONResponseValue[[]computeapi.ComputeClusterRead](computeClusterListResponse)
+	computeClusters, err := nscale.ReadJSONResponseValue[[]computeClusterJSON](computeClusterListResponse)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	for _, computeCluster := range computeClusters {
 		if computeCluster.Metadata.Id == id {
-			return &computeCluster, commonReadMetadataFromLegacy(&computeCluster.Metadata), nil
+			return &computeCluster, nil
 		}
 	}
 
-	err = &nscale.APIError{
+	return nil, &nscale.APIError{
 		StatusCode: http.StatusNotFound,
 		Message:    fmt.Sprintf("failed to find compute cluster '%s' in the list response", id),
 	}
+}
+
+// changedAttributes names the configurable attributes that differ between two
+// reads of a cluster. Machines are observed rather than configured, and the
+// pool settings the provider keeps are not read back, so pools are compared
+// without them. An empty description is read back as null, so the two are
+// equal.
+func changedAttributes(before, after ComputeClusterModel) []string {
+	var changed []string
+
+	if !before.Name.Equal(after.Name) {
+		changed = append(changed, "name")
+	}
+
+	if !before.Description.Equal(tftypes.StringWithPriorEmpty(after.Description, before.Description)) {
+		changed = append(changed, "description")
+	}
+
+	if !before.Tags.Equal(after.Tags) {
+		changed = append(changed, "tags")
+	}
+
+	if !withoutMachines(withoutPoolSettings(before.WorkloadPools)).Equal(
+		withoutMachines(withoutPoolSettings(after.WorkloadPools)),
+	) {
+		changed = append(changed, "workload_pools")
+	}
+
+	return changed
+}
+
+// metadataOnly reports whether plan only changes the name, description or
+// tags of prior, leaving its region and the configuration of its pools, extra
+// specs included, as they are.
+func metadataOnly(prior, plan ComputeClusterModel) bool {
+	return prior.RegionID.Equal(plan.RegionID) &&
+		withoutMachines(prior.WorkloadPools).Equal(withoutMachines(plan.WorkloadPools))
+}
+
+// withoutMachines returns the pools with every attribute observed from their
+// machines set to null.
+func withoutMachines(pools types.List) types.List {
+	return withPoolAttributes(pools, nullMachineAttributes())
+}
+
+// nullMachineAttributes returns a null value for each pool attribute observed
+// from its machines.
+func nullMachineAttributes() map[string]attr.Value {
+	return map[string]attr.Value{
+		"machines":             types.ListNull(MachineModelAttributeType),
+		"machines_by_hostname": types.MapNull(MachineModelAttributeType),
+		"machine_count":        types.Int64Null(),
+		"private_ips":          types.ListNull(types.StringType),
+		"public_ips":           types.ListNull(types.StringType),
+	}
+}
+
+// withoutPoolSettings returns the pools with the settings the provider keeps
+// rather than the API, their extra specs, image update policies and pinned
+// versions, set to null.
+func withoutPoolSettings(pools types.List) types.List {
+	return withPoolAttributes(pools, map[string]attr.Value{
+		"extra_spec_json":     jsontypes.NewNormalizedNull(),
+		"image_update_policy": types.StringNull(),
+		"gpu_driver_version":  types.StringNull(),
+		"cuda_version":        types.StringNull(),
+	})
+}
+
+// withPoolSettings returns the pools with the extra spec, image update policy
+// and pinned versions of the pool of the same name in source, as the API does
+// not return them. A pool source does not have, as on import, gets the default
+// policy.
+func withPoolSettings(pools, source types.List) types.List {
+	if pools.IsNull() || pools.IsUnknown() {
+		return pools
+	}
+
+	sourcePools := poolsByName(source)
+
+	elements := make([]attr.Value, 0, len(pools.Elements()))
+	for _, element := range pools.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok || pool.IsNull() || pool.IsUnknown() {
+			elements = append(elements, element)
+			continue
+		}
+
+		settings := map[string]attr.Value{
+			"image_update_policy": types.StringValue(imageUpdatePolicyIgnore),
+		}
+
+		name, _ := pool.Attributes()["name"].(types.String)
+		if sourcePool, found := sourcePools[name.ValueString()]; found {
+			settings["extra_spec_json"] = sourcePool.Attributes()["extra_spec_json"]
+			settings["gpu_driver_version"] = sourcePool.Attributes()["gpu_driver_version"]
+			settings["cuda_version"] = sourcePool.Attributes()["cuda_version"]
+			if policy, ok := sourcePool.Attributes()["image_update_policy"].(types.String); ok && !policy.IsNull() {
+				settings["image_update_policy"] = policy
+			}
+		}
+
+		elements = append(elements, withAttributes(pool, settings))
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, elements)
+}
+
+// publicIPsSettled reports whether the machines of each of the pools have
+// public IP addresses exactly when the pool enables them.
+func publicIPsSettled(cluster *computeapi.ComputeClusterRead, pools types.List) bool {
+	for name, pool := range poolsByName(pools) {
+		enabled, _ := pool.Attributes()["enable_public_ip"].(types.Bool)
+		if !poolPublicIPsSettled(cluster, name, enabled) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// poolPublicIPsSettled reports whether the machines of the named pool have
+// public IP addresses exactly when enabled is true. The API attaches and
+// detaches them some time after an update is observed.
+func poolPublicIPsSettled(cluster *computeapi.ComputeClusterRead, name string, enabled types.Bool) bool {
+	if enabled.IsNull() || enabled.IsUnknown() {
+		return true
+	}
+
+	_, status := findWorkloadPool(cluster, name)
+	if status == nil || status.Machines == nil {
+		return true
+	}
+
+	for _, machine := range *status.Machines {
+		if (machine.PublicIP != nil) != enabled.ValueBool() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// poolExtraSpecs maps the name of each pool to its extra spec, or to an empty
+// string if it has none.
+func poolExtraSpecs(pools types.List) map[string]string {
+	extraSpecs := map[string]string{}
+	for name, pool := range poolsByName(pools) {
+		extraSpecs[name] = ""
+
+		extraSpec, ok := pool.Attributes()["extra_spec_json"].(jsontypes.Normalized)
+		if ok && !extraSpec.IsNull() && !extraSpec.IsUnknown() {
+			extraSpecs[name] = extraSpec.ValueString()
+		}
+	}
+
+	return extraSpecs
+}
+
+// clusterRequestBody encodes a cluster write. The pools named in extraSpecs are
+// the ones the writer configures, and get their extra spec merged in. Every
+// other pool keeps the fields of its current object, from
+// getComputeClusterForWrite, that an extra spec could have set, so a write from
+// one resource does not drop the extra spec fields of the pools another manages.
+func clusterRequestBody(
+	request computeapi.ComputeClusterWrite,
+	current map[string]map[string]any,
+	extraSpecs map[string]string,
+) (io.Reader, error) {
+	body, err := nscale.JSONObject(request)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, _ := body["spec"].(map[string]any)
+	pools, _ := spec["workloadPools"].([]any)
+	for _, element := range pools {
+		pool, ok := element.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _ := pool["name"].(string)
+
+		extraSpec, configured := extraSpecs[name]
+		if !configured {
+			nscale.KeepUnmanagedFields(pool, current[name], workloadPoolManagedFields)
+			continue
+		}
+
+		if err = nscale.MergeExtraSpec(pool, extraSpec); err != nil {
+			return nil, fmt.Errorf("workload pool '%s': %w", name, err)
+		}
+	}
+
+	return nscale.JSONBody(body)
+}
+
+// withoutMachineDetails returns the pools with their per-machine objects
+// dropped, keeping the machine counts and IP address lists.
+func withoutMachineDetails(pools types.List) types.List {
+	return withPoolAttributes(pools, map[string]attr.Value{
+		"machines":             types.ListNull(MachineModelAttributeType),
+		"machines_by_hostname": types.MapNull(MachineModelAttributeType),
+	})
+}
+
+// withoutMachineSummaries returns the pools with their machine counts and IP
+// address lists, which only stand in for the per-machine objects when those
+// are not stored, set to null.
+func withoutMachineSummaries(pools types.List) types.List {
+	return withPoolAttributes(pools, map[string]attr.Value{
+		"machine_count": types.Int64Null(),
+		"private_ips":   types.ListNull(types.StringType),
+		"public_ips":    types.ListNull(types.StringType),
+	})
+}
+
+// withPoolAttributes returns the pools with the given attributes replaced.
+func withPoolAttributes(pools types.List, replacements map[string]attr.Value) types.List {
+	if pools.IsNull() || pools.IsUnknown() {
+		return pools
+	}
+
+	elements := make([]attr.Value, 0, len(pools.Elements()))
+	for _, element := range pools.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok {
+			elements = append(elements, element)
+			continue
+		}
+
+		elements = append(elements, withAttributes(pool, replacements))
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, elements)
+}
+
+// withAttributes returns the pool with the given attributes replaced.
+func withAttributes(pool types.Object, replacements map[string]attr.Value) types.Object {
+	attributes := maps.Clone(pool.Attributes())
+	maps.Copy(attributes, replacements)
+	return types.ObjectValueMust(WorkloadPoolModelAttributeType.AttrTypes, attributes)
+}
+
+// withoutPoolMachines returns the pool with every attribute observed from its
+// machines set to null.
+func withoutPoolMachines(pool types.Object) types.Object {
+	return withAttributes(pool, nullMachineAttributes())
+}
+
+// poolsByName indexes the known pools of a list by name.
+func poolsByName(pools types.List) map[string]types.Object {
+	byName := map[string]types.Object{}
+	for _, element := range pools.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok || pool.IsNull() || pool.IsUnknown() {
+			continue
+		}
+
+		if name, ok := pool.Attributes()["name"].(types.String); ok && !name.IsNull() && !name.IsUnknown() {
+			byName[name.ValueString()] = pool
+		}
+	}
+
+	return byName
+}
+
+// withUnchangedPoolMachines returns the planned pools with the machines of
+// each pool whose configuration is the same as in state copied from state.
+func withUnchangedPoolMachines(plan, state types.List) types.List {
+	statePools := poolsByName(state)
+
+	elements := make([]attr.Value, 0, len(plan.Elements()))
+	for _, element := range plan.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok || pool.IsNull() || pool.IsUnknown() {
+			elements = append(elements, element)
+			continue
+		}
+
+		name, _ := pool.Attributes()["name"].(types.String)
+		prior, found := statePools[name.ValueString()]
+		if !found || !withoutPoolMachines(pool).Equal(withoutPoolMachines(prior)) {
+			elements = append(elements, element)
+			continue
+		}
+
+		machineAttributes := map[string]attr.Value{}
+		for attribute := range nullMachineAttributes() {
+			machineAttributes[attribute] = prior.Attributes()[attribute]
+		}
+		elements = append(elements, withAttributes(pool, machineAttributes))
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, elements)
+}
+
+// machinesReplaced reports whether a machine of the prior pools is missing from
+// the current ones, as when the platform replaces a failed machine. Machines
+// are identified by hostname, or by private IP address when either side does
+// not store machine details. Pools that were removed, or whose machines are not
+// known, are skipped: their changes come from the configuration.
+func machinesReplaced(prior, current types.List) bool {
+	if prior.IsNull() || prior.IsUnknown() || current.IsNull() || current.IsUnknown() {
+		return false
+	}
+
+	currentPools := poolsByName(current)
+	for name, priorPool := range poolsByName(prior) {
+		currentPool, ok := currentPools[name]
+		if !ok {
+			continue
+		}
+
+		for _, attribute := range []string{"machines", "private_ips"} {
+			priorMachines, _ := priorPool.Attributes()[attribute].(types.List)
+			currentMachines, _ := currentPool.Attributes()[attribute].(types.List)
+			if priorMachines.IsNull() || priorMachines.IsUnknown() ||
+				currentMachines.IsNull() || currentMachines.IsUnknown() {
+				continue
+			}
+
+			remaining := machineIdentifiers(currentMachines)
+			for identifier := range machineIdentifiers(priorMachines) {
+				if _, ok := remaining[identifier]; !ok {
+					return true
+				}
+			}
+
+			break
+		}
+	}
+
+	return false
+}
+
+// machineIdentifiers returns the hostnames of a list of machines, or the
+// addresses of a list of IP addresses.
+func machineIdentifiers(machines types.List) map[string]struct{} {
+	identifiers := map[string]struct{}{}
+	for _, element := range machines.Elements() {
+		switch value := element.(type) {
+		case types.Object:
+			if hostname, ok := value.Attributes()["hostname"].(types.String); ok {
+				identifiers[hostname.ValueString()] = struct{}{}
+			}
+		case types.String:
+			identifiers[value.ValueString()] = struct{}{}
+		}
+	}
 
-	return nil, nil, err
+	return identifiers
 }
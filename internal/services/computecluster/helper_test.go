@@ -0,0 +1,609 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	legacycore "github.com/unikorn-cloud/core/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/pointer"
+)
+
+func testComputeCluster() *computeapi.ComputeClusterRead {
+	machines := computeapi.ComputeClusterMachinesStatus{{Hostname: "default-0"}}
+	statuses := computeapi.ComputeClusterWorkloadPoolsStatus{{Name: "default", Machines: &machines}}
+
+	cluster := &computeapi.ComputeClusterRead{}
+	cluster.Metadata.Id = "cluster"
+	cluster.Metadata.Name = "example"
+	cluster.Metadata.CreationTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cluster.Metadata.Tags = &legacycore.TagList{{Name: "team", Value: "ml"}}
+	cluster.Spec.WorkloadPools = []computeapi.ComputeClusterWorkloadPool{
+		{Name: "default", Machine: computeapi.MachinePool{Replicas: 1, FlavorId: "flavor"}},
+	}
+	cluster.Status = &computeapi.ComputeClusterStatus{WorkloadPools: &statuses}
+
+	return cluster
+}
+
+func TestChangedAttributes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(cluster *computeapi.ComputeClusterRead)
+		want   []string
+	}{
+		{
+			name:   "unchanged",
+			modify: func(*computeapi.ComputeClusterRead) {},
+			want:   nil,
+		},
+		{
+			name: "machines are observed state, not configuration",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Status = nil
+				cluster.Metadata.ProvisioningStatus = legacycore.ResourceProvisioningStatusProvisioning
+			},
+			want: nil,
+		},
+		{
+			name: "pool resized",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Spec.WorkloadPools[0].Machine.Replicas = 2
+			},
+			want: []string{"workload_pools"},
+		},
+		{
+			name: "pool added and tags edited",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Spec.WorkloadPools = append(cluster.Spec.WorkloadPools, computeapi.ComputeClusterWorkloadPool{
+					Name: "extra",
+				})
+				cluster.Metadata.Tags = &legacycore.TagList{{Name: "team", Value: "infra"}}
+			},
+			want: []string{"tags", "workload_pools"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			before := NewComputeClusterModel(testComputeCluster())
+
+			after := testComputeCluster()
+			testCase.modify(after)
+
+			if got := changedAttributes(before, NewComputeClusterModel(after)); !slices.Equal(got, testCase.want) {
+				t.Fatalf("changedAttributes() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestChangedAttributesIgnoresEmptyDescription(t *testing.T) {
+	before := NewComputeClusterModel(testComputeCluster())
+	before.Description = types.StringValue("")
+
+	// The API returns no description for an empty one.
+	if got := changedAttributes(before, NewComputeClusterModel(testComputeCluster())); len(got) > 0 {
+		t.Fatalf("changedAttributes() = %v, want none", got)
+	}
+
+	after := testComputeCluster()
+	after.Metadata.Description = pointer.Reference("edited")
+
+	if got := changedAttributes(before, NewComputeClusterModel(after)); !slices.Equal(got, []string{"description"}) {
+		t.Fatalf("changedAttributes() = %v, want [description]", got)
+	}
+}
+
+func TestMetadataOnly(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(cluster *computeapi.ComputeClusterRead)
+		want   bool
+	}{
+		{
+			name: "renamed and retagged",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Metadata.Name = "renamed"
+				cluster.Metadata.Tags = &legacycore.TagList{{Name: "team", Value: "infra"}}
+			},
+			want: true,
+		},
+		{
+			name: "machines are observed state, not configuration",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Status = nil
+			},
+			want: true,
+		},
+		{
+			name: "pool resized",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Spec.WorkloadPools[0].Machine.Replicas = 2
+			},
+			want: false,
+		},
+		{
+			name: "region changed",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Spec.RegionId = "other"
+			},
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			prior := NewComputeClusterModel(testComputeCluster())
+
+			plan := testComputeCluster()
+			testCase.modify(plan)
+
+			if got := metadataOnly(prior, NewComputeClusterModel(plan)); got != testCase.want {
+				t.Fatalf("metadataOnly() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+// plannedPools returns the pools of cluster as Terraform plans them before any
+// plan modifier runs, with every attribute observed from machines unknown.
+func plannedPools(cluster *computeapi.ComputeClusterRead) types.List {
+	return withPoolAttributes(NewComputeClusterModel(cluster).WorkloadPools, map[string]attr.Value{
+		"machines":      types.ListUnknown(MachineModelAttributeType),
+		"machine_count": types.Int64Unknown(),
+		"private_ips":   types.ListUnknown(types.StringType),
+		"public_ips":    types.ListUnknown(types.StringType),
+	})
+}
+
+func TestWithUnchangedPoolMachines(t *testing.T) {
+	state := NewComputeClusterModel(testComputeCluster()).WorkloadPools
+
+	t.Run("unchanged pool keeps its machines", func(t *testing.T) {
+		if got := withUnchangedPoolMachines(plannedPools(testComputeCluster()), state); !got.Equal(state) {
+			t.Fatalf("withUnchangedPoolMachines() = %v, want the pools in state", got)
+		}
+	})
+
+	t.Run("resized pool plans its machines as unknown", func(t *testing.T) {
+		cluster := testComputeCluster()
+		cluster.Spec.WorkloadPools[0].Machine.Replicas = 2
+
+		plan := plannedPools(cluster)
+		if got := withUnchangedPoolMachines(plan, state); !got.Equal(plan) {
+			t.Fatalf("withUnchangedPoolMachines() = %v, want the plan unchanged", got)
+		}
+	})
+}
+
+func TestPoolExtraSpecs(t *testing.T) {
+	cluster := testComputeCluster()
+	cluster.Spec.WorkloadPools = append(cluster.Spec.WorkloadPools, computeapi.ComputeClusterWorkloadPool{
+		Name:    "plain",
+		Machine: computeapi.MachinePool{Replicas: 1, FlavorId: "flavor"},
+	})
+
+	read := NewComputeClusterModel(cluster).WorkloadPools
+	configured := withAttributes(
+		poolsByName(read)["default"],
+		map[string]attr.Value{
+			"extra_spec_json":     jsontypes.NewNormalizedValue(`{"machine": {"disk": {"size": 100}}}`),
+			"image_update_policy": types.StringValue(imageUpdatePolicyRolling),
+		},
+	)
+	plain := withAttributes(
+		poolsByName(read)["plain"],
+		map[string]attr.Value{"image_update_policy": types.StringValue(imageUpdatePolicyIgnore)},
+	)
+	planned := types.ListValueMust(WorkloadPoolModelAttributeType, []attr.Value{configured, plain})
+
+	t.Run("pool settings are kept from the prior pools", func(t *testing.T) {
+		if got := withPoolSettings(read, planned); !got.Equal(planned) {
+			t.Fatalf("withPoolSettings() = %v, want %v", got, planned)
+		}
+	})
+
+	t.Run("imported pools get the default image update policy", func(t *testing.T) {
+		imported := withPoolSettings(read, types.ListNull(WorkloadPoolModelAttributeType))
+		want := types.ListValueMust(WorkloadPoolModelAttributeType, []attr.Value{
+			withAttributes(poolsByName(read)["default"], map[string]attr.Value{
+				"image_update_policy": types.StringValue(imageUpdatePolicyIgnore),
+			}),
+			plain,
+		})
+
+		if !imported.Equal(want) {
+			t.Fatalf("withPoolSettings() = %v, want %v", imported, want)
+		}
+	})
+
+	t.Run("extra specs are not a change outside Terraform", func(t *testing.T) {
+		before := NewComputeClusterModel(cluster)
+		before.WorkloadPools = planned
+
+		if got := changedAttributes(before, NewComputeClusterModel(cluster)); len(got) > 0 {
+			t.Fatalf("changedAttributes() = %v, want none", got)
+		}
+	})
+
+	t.Run("extra specs are merged into their pools", func(t *testing.T) {
+		request := clusterWriteFromRead(cluster)
+
+		body, err := clusterRequestBody(request, nil, poolExtraSpecs(planned))
+		if err != nil {
+			t.Fatalf("clusterRequestBody() error = %v", err)
+		}
+
+		var got computeapi.ComputeClusterWrite
+		if err = json.NewDecoder(body).Decode(&got); err != nil {
+			t.Fatalf("failed to decode the request: %v", err)
+		}
+
+		pools := got.Spec.WorkloadPools
+		if pools[0].Machine.Disk == nil || pools[0].Machine.Disk.Size != 100 {
+			t.Fatalf("pool %q disk = %v, want the extra spec's", pools[0].Name, pools[0].Machine.Disk)
+		}
+
+		if pools[1].Machine.Disk != nil || pools[1].Machine.FlavorId != "flavor" {
+			t.Fatalf("pool %q = %+v, want it unchanged", pools[1].Name, pools[1].Machine)
+		}
+	})
+}
+
+func TestClusterRequestBodyKeepsOtherPoolsExtraSpecs(t *testing.T) {
+	cluster := testComputeCluster()
+	cluster.Spec.WorkloadPools = []computeapi.ComputeClusterWorkloadPool{
+		{Name: "cluster-pool", Machine: computeapi.MachinePool{Replicas: 1, FlavorId: "flavor"}},
+		{Name: "standalone-pool", Machine: computeapi.MachinePool{Replicas: 1, FlavorId: "flavor"}},
+	}
+
+	// The API returns the fields the extra specs of both pools set, which the
+	// client's types do not model.
+	read, err := nscale.JSONObject(cluster)
+	if err != nil {
+		t.Fatalf("JSONObject() error = %v", err)
+	}
+
+	extraSpecs := map[string]string{
+		"cluster-pool":    `{"placement": "spread", "machine": {"gpuSharing": {"mode": "mig"}}}`,
+		"standalone-pool": `{"machine": {"gpuSharing": {"mode": "time"}}}`,
+	}
+	for _, element := range read["spec"].(map[string]any)["workloadPools"].([]any) {
+		pool := element.(map[string]any)
+		if err = nscale.MergeExtraSpec(pool, extraSpecs[pool["name"].(string)]); err != nil {
+			t.Fatalf("MergeExtraSpec() error = %v", err)
+		}
+	}
+
+	encoded, err := json.Marshal(read)
+	if err != nil {
+		t.Fatalf("failed to encode the cluster: %v", err)
+	}
+
+	var current computeClusterJSON
+	if err = json.Unmarshal(encoded, &current); err != nil {
+		t.Fatalf("failed to decode the cluster: %v", err)
+	}
+
+	writtenPools := func(t *testing.T, extraSpecs map[string]string) map[string]map[string]any {
+		t.Helper()
+
+		request := clusterWriteFromRead(&current.ComputeClusterRead)
+		request.Spec.WorkloadPools[1].Machine.Replicas = 2
+
+		body, err := clusterRequestBody(request, current.workloadPools, extraSpecs)
+		if err != nil {
+			t.Fatalf("clusterRequestBody() error = %v", err)
+		}
+
+		var got struct {
+			Spec struct {
+				WorkloadPools []map[string]any `json:"workloadPools"`
+			} `json:"spec"`
+		}
+		if err = json.NewDecoder(body).Decode(&got); err != nil {
+			t.Fatalf("failed to decode the request: %v", err)
+		}
+
+		pools := map[string]map[string]any{}
+		for _, pool := range got.Spec.WorkloadPools {
+			pools[pool["name"].(string)] = pool
+		}
+
+		return pools
+	}
+
+	gpuSharing := func(pool map[string]any) any {
+		machine, _ := pool["machine"].(map[string]any)
+		sharing, _ := machine["gpuSharing"].(map[string]any)
+		return sharing["mode"]
+	}
+
+	t.Run("a pool resource's write keeps the other pool's fields", func(t *testing.T) {
+		pools := writtenPools(t, map[string]string{"standalone-pool": `{"machine": {"gpuSharing": {"mode": "mps"}}}`})
+
+		if got := gpuSharing(pools["cluster-pool"]); got != "mig" {
+			t.Fatalf("cluster-pool gpuSharing mode = %v, want mig", got)
+		}
+
+		if got := pools["cluster-pool"]["placement"]; got != "spread" {
+			t.Fatalf("cluster-pool placement = %v, want spread", got)
+		}
+
+		if got := gpuSharing(pools["standalone-pool"]); got != "mps" {
+			t.Fatalf("standalone-pool gpuSharing mode = %v, want the writer's extra spec", got)
+		}
+	})
+
+	t.Run("a pool the writer configures drops fields removed from its extra spec", func(t *testing.T) {
+		pools := writtenPools(t, map[string]string{"cluster-pool": ""})
+
+		if _, ok := pools["cluster-pool"]["placement"]; ok {
+			t.Fatalf("cluster-pool = %v, want no placement", pools["cluster-pool"])
+		}
+
+		if got := gpuSharing(pools["standalone-pool"]); got != "time" {
+			t.Fatalf("standalone-pool gpuSharing mode = %v, want time", got)
+		}
+	})
+
+	t.Run("managed fields come from the write", func(t *testing.T) {
+		pools := writtenPools(t, nil)
+
+		machine, _ := pools["standalone-pool"]["machine"].(map[string]any)
+		if got := machine["replicas"]; got != float64(2) {
+			t.Fatalf("standalone-pool replicas = %v, want 2", got)
+		}
+	})
+}
+
+func TestMachinesReplaced(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modify   func(cluster *computeapi.ComputeClusterRead)
+		noDetail bool
+		want     bool
+	}{
+		{
+			name:   "unchanged",
+			modify: func(*computeapi.ComputeClusterRead) {},
+		},
+		{
+			name: "machine added",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				machines := computeapi.ComputeClusterMachinesStatus{{Hostname: "default-0"}, {Hostname: "default-1"}}
+				(*cluster.Status.WorkloadPools)[0].Machines = &machines
+			},
+		},
+		{
+			name: "machine replaced",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				machines := computeapi.ComputeClusterMachinesStatus{{Hostname: "default-1"}}
+				(*cluster.Status.WorkloadPools)[0].Machines = &machines
+			},
+			want: true,
+		},
+		{
+			name: "machine replaced without machine details",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				address := "10.0.0.2"
+				machines := computeapi.ComputeClusterMachinesStatus{{Hostname: "default-0", PrivateIP: &address}}
+				(*cluster.Status.WorkloadPools)[0].Machines = &machines
+			},
+			noDetail: true,
+			want:     true,
+		},
+		{
+			name: "pool removed",
+			modify: func(cluster *computeapi.ComputeClusterRead) {
+				cluster.Spec.WorkloadPools = nil
+				cluster.Status = nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			before := testComputeCluster()
+			address := "10.0.0.1"
+			(*(*before.Status.WorkloadPools)[0].Machines)[0].PrivateIP = &address
+
+			after := testComputeCluster()
+			(*(*after.Status.WorkloadPools)[0].Machines)[0].PrivateIP = &address
+			testCase.modify(after)
+
+			prior := NewComputeClusterModel(before).WorkloadPools
+			current := NewComputeClusterModel(after).WorkloadPools
+			if testCase.noDetail {
+				prior, current = withoutMachineDetails(prior), withoutMachineDetails(current)
+			}
+
+			if got := machinesReplaced(prior, current); got != testCase.want {
+				t.Fatalf("machinesReplaced() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+
+	if machinesReplaced(plannedPools(testComputeCluster()), NewComputeClusterModel(testComputeCluster()).WorkloadPools) {
+		t.Fatal("machinesReplaced() = true for pools whose machines were planned as unknown")
+	}
+}
+
+// fakeLegacyComputeClient serves compute cluster reads from a fixed set of
+// clusters and counts the organization-wide lists.
+type fakeLegacyComputeClient struct {
+	computeapi.ClientInterface
+
+	clusters []computeapi.ComputeClusterRead
+	lists    int
+	t        *testing.T
+}
+
+func (c *fakeLegacyComputeClient) respond(status int, body any) *http.Response {
+	c.t.Helper()
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		c.t.Fatalf("failed to encode response: %v", err)
+	}
+
+	return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(data))}
+}
+
+func (c *fakeLegacyComputeClient) GetApiV1OrganizationsOrganizationIDClusters(
+	_ context.Context,
+	_ computeapi.OrganizationIDParameter,
+	_ *computeapi.GetApiV1OrganizationsOrganizationIDClustersParams,
+	_ ...computeapi.RequestEditorFn,
+) (*http.Response, error) {
+	c.lists++
+	return c.respond(http.StatusOK, c.clusters), nil
+}
+
+func (c *fakeLegacyComputeClient) GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(
+	_ context.Context,
+	_ computeapi.OrganizationIDParameter,
+	projectID computeapi.ProjectIDParameter,
+	clusterID computeapi.ClusterIDParameter,
+	_ ...computeapi.RequestEditorFn,
+) (*http.Response, error) {
+	for _, cluster := range c.clusters {
+		if cluster.Metadata.ProjectId == projectID && cluster.Metadata.Id == clusterID {
+			return c.respond(http.StatusOK, cluster), nil
+		}
+	}
+	return c.respond(http.StatusNotFound, map[string]string{"error": "not_found"}), nil
+}
+
+func TestMachineGeneration(t *testing.T) {
+	adapter := computeClusterAdapter()
+
+	var state ComputeClusterResourceModel
+	adapter.ToModel(testComputeCluster(), &state)
+	if got := state.MachineGeneration; !got.Equal(types.Int64Value(0)) {
+		t.Fatalf("machine_generation after the first read = %v, want 0", got)
+	}
+
+	healed := testComputeCluster()
+	machines := computeapi.ComputeClusterMachinesStatus{{Hostname: "default-1"}}
+	(*healed.Status.WorkloadPools)[0].Machines = &machines
+
+	t.Run("an update keeps the planned generation", func(t *testing.T) {
+		planned := state
+		adapter.ToModel(healed, &planned)
+
+		if got := planned.MachineGeneration; !got.Equal(state.MachineGeneration) {
+			t.Fatalf("machine_generation after an update = %v, want %v", got, state.MachineGeneration)
+		}
+	})
+
+	t.Run("a refresh counts replaced machines", func(t *testing.T) {
+		refreshed := state
+		adapter.ToModel(healed, &refreshed)
+		adapter.Refreshed(state, &refreshed)
+
+		if got := refreshed.MachineGeneration; !got.Equal(types.Int64Value(1)) {
+			t.Fatalf("machine_generation after a refresh = %v, want 1", got)
+		}
+	})
+}
+
+func TestGetComputeClusterScopesToProject(t *testing.T) {
+	clusters := make([]computeapi.ComputeClusterRead, 2)
+	for i, projectID := range []string{"project-a", "project-b"} {
+		clusters[i] = *testComputeCluster()
+		clusters[i].Metadata.ProjectId = projectID
+	}
+
+	testCases := []struct {
+		name        string
+		projectID   string
+		wantProject string
+		wantLists   int
+		wantErr     bool
+	}{
+		{name: "project", projectID: "project-b", wantProject: "project-b"},
+		{name: "no project lists the organization", wantProject: "project-a", wantLists: 1},
+		{name: "other project", projectID: "project-c", wantErr: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			legacyCompute := &fakeLegacyComputeClient{clusters: clusters, t: t}
+			client := &nscale.Client{OrganizationID: "organization", LegacyCompute: legacyCompute}
+
+			cluster, _, err := getComputeCluster(context.Background(), "organization", testCase.projectID, "cluster", client)
+			if (err != nil) != testCase.wantErr {
+				t.Fatalf("getComputeCluster() error = %v, want error %v", err, testCase.wantErr)
+			}
+
+			if !testCase.wantErr && cluster.Metadata.ProjectId != testCase.wantProject {
+				t.Fatalf("read the cluster of %q, want %q", cluster.Metadata.ProjectId, testCase.wantProject)
+			}
+
+			if legacyCompute.lists != testCase.wantLists {
+				t.Fatalf("listed the organization's clusters %d times, want %d", legacyCompute.lists, testCase.wantLists)
+			}
+		})
+	}
+}
+
+func TestPublicIPsSettled(t *testing.T) {
+	address := "203.0.113.10"
+
+	testCases := []struct {
+		name     string
+		enabled  types.Bool
+		publicIP *string
+		want     bool
+	}{
+		{name: "attached", enabled: types.BoolValue(true), publicIP: &address, want: true},
+		{name: "attaching", enabled: types.BoolValue(true)},
+		{name: "detaching", enabled: types.BoolValue(false), publicIP: &address},
+		{name: "detached", enabled: types.BoolValue(false), want: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cluster := testComputeCluster()
+			(*(*cluster.Status.WorkloadPools)[0].Machines)[0].PublicIP = testCase.publicIP
+
+			pools := withPoolAttributes(
+				NewComputeClusterModel(cluster).WorkloadPools,
+				map[string]attr.Value{"enable_public_ip": testCase.enabled},
+			)
+
+			if got := publicIPsSettled(cluster, pools); got != testCase.want {
+				t.Fatalf("publicIPsSettled() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+)
+
+const (
+	// hostnamePatternBoundary separates the parts of user data the provider
+	// has combined with a hostname pattern, and marks it as such on read.
+	hostnamePatternBoundary = "nscale-hostname-pattern"
+
+	// hostnamePatternHeader carries the pattern in the header of the part
+	// that applies it, so it can be read back from the pool's user data.
+	hostnamePatternHeader = "X-Nscale-Hostname-Pattern"
+)
+
+//nolint:gochecknoglobals // compiled once.
+var (
+	// hostnamePatternRegex matches patterns made of lowercase letters, digits,
+	// hyphens and the supported placeholders.
+	hostnamePatternRegex = regexp.MustCompile(`^([a-z0-9-]|\{(pool|hostname|ip)\})+$`)
+
+	// hostnamePatternUniqueRegex matches patterns that give each machine of a
+	// pool a different name.
+	hostnamePatternUniqueRegex = regexp.MustCompile(`\{(hostname|ip)\}`)
+)
+
+// hostnameScript renders pattern as the shell commands that set the hostname
+// of a machine of pool at boot. The machine's own hostname, as assigned by the
+// platform, and its private IPv4 address are only known on the machine.
+func hostnameScript(pool, pattern string) string {
+	name := strings.NewReplacer(
+		"{pool}", pool,
+		"{hostname}", "${hostname}",
+		"{ip}", "${ip}",
+	).Replace(pattern)
+
+	return strings.Join([]string{
+		`hostname="$(cloud-init query v1.local_hostname 2>/dev/null || hostname)"`,
+		`hostname="${hostname%%.*}"`,
+		`ip="$(hostname -I | awk '{print $1}' | tr . -)"`,
+		fmt.Sprintf(`name="%s"`, name),
+		`hostnamectl set-hostname "$name" 2>/dev/null || hostname "$name"`,
+		`grep -q " $name\$" /etc/hosts || echo "127.0.1.1 $name" >> /etc/hosts`,
+	}, "\n")
+}
+
+// hostnameCloudConfig returns the cloud-config that applies pattern on every
+// boot, and stops cloud-init from resetting the hostname afterwards.
+func hostnameCloudConfig(pool, pattern string) []byte {
+	var config strings.Builder
+
+	config.WriteString("#cloud-config\n")
+	config.WriteString("# Generated from the hostname_pattern of the workload pool.\n")
+	config.WriteString("preserve_hostname: true\n")
+	config.WriteString("bootcmd:\n")
+	config.WriteString("  - |\n")
+	for _, line := range strings.Split(hostnameScript(pool, pattern), "\n") {
+		config.WriteString("    " + line + "\n")
+	}
+
+	return []byte(config.String())
+}
+
+// withHostnamePattern returns userData combined with the cloud-config that
+// applies pattern to the machines of pool, as a multi-part MIME message
+// cloud-init processes part by part. The user's part keeps its own format,
+// which cloud-init detects from its content, and the generated cloud-config
+// is appended to its lists rather than replacing them.
+func withHostnamePattern(userData []byte, pool, pattern string) []byte {
+	var message bytes.Buffer
+
+	fmt.Fprintf(&message, "Content-Type: multipart/mixed; boundary=%q\n", hostnamePatternBoundary)
+	message.WriteString("MIME-Version: 1.0\n\n")
+
+	writer := multipart.NewWriter(&message)
+	_ = writer.SetBoundary(hostnamePatternBoundary)
+
+	if userData != nil {
+		part, _ := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {"text/plain"},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		_, _ = part.Write([]byte(base64.StdEncoding.EncodeToString(userData)))
+	}
+
+	part, _ := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":        {"text/cloud-config"},
+		"Merge-Type":          {"list(append)+dict(recurse_array)+str()"},
+		hostnamePatternHeader: {pattern},
+	})
+	_, _ = part.Write(hostnameCloudConfig(pool, pattern))
+
+	_ = writer.Close()
+
+	return message.Bytes()
+}
+
+// withoutHostnamePattern splits user data combined by withHostnamePattern back
+// into the user's own data, nil when there was none, and the pattern. Other
+// user data is returned as it is, with an empty pattern.
+func withoutHostnamePattern(userData []byte) ([]byte, string) {
+	message, err := mail.ReadMessage(bytes.NewReader(userData))
+	if err != nil {
+		return userData, ""
+	}
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" || params["boundary"] != hostnamePatternBoundary {
+		return userData, ""
+	}
+
+	var (
+		ownData []byte
+		pattern string
+	)
+
+	reader := multipart.NewReader(message.Body, hostnamePatternBoundary)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return userData, ""
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return userData, ""
+		}
+
+		if value := part.Header.Get(hostnamePatternHeader); value != "" {
+			pattern = value
+			continue
+		}
+
+		if ownData, err = base64.StdEncoding.DecodeString(string(content)); err != nil {
+			return userData, ""
+		}
+	}
+
+	if pattern == "" {
+		return userData, ""
+	}
+
+	return ownData, pattern
+}
+
+// newUserDataModels returns the user data of a pool as the API returns it,
+// without the part applying its hostname pattern, and the pattern.
+func newUserDataModels(source *[]byte) (tftypes.Base64StringValue, types.String) {
+	if source == nil {
+		return tftypes.NewBase64StringNull(), types.StringNull()
+	}
+
+	raw, err := tftypes.DecodeBase64(string(*source))
+	if err != nil {
+		return tftypes.NewBase64StringValue(string(*source)), types.StringNull()
+	}
+
+	ownData, pattern := withoutHostnamePattern(raw)
+	if pattern == "" {
+		return tftypes.NewBase64StringValue(string(*source)), types.StringNull()
+	}
+
+	if ownData == nil {
+		return tftypes.NewBase64StringNull(), types.StringValue(pattern)
+	}
+
+	return tftypes.NewBase64StringValue(base64.StdEncoding.EncodeToString(ownData)), types.StringValue(pattern)
+}
+
+// nscaleUserData returns the user data to send for a pool, combined with the
+// cloud-config applying its hostname pattern when it has one.
+func (m *WorkloadPoolModel) nscaleUserData() (*[]byte, diag.Diagnostics) {
+	var userData *[]byte
+	if !m.UserData.IsNull() && !m.UserData.IsUnknown() {
+		temp := []byte(m.UserData.CanonicalValueString())
+		userData = &temp
+	}
+
+	pattern := m.HostnamePattern.ValueString()
+	if pattern == "" {
+		return userData, nil
+	}
+
+	var ownData []byte
+	if userData != nil {
+		var err error
+		if ownData, err = tftypes.DecodeBase64(string(*userData)); err != nil {
+			return nil, NewErrorDiagnostics(
+				"Invalid User Data",
+				fmt.Sprintf("The user data of the workload pool '%s' is not valid base64: %s", m.Name.ValueString(), err),
+			)
+		}
+	}
+
+	combined := []byte(base64.StdEncoding.EncodeToString(withHostnamePattern(ownData, m.Name.ValueString(), pattern)))
+
+	return &combined, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+)
+
+func TestHostnamePatternRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name     string
+		userData []byte
+	}{
+		{name: "cloud-config", userData: []byte("#cloud-config\npackages: [htop]\n")},
+		{name: "binary", userData: []byte{0x1f, 0x8b, 0x00, 0xff}},
+		{name: "no user data"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			combined := withHostnamePattern(testCase.userData, "gpu", "train-{pool}-{ip}")
+
+			if !bytes.Contains(combined, []byte(`name="train-gpu-${ip}"`)) {
+				t.Fatalf("withHostnamePattern() = %s, want the rendered pattern", combined)
+			}
+
+			userData, pattern := withoutHostnamePattern(combined)
+			if pattern != "train-{pool}-{ip}" {
+				t.Fatalf("withoutHostnamePattern() pattern = %q, want %q", pattern, "train-{pool}-{ip}")
+			}
+			if !bytes.Equal(userData, testCase.userData) || (userData == nil) != (testCase.userData == nil) {
+				t.Fatalf("withoutHostnamePattern() user data = %q, want %q", userData, testCase.userData)
+			}
+		})
+	}
+}
+
+func TestWithoutHostnamePatternKeepsOtherUserData(t *testing.T) {
+	for _, userData := range []string{
+		"#!/bin/sh\necho hello\n",
+		"Content-Type: multipart/mixed; boundary=\"other\"\nMIME-Version: 1.0\n\n--other--\n",
+	} {
+		got, pattern := withoutHostnamePattern([]byte(userData))
+		if pattern != "" || string(got) != userData {
+			t.Fatalf("withoutHostnamePattern(%q) = %q, %q, want it unchanged", userData, got, pattern)
+		}
+	}
+}
+
+func TestWorkloadPoolHostnamePatternRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	var pool WorkloadPoolModel
+	read := NewWorkloadPoolModel(computeapi.ComputeClusterWorkloadPool{Name: "gpu"}, nil)
+	if diagnostics := read.As(ctx, &pool, basetypes.ObjectAsOptions{}); diagnostics.HasError() {
+		t.Fatalf("failed to decode workload pool: %v", diagnostics)
+	}
+
+	userData := base64.StdEncoding.EncodeToString([]byte("#cloud-config\n"))
+	pool.UserData = tftypes.NewBase64StringValue(userData)
+	pool.HostnamePattern = types.StringValue("{pool}-{hostname}")
+
+	spec, diagnostics := pool.NscaleWorkloadPool()
+	if diagnostics.HasError() {
+		t.Fatalf("NscaleWorkloadPool() error: %v", diagnostics)
+	}
+	if sent := string(*spec.Machine.UserData); strings.Contains(sent, "cloud-config") {
+		t.Fatalf("NscaleWorkloadPool() user data = %q, want it base64-encoded", sent)
+	}
+
+	var roundTripped WorkloadPoolModel
+	diagnostics = NewWorkloadPoolModel(spec, nil).As(ctx, &roundTripped, basetypes.ObjectAsOptions{})
+	if diagnostics.HasError() {
+		t.Fatalf("failed to decode workload pool: %v", diagnostics)
+	}
+
+	if !roundTripped.HostnamePattern.Equal(pool.HostnamePattern) {
+		t.Fatalf("hostname_pattern = %v, want %v", roundTripped.HostnamePattern, pool.HostnamePattern)
+	}
+	if roundTripped.UserData.ValueString() != userData {
+		t.Fatalf("user_data = %v, want %v", roundTripped.UserData, userData)
+	}
+}
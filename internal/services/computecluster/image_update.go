@@ -0,0 +1,349 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	legacycore "github.com/unikorn-cloud/core/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+const (
+	imageUpdatePolicyIgnore     = "ignore"
+	imageUpdatePolicyReplaceAll = "replace_all"
+	imageUpdatePolicyRolling    = "rolling"
+)
+
+//nolint:gochecknoglobals // the accepted values of image_update_policy.
+var imageUpdatePolicies = []string{imageUpdatePolicyIgnore, imageUpdatePolicyReplaceAll, imageUpdatePolicyRolling}
+
+// imageUpdate is a workload pool whose machines are to be moved onto a new
+// image once the cluster has been updated.
+type imageUpdate struct {
+	pool    string
+	imageID string
+	policy  string
+}
+
+// imageUpdates returns the pools of plan whose policy replaces the machines on
+// an old image and whose image differs from the pool of the same name in
+// prior, or whose policy did not replace them in prior. New pools have no
+// machines to replace.
+func imageUpdates(prior, plan types.List) []imageUpdate {
+	priorPools := poolsByName(prior)
+
+	var updates []imageUpdate
+	for name, pool := range poolsByName(plan) {
+		priorPool, found := priorPools[name]
+		if !found {
+			continue
+		}
+
+		policy, _ := pool.Attributes()["image_update_policy"].(types.String)
+		if !replacesMachines(policy) {
+			continue
+		}
+
+		imageID, _ := pool.Attributes()["image_id"].(types.String)
+		if imageID.IsNull() || imageID.IsUnknown() {
+			continue
+		}
+
+		priorImageID, _ := priorPool.Attributes()["image_id"].(types.String)
+		priorPolicy, _ := priorPool.Attributes()["image_update_policy"].(types.String)
+		if imageID.Equal(priorImageID) && replacesMachines(priorPolicy) {
+			continue
+		}
+
+		updates = append(updates, imageUpdate{pool: name, imageID: imageID.ValueString(), policy: policy.ValueString()})
+	}
+
+	// Replace the pools in a stable order, so repeated applies behave alike.
+	slices.SortFunc(updates, func(a, b imageUpdate) int { return cmp.Compare(a.pool, b.pool) })
+
+	return updates
+}
+
+// replacesMachines reports whether policy replaces the machines on an old
+// image.
+func replacesMachines(policy types.String) bool {
+	return policy.ValueString() == imageUpdatePolicyReplaceAll || policy.ValueString() == imageUpdatePolicyRolling
+}
+
+// pendingImageID returns the image of a machine of the named pool that does
+// not run imageID, or an empty string if they all do. Machines not reporting
+// their image yet are skipped.
+func pendingImageID(cluster *computeapi.ComputeClusterRead, pool, imageID string) string {
+	_, status := findWorkloadPool(cluster, pool)
+	if status == nil || status.Machines == nil {
+		return ""
+	}
+
+	for _, machine := range *status.Machines {
+		if machine.ImageID != "" && machine.ImageID != imageID {
+			return machine.ImageID
+		}
+	}
+
+	return ""
+}
+
+// withPendingImageUpdates returns the pools with the image_id of each pool
+// whose policy replaces the machines on an old image, but which still has
+// such machines, set to the old image. This happens when a replacement fails
+// part way, and makes the next plan finish it.
+func withPendingImageUpdates(pools types.List, cluster *computeapi.ComputeClusterRead) types.List {
+	if pools.IsNull() || pools.IsUnknown() {
+		return pools
+	}
+
+	elements := make([]attr.Value, 0, len(pools.Elements()))
+	for _, element := range pools.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok || pool.IsNull() || pool.IsUnknown() {
+			elements = append(elements, element)
+			continue
+		}
+
+		name, _ := pool.Attributes()["name"].(types.String)
+		imageID, _ := pool.Attributes()["image_id"].(types.String)
+		policy, _ := pool.Attributes()["image_update_policy"].(types.String)
+		if replacesMachines(policy) {
+			if pending := pendingImageID(cluster, name.ValueString(), imageID.ValueString()); pending != "" {
+				pool = withAttributes(pool, map[string]attr.Value{"image_id": types.StringValue(pending)})
+			}
+		}
+
+		elements = append(elements, pool)
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, elements)
+}
+
+// outdatedMachines returns the IDs of the machines of the named pool that do
+// not run imageID.
+func outdatedMachines(cluster *computeapi.ComputeClusterRead, pool, imageID string) []string {
+	_, status := findWorkloadPool(cluster, pool)
+	if status == nil || status.Machines == nil {
+		return nil
+	}
+
+	var machineIDs []string
+	for _, machine := range *status.Machines {
+		if machine.ImageID != imageID {
+			machineIDs = append(machineIDs, machine.Id)
+		}
+	}
+
+	return machineIDs
+}
+
+// machineBatches splits the machines to replace into the batches the policy
+// evicts together: all of them at once, or one at a time.
+func machineBatches(policy string, machineIDs []string) [][]string {
+	if len(machineIDs) == 0 {
+		return nil
+	}
+
+	if policy != imageUpdatePolicyRolling {
+		return [][]string{machineIDs}
+	}
+
+	batches := make([][]string, 0, len(machineIDs))
+	for _, machineID := range machineIDs {
+		batches = append(batches, []string{machineID})
+	}
+
+	return batches
+}
+
+// poolReplaced reports whether the named pool is back to replicas machines,
+// none of them evicted and all of them provisioned.
+func poolReplaced(cluster *computeapi.ComputeClusterRead, pool string, evicted []string, replicas int) bool {
+	_, status := findWorkloadPool(cluster, pool)
+	if status == nil || status.Machines == nil || len(*status.Machines) != replicas {
+		return false
+	}
+
+	for _, machine := range *status.Machines {
+		if slices.Contains(evicted, machine.Id) ||
+			machine.ProvisioningStatus != legacycore.ResourceProvisioningStatusProvisioned {
+			return false
+		}
+	}
+
+	return true
+}
+
+// replaceOutdatedMachines replaces the machines of a pool that do not run its
+// new image, as its image update policy asks. The API only gives new machines
+// the new image, so each batch is evicted, which also scales the pool down,
+// and the pool is then scaled back up to its replicas. It returns the cluster
+// and the operation tag of its last write once the replacements are
+// provisioned, or an empty tag when no machine needed replacing.
+func replaceOutdatedMachines(
+	ctx context.Context,
+	client *nscale.Client,
+	clusterID string,
+	update imageUpdate,
+	extraSpecs map[string]string,
+	timeout time.Duration,
+	diagnostics *diag.Diagnostics,
+) (*computeapi.ComputeClusterRead, string, bool) {
+	unlock := clusterLocks.Lock(clusterID)
+	defer unlock()
+
+	deadline := time.Now().Add(timeout)
+
+	cluster, _, err := getComputeCluster(ctx, client.OrganizationID, client.ProjectID, clusterID, client)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		addImageUpdateError(diagnostics, update, fmt.Sprintf("retrieving the compute cluster: %s", err))
+		return nil, "", false
+	}
+
+	spec, _ := findWorkloadPool(cluster, update.pool)
+	if spec == nil {
+		addImageUpdateError(diagnostics, update, "the workload pool is no longer in the compute cluster")
+		return nil, "", false
+	}
+	replicas := spec.Machine.Replicas
+
+	var operationTagKey string
+	for _, batch := range machineBatches(update.policy, outdatedMachines(cluster, update.pool, update.imageID)) {
+		if err = evictMachines(ctx, client, cluster.Metadata.ProjectId, clusterID, batch); err != nil {
+			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+			addImageUpdateError(diagnostics, update, fmt.Sprintf("evicting the machines %v: %s", batch, err))
+			return nil, "", false
+		}
+
+		operationTagKey, err = scalePool(ctx, client, clusterID, update.pool, replicas, extraSpecs)
+		if err != nil {
+			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+			addImageUpdateError(diagnostics, update, fmt.Sprintf("replacing the machines %v: %s", batch, err))
+			return nil, "", false
+		}
+
+		stateWatcher := nscale.UpdateStateWatcher[computeapi.ComputeClusterRead]{
+			ResourceTitle: "Compute Cluster",
+			ResourceName:  "compute cluster",
+			GetFunc: func(ctx context.Context) (*computeapi.ComputeClusterRead, nscale.ResourceStatus, error) {
+				cluster, status, err := nscale.AdaptProjectScoped(
+					getComputeCluster(ctx, client.OrganizationID, client.ProjectID, clusterID, client),
+				)
+
+				// Hold the watcher until the replacements are provisioned, not
+				// just until the write is observed.
+				if err == nil && !poolReplaced(cluster, update.pool, batch, replicas) {
+					status.Tags = nil
+				}
+
+				return cluster, status, err
+			},
+		}
+
+		var ok bool
+		if cluster, ok = stateWatcher.WaitFor(ctx, operationTagKey, time.Until(deadline), diagnostics); !ok {
+			return nil, "", false
+		}
+	}
+
+	return cluster, operationTagKey, true
+}
+
+// evictMachines deletes machines from a cluster, scaling their pools down.
+func evictMachines(ctx context.Context, client *nscale.Client, projectID, clusterID string, machineIDs []string) error {
+	evictResponse, err := client.LegacyCompute.PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDEvict(
+		ctx,
+		client.OrganizationID,
+		projectID,
+		clusterID,
+		computeapi.EvictionWrite{MachineIDs: machineIDs},
+	)
+	if err != nil {
+		return err
+	}
+	defer evictResponse.Body.Close()
+
+	return nscale.ReadEmptyResponse(evictResponse)
+}
+
+// scalePool sets the replicas of the named pool, leaving the rest of the
+// cluster as it is, and returns the operation tag of the write.
+func scalePool(
+	ctx context.Context,
+	client *nscale.Client,
+	clusterID, pool string,
+	replicas int,
+	extraSpecs map[string]string,
+) (string, error) {
+	cluster, currentPools, err := getComputeClusterForWrite(ctx, client, clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	requestData := clusterWriteFromRead(cluster)
+	for i := range requestData.Spec.WorkloadPools {
+		if requestData.Spec.WorkloadPools[i].Name == pool {
+			requestData.Spec.WorkloadPools[i].Machine.Replicas = replicas
+		}
+	}
+
+	operationTagKey := writeOperationTagLegacy(&requestData.Metadata)
+
+	body, err := clusterRequestBody(requestData, currentPools, extraSpecs)
+	if err != nil {
+		return "", err
+	}
+
+	updateResponse, err := client.LegacyCompute.PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDWithBody(
+		ctx,
+		client.OrganizationID,
+		cluster.Metadata.ProjectId,
+		clusterID,
+		nscale.ExtraSpecContentType,
+		body,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer updateResponse.Body.Close()
+
+	return operationTagKey, nscale.ReadEmptyResponse(updateResponse)
+}
+
+func addImageUpdateError(diagnostics *diag.Diagnostics, update imageUpdate, detail string) {
+	diagnostics.AddError(
+		"Failed to Replace Workload Pool Machines",
+		fmt.Sprintf(
+			"An error occurred while replacing the machines of the workload pool '%s' with ones on the image "+
+				"'%s': %s. The rest of the update has been applied, and the machines not yet replaced are replaced by the "+
+				"next apply.",
+			update.pool, update.imageID, detail,
+		),
+	)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	legacycore "github.com/unikorn-cloud/core/pkg/openapi"
+)
+
+func testPools(t *testing.T, pools ...map[string]attr.Value) types.List {
+	t.Helper()
+
+	read := poolsByName(NewComputeClusterModel(testComputeCluster()).WorkloadPools)["default"]
+
+	elements := make([]attr.Value, 0, len(pools))
+	for _, attributes := range pools {
+		elements = append(elements, withAttributes(read, attributes))
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, elements)
+}
+
+func TestImageUpdates(t *testing.T) {
+	prior := testPools(t,
+		map[string]attr.Value{
+			"name":                types.StringValue("a"),
+			"image_id":            types.StringValue("old"),
+			"image_update_policy": types.StringValue(imageUpdatePolicyRolling),
+		},
+		map[string]attr.Value{
+			"name":                types.StringValue("b"),
+			"image_id":            types.StringValue("old"),
+			"image_update_policy": types.StringValue(imageUpdatePolicyIgnore),
+		},
+	)
+
+	testCases := []struct {
+		name string
+		plan types.List
+		want []imageUpdate
+	}{
+		{
+			name: "ignore leaves the machines",
+			plan: testPools(t, map[string]attr.Value{
+				"name":                types.StringValue("a"),
+				"image_id":            types.StringValue("new"),
+				"image_update_policy": types.StringValue(imageUpdatePolicyIgnore),
+			}),
+		},
+		{
+			name: "unchanged image",
+			plan: testPools(t, map[string]attr.Value{
+				"name":                types.StringValue("a"),
+				"image_id":            types.StringValue("old"),
+				"image_update_policy": types.StringValue(imageUpdatePolicyRolling),
+			}),
+		},
+		{
+			name: "policy now replaces the machines",
+			plan: testPools(t, map[string]attr.Value{
+				"name":                types.StringValue("b"),
+				"image_id":            types.StringValue("old"),
+				"image_update_policy": types.StringValue(imageUpdatePolicyReplaceAll),
+			}),
+			want: []imageUpdate{{pool: "b", imageID: "old", policy: imageUpdatePolicyReplaceAll}},
+		},
+		{
+			name: "new pool",
+			plan: testPools(t, map[string]attr.Value{
+				"name":                types.StringValue("c"),
+				"image_id":            types.StringValue("new"),
+				"image_update_policy": types.StringValue(imageUpdatePolicyReplaceAll),
+			}),
+		},
+		{
+			name: "changed images in pool order",
+			plan: testPools(t,
+				map[string]attr.Value{
+					"name":                types.StringValue("b"),
+					"image_id":            types.StringValue("new"),
+					"image_update_policy": types.StringValue(imageUpdatePolicyRolling),
+				},
+				map[string]attr.Value{
+					"name":                types.StringValue("a"),
+					"image_id":            types.StringValue("new"),
+					"image_update_policy": types.StringValue(imageUpdatePolicyReplaceAll),
+				},
+			),
+			want: []imageUpdate{
+				{pool: "a", imageID: "new", policy: imageUpdatePolicyReplaceAll},
+				{pool: "b", imageID: "new", policy: imageUpdatePolicyRolling},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := imageUpdates(prior, testCase.plan); !slices.Equal(got, testCase.want) {
+				t.Fatalf("imageUpdates() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func testClusterWithMachines(machines ...computeapi.ComputeClusterMachineStatus) *computeapi.ComputeClusterRead {
+	cluster := testComputeCluster()
+	statuses := computeapi.ComputeClusterWorkloadPoolsStatus{{Name: "default", Machines: &machines}}
+	cluster.Status.WorkloadPools = &statuses
+	return cluster
+}
+
+func TestMachineBatches(t *testing.T) {
+	cluster := testClusterWithMachines(
+		computeapi.ComputeClusterMachineStatus{Id: "m1", ImageID: "old"},
+		computeapi.ComputeClusterMachineStatus{Id: "m2", ImageID: "new"},
+		computeapi.ComputeClusterMachineStatus{Id: "m3", ImageID: "old"},
+	)
+
+	outdated := outdatedMachines(cluster, "default", "new")
+	if want := []string{"m1", "m3"}; !slices.Equal(outdated, want) {
+		t.Fatalf("outdatedMachines() = %v, want %v", outdated, want)
+	}
+
+	testCases := []struct {
+		policy string
+		want   [][]string
+	}{
+		{policy: imageUpdatePolicyReplaceAll, want: [][]string{{"m1", "m3"}}},
+		{policy: imageUpdatePolicyRolling, want: [][]string{{"m1"}, {"m3"}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.policy, func(t *testing.T) {
+			got := machineBatches(testCase.policy, outdated)
+			if !slices.EqualFunc(got, testCase.want, slices.Equal[[]string]) {
+				t.Fatalf("machineBatches() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+
+	if got := machineBatches(imageUpdatePolicyRolling, nil); got != nil {
+		t.Fatalf("machineBatches() = %v, want none when every machine has the new image", got)
+	}
+}
+
+func TestPoolReplaced(t *testing.T) {
+	provisioned := func(id string) computeapi.ComputeClusterMachineStatus {
+		return computeapi.ComputeClusterMachineStatus{
+			Id:                 id,
+			ProvisioningStatus: legacycore.ResourceProvisioningStatusProvisioned,
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		cluster *computeapi.ComputeClusterRead
+		want    bool
+	}{
+		{
+			name:    "scaled down",
+			cluster: testClusterWithMachines(provisioned("m2")),
+		},
+		{
+			name:    "evicted machine still listed",
+			cluster: testClusterWithMachines(provisioned("m1"), provisioned("m2")),
+		},
+		{
+			name: "replacement provisioning",
+			cluster: testClusterWithMachines(provisioned("m2"), computeapi.ComputeClusterMachineStatus{
+				Id:                 "m4",
+				ProvisioningStatus: legacycore.ResourceProvisioningStatusProvisioning,
+			}),
+		},
+		{
+			name:    "replaced",
+			cluster: testClusterWithMachines(provisioned("m2"), provisioned("m4")),
+			want:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := poolReplaced(testCase.cluster, "default", []string{"m1"}, 2); got != testCase.want {
+				t.Fatalf("poolReplaced() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestWithPendingImageUpdates(t *testing.T) {
+	outdated := testClusterWithMachines(
+		computeapi.ComputeClusterMachineStatus{Id: "m1", ImageID: "new"},
+		computeapi.ComputeClusterMachineStatus{Id: "m2"},
+		computeapi.ComputeClusterMachineStatus{Id: "m3", ImageID: "old"},
+	)
+	replaced := testClusterWithMachines(
+		computeapi.ComputeClusterMachineStatus{Id: "m1", ImageID: "new"},
+		computeapi.ComputeClusterMachineStatus{Id: "m2"},
+	)
+
+	testCases := []struct {
+		name    string
+		cluster *computeapi.ComputeClusterRead
+		policy  string
+		want    string
+	}{
+		{name: "ignore keeps the configured image", cluster: outdated, policy: imageUpdatePolicyIgnore, want: "new"},
+		{name: "outdated machine", cluster: outdated, policy: imageUpdatePolicyRolling, want: "old"},
+		{name: "all machines replaced", cluster: replaced, policy: imageUpdatePolicyReplaceAll, want: "new"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pools := testPools(t, map[string]attr.Value{
+				"name":                types.StringValue("default"),
+				"image_id":            types.StringValue("new"),
+				"image_update_policy": types.StringValue(testCase.policy),
+			})
+
+			pool := poolsByName(withPendingImageUpdates(pools, testCase.cluster))["default"]
+			if imageID, _ := pool.Attributes()["image_id"].(types.String); imageID.ValueString() != testCase.want {
+				t.Fatalf("image_id = %s, want %s", imageID.ValueString(), testCase.want)
+			}
+		})
+	}
+}
@@ -21,27 +21,33 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
 	coreapi "github.com/unikorn-cloud/core/pkg/openapi"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 )
 
 type ComputeClusterModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	WorkloadPools      types.List   `tfsdk:"workload_pools"`
-	SSHPrivateKey      types.String `tfsdk:"ssh_private_key"`
-	Tags               types.Map    `tfsdk:"tags"`
-	RegionID           types.String `tfsdk:"region_id"`
-	ProvisioningStatus types.String `tfsdk:"provisioning_status"`
-	CreationTime       types.String `tfsdk:"creation_time"`
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Description        types.String      `tfsdk:"description"`
+	WorkloadPools      types.List        `tfsdk:"workload_pools"`
+	SSHPrivateKey      types.String      `tfsdk:"ssh_private_key"`
+	Tags               types.Map         `tfsdk:"tags"`
+	RegionID           types.String      `tfsdk:"region_id"`
+	ProvisioningStatus types.String      `tfsdk:"provisioning_status"`
+	SpecFingerprint    types.String      `tfsdk:"spec_fingerprint"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy          types.String      `tfsdk:"created_by"`
+	ModifiedBy         types.String      `tfsdk:"modified_by"`
+	LastModifiedTime   timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 func NewComputeClusterModel(source *computeapi.ComputeClusterRead) ComputeClusterModel {
@@ -66,7 +72,11 @@ func NewComputeClusterModel(source *computeapi.ComputeClusterRead) ComputeCluste
 		Tags:               tftypes.TagMapValueMust(tags),
 		RegionID:           types.StringValue(source.Spec.RegionId),
 		ProvisioningStatus: types.StringValue(string(source.Metadata.ProvisioningStatus)),
-		CreationTime:       types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		SpecFingerprint:    nscale.SpecFingerprint(source.Spec),
+		CreationTime:       timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:          types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:         types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:   timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
 
@@ -143,7 +153,7 @@ var WorkloadPoolModelAttributeType = types.ObjectType{
 		"image_id":  types.StringType,
 		"flavor_id": types.StringType,
 		// "disk_size":         types.Int64Type,
-		"user_data":        types.StringType,
+		"user_data":        tftypes.Base64StringType{},
 		"enable_public_ip": types.BoolType,
 		"allowed_address_pairs": types.SetType{
 			ElemType: AllowedAddressPairModelAttributeType,
@@ -154,6 +164,17 @@ var WorkloadPoolModelAttributeType = types.ObjectType{
 		"machines": types.ListType{
 			ElemType: MachineModelAttributeType,
 		},
+		"machines_by_hostname": types.MapType{
+			ElemType: MachineModelAttributeType,
+		},
+		"machine_count":       types.Int64Type,
+		"private_ips":         types.ListType{ElemType: types.StringType},
+		"public_ips":          types.ListType{ElemType: types.StringType},
+		"extra_spec_json":     jsontypes.NormalizedType{},
+		"image_update_policy": types.StringType,
+		"gpu_driver_version":  types.StringType,
+		"cuda_version":        types.StringType,
+		"hostname_pattern":    types.StringType,
 	},
 }
 
@@ -164,21 +185,27 @@ type WorkloadPoolModel struct {
 	ImageID  types.String `tfsdk:"image_id"`
 	FlavorID types.String `tfsdk:"flavor_id"`
 	// DiskSize          types.Int64  `tfsdk:"disk_size"`
-	UserData            types.String `tfsdk:"user_data"`
-	EnablePublicIP      types.Bool   `tfsdk:"enable_public_ip"`
-	AllowedAddressPairs types.Set    `tfsdk:"allowed_address_pairs"`
-	FirewallRules       types.List   `tfsdk:"firewall_rules"`
-	Machines            types.List   `tfsdk:"machines"`
+	UserData            tftypes.Base64StringValue `tfsdk:"user_data"`
+	EnablePublicIP      types.Bool                `tfsdk:"enable_public_ip"`
+	AllowedAddressPairs types.Set                 `tfsdk:"allowed_address_pairs"`
+	FirewallRules       types.List                `tfsdk:"firewall_rules"`
+	Machines            types.List                `tfsdk:"machines"`
+	MachinesByHostname  types.Map                 `tfsdk:"machines_by_hostname"`
+	MachineCount        types.Int64               `tfsdk:"machine_count"`
+	PrivateIPs          types.List                `tfsdk:"private_ips"`
+	PublicIPs           types.List                `tfsdk:"public_ips"`
+	ExtraSpecJSON       jsontypes.Normalized      `tfsdk:"extra_spec_json"`
+	ImageUpdatePolicy   types.String              `tfsdk:"image_update_policy"`
+	GPUDriverVersion    types.String              `tfsdk:"gpu_driver_version"`
+	CUDAVersion         types.String              `tfsdk:"cuda_version"`
+	HostnamePattern     types.String              `tfsdk:"hostname_pattern"`
 }
 
 func NewWorkloadPoolModel(
 	spec computeapi.ComputeClusterWorkloadPool,
 	status *computeapi.ComputeClusterWorkloadPoolStatus,
-) attr.Value {
-	var userData types.String
-	if spec.Machine.UserData != nil {
-		userData = types.StringValue(string(*spec.Machine.UserData))
-	}
+) types.Object {
+	userData, hostnamePattern := newUserDataModels(spec.Machine.UserData)
 
 	enablePublicIP := types.BoolValue(true)
 	if spec.Machine.PublicIPAllocation != nil {
@@ -200,8 +227,13 @@ func NewWorkloadPoolModel(
 	}
 
 	machines := types.ListNull(MachineModelAttributeType)
+	machineCount := types.Int64Null()
+	privateIPs := types.ListNull(types.StringType)
+	publicIPs := types.ListNull(types.StringType)
 	if status != nil && status.Machines != nil {
 		machines = NewMachineModels(*status.Machines)
+		machineCount = types.Int64Value(int64(len(*status.Machines)))
+		privateIPs, publicIPs = newMachineAddressLists(*status.Machines)
 	}
 
 	return types.ObjectValueMust(
@@ -219,6 +251,15 @@ func NewWorkloadPoolModel(
 			"allowed_address_pairs": allowedAddressPairs,
 			"firewall_rules":        firewallRules,
 			"machines":              machines,
+			"machines_by_hostname":  machinesByHostname(machines),
+			"machine_count":         machineCount,
+			"private_ips":           privateIPs,
+			"public_ips":            publicIPs,
+			"extra_spec_json":       jsontypes.NewNormalizedNull(),
+			"image_update_policy":   types.StringNull(),
+			"gpu_driver_version":    types.StringNull(),
+			"cuda_version":          types.StringNull(),
+			"hostname_pattern":      hostnamePattern,
 		},
 	)
 }
@@ -227,19 +268,19 @@ func NewWorkloadPoolModels(
 	specs []computeapi.ComputeClusterWorkloadPool,
 	statuses *computeapi.ComputeClusterWorkloadPoolsStatus,
 ) types.List {
-	statusMemo := make(map[string]*computeapi.ComputeClusterWorkloadPoolStatus)
+	// Index the statuses by pool name, pointing into the caller's slice rather
+	// than at a loop variable, so each pool is joined with its own status.
+	var statusByName map[string]*computeapi.ComputeClusterWorkloadPoolStatus
 	if statuses != nil {
-		workloadPools := *statuses
-		statusMemo = make(map[string]*computeapi.ComputeClusterWorkloadPoolStatus, len(workloadPools))
-		for _, workloadPool := range workloadPools {
-			statusMemo[workloadPool.Name] = &workloadPool
+		statusByName = make(map[string]*computeapi.ComputeClusterWorkloadPoolStatus, len(*statuses))
+		for i := range *statuses {
+			statusByName[(*statuses)[i].Name] = &(*statuses)[i]
 		}
 	}
 
 	pools := make([]attr.Value, 0, len(specs))
 	for _, spec := range specs {
-		status := statusMemo[spec.Name]
-		pools = append(pools, NewWorkloadPoolModel(spec, status))
+		pools = append(pools, NewWorkloadPoolModel(spec, statusByName[spec.Name]))
 	}
 
 	return types.ListValueMust(WorkloadPoolModelAttributeType, pools)
@@ -267,10 +308,9 @@ func (m *WorkloadPoolModel) NscaleWorkloadPool() (computeapi.ComputeClusterWorkl
 		firewallRules = append(firewallRules, firewallRule)
 	}
 
-	var userData *[]byte
-	if !m.UserData.IsNull() && !m.UserData.IsUnknown() {
-		temp := []byte(m.UserData.ValueString())
-		userData = &temp
+	userData, diagnostics := m.nscaleUserData()
+	if diagnostics.HasError() {
+		return computeapi.ComputeClusterWorkloadPool{}, diagnostics
 	}
 
 	var allowedAddressPairs *computeapi.AllowedAddressPairList
@@ -315,6 +355,8 @@ var FirewallRuleModelAttributeType = types.ObjectType{
 		"direction": types.StringType,
 		"protocol":  types.StringType,
 		"ports":     types.StringType,
+		"from_port": types.Int32Type,
+		"to_port":   types.Int32Type,
 		"prefixes": types.SetType{
 			ElemType: types.StringType,
 		},
@@ -325,13 +367,17 @@ type FirewallRuleModel struct {
 	Direction types.String `tfsdk:"direction"`
 	Protocol  types.String `tfsdk:"protocol"`
 	Ports     types.String `tfsdk:"ports"`
+	FromPort  types.Int32  `tfsdk:"from_port"`
+	ToPort    types.Int32  `tfsdk:"to_port"`
 	Prefixes  types.Set    `tfsdk:"prefixes"`
 }
 
 func NewFirewallRuleModel(source computeapi.FirewallRule) attr.Value {
 	ports := strconv.Itoa(source.Port)
+	portMax := source.Port
 	if source.PortMax != nil {
 		ports += "-" + strconv.Itoa(*source.PortMax)
+		portMax = *source.PortMax
 	}
 
 	prefixes := make([]attr.Value, 0, len(source.Prefixes))
@@ -345,6 +391,8 @@ func NewFirewallRuleModel(source computeapi.FirewallRule) attr.Value {
 			"direction": types.StringValue(string(source.Direction)),
 			"protocol":  types.StringValue(string(source.Protocol)),
 			"ports":     types.StringValue(ports),
+			"from_port": types.Int32Value(int32(source.Port)), //nolint:gosec // port numbers are 0-65535, within int32
+			"to_port":   types.Int32Value(int32(portMax)),     //nolint:gosec // port numbers are 0-65535, within int32
 			"prefixes":  tftypes.NullableSetValueMust(types.StringType, prefixes),
 		},
 	)
@@ -359,6 +407,13 @@ func NewFirewallRuleModels(source []computeapi.FirewallRule) types.List {
 }
 
 func (m *FirewallRuleModel) NscaleFirewallRule() (computeapi.FirewallRule, diag.Diagnostics) {
+	var prefixes []string
+	if diagnostics := m.Prefixes.ElementsAs(context.Background(), &prefixes, false); diagnostics.HasError() {
+		return computeapi.FirewallRule{}, diagnostics
+	}
+
+	// Rules given as from_port and to_port have their ports planned from them,
+	// so ports is always set.
 	ports := strings.Split(m.Ports.ValueString(), "-")
 	if len(ports) > portRangeParts {
 		diagnostics := NewErrorDiagnostics(
@@ -386,11 +441,6 @@ func (m *FirewallRuleModel) NscaleFirewallRule() (computeapi.FirewallRule, diag.
 		portMax = &portNumbers[1]
 	}
 
-	var prefixes []string
-	if diagnostics := m.Prefixes.ElementsAs(context.Background(), &prefixes, false); diagnostics.HasError() {
-		return computeapi.FirewallRule{}, diagnostics
-	}
-
 	firewallRule := computeapi.FirewallRule{
 		Direction: computeapi.FirewallRuleDirection(m.Direction.ValueString()),
 		Port:      portNumbers[0],
@@ -404,25 +454,33 @@ func (m *FirewallRuleModel) NscaleFirewallRule() (computeapi.FirewallRule, diag.
 
 var MachineModelAttributeType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
-		"hostname":   types.StringType,
-		"private_ip": types.StringType,
-		"public_ip":  types.StringType,
+		"hostname":       types.StringType,
+		"private_ip":     types.StringType,
+		"public_ip":      types.StringType,
+		"ssh_connection": machineSSHConnectionAttributeType,
 	},
 }
 
 type MachineModel struct {
-	Hostname  types.String `tfsdk:"hostname"`
-	PrivateIP types.String `tfsdk:"private_ip"`
-	PublicIP  types.String `tfsdk:"public_ip"`
+	Hostname      types.String `tfsdk:"hostname"`
+	PrivateIP     types.String `tfsdk:"private_ip"`
+	PublicIP      types.String `tfsdk:"public_ip"`
+	SSHConnection types.Object `tfsdk:"ssh_connection"`
 }
 
+// NewMachineModel returns the machine as the API returns it. The user of its
+// SSH connection is left null; see withSSHConnections.
 func NewMachineModel(source computeapi.ComputeClusterMachineStatus) attr.Value {
+	privateIP := types.StringPointerValue(source.PrivateIP)
+	publicIP := types.StringPointerValue(source.PublicIP)
+
 	return types.ObjectValueMust(
 		MachineModelAttributeType.AttrTypes,
 		map[string]attr.Value{
-			"hostname":   types.StringValue(source.Hostname),
-			"private_ip": types.StringPointerValue(source.PrivateIP),
-			"public_ip":  types.StringPointerValue(source.PublicIP),
+			"hostname":       types.StringValue(source.Hostname),
+			"private_ip":     privateIP,
+			"public_ip":      publicIP,
+			"ssh_connection": newMachineSSHConnection(publicIP, privateIP, types.StringNull()),
 		},
 	)
 }
@@ -435,6 +493,49 @@ func NewMachineModels(source []computeapi.ComputeClusterMachineStatus) types.Lis
 	return types.ListValueMust(MachineModelAttributeType, machines)
 }
 
+// machinesByHostname indexes machines by hostname, so that for_each over them
+// keys each machine the same way however the pool is scaled. It is null or
+// unknown when machines is.
+func machinesByHostname(machines types.List) types.Map {
+	if machines.IsNull() {
+		return types.MapNull(MachineModelAttributeType)
+	}
+	if machines.IsUnknown() {
+		return types.MapUnknown(MachineModelAttributeType)
+	}
+
+	byHostname := make(map[string]attr.Value, len(machines.Elements()))
+	for _, element := range machines.Elements() {
+		machine, ok := element.(types.Object)
+		if !ok || machine.IsNull() || machine.IsUnknown() {
+			continue
+		}
+
+		if hostname, ok := machine.Attributes()["hostname"].(types.String); ok && !hostname.IsNull() && !hostname.IsUnknown() {
+			byHostname[hostname.ValueString()] = machine
+		}
+	}
+
+	return types.MapValueMust(MachineModelAttributeType, byHostname)
+}
+
+// newMachineAddressLists returns the private and public IP addresses of the
+// machines, in machine order. Machines without an address of a kind are
+// skipped in that list.
+func newMachineAddressLists(source []computeapi.ComputeClusterMachineStatus) (types.List, types.List) {
+	privateIPs := make([]attr.Value, 0, len(source))
+	publicIPs := make([]attr.Value, 0, len(source))
+	for _, data := range source {
+		if data.PrivateIP != nil {
+			privateIPs = append(privateIPs, types.StringValue(*data.PrivateIP))
+		}
+		if data.PublicIP != nil {
+			publicIPs = append(publicIPs, types.StringValue(*data.PublicIP))
+		}
+	}
+	return types.ListValueMust(types.StringType, privateIPs), types.ListValueMust(types.StringType, publicIPs)
+}
+
 func NewErrorDiagnostics(summary, detail string) diag.Diagnostics {
 	var diagnostics diag.Diagnostics
 	diagnostics.AddError(summary, detail)
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/pointer"
+)
+
+// testWorkloadPools returns the specs of count pools and their statuses, in
+// reverse order so the join cannot rely on position. Every pool but the last
+// has one machine whose hostname names its pool; the last has no status.
+func testWorkloadPools(count int) (
+	[]computeapi.ComputeClusterWorkloadPool,
+	computeapi.ComputeClusterWorkloadPoolsStatus,
+) {
+	specs := make([]computeapi.ComputeClusterWorkloadPool, 0, count)
+	statuses := make(computeapi.ComputeClusterWorkloadPoolsStatus, 0, count)
+
+	for i := range count {
+		name := fmt.Sprintf("pool-%d", i)
+		specs = append(specs, computeapi.ComputeClusterWorkloadPool{
+			Name:    name,
+			Machine: computeapi.MachinePool{Replicas: 1, FlavorId: "flavor"},
+		})
+
+		if i < count-1 {
+			machines := computeapi.ComputeClusterMachinesStatus{{Hostname: name + "-0"}}
+			statuses = append(statuses, computeapi.ComputeClusterWorkloadPoolStatus{Name: name, Machines: &machines})
+		}
+	}
+
+	slices.Reverse(statuses)
+
+	return specs, statuses
+}
+
+func TestNewWorkloadPoolModelsJoinsEachPoolWithItsStatus(t *testing.T) {
+	const count = 64
+
+	specs, statuses := testWorkloadPools(count)
+
+	var pools []WorkloadPoolModel
+	list := NewWorkloadPoolModels(specs, &statuses)
+	if diagnostics := list.ElementsAs(context.Background(), &pools, false); diagnostics.HasError() {
+		t.Fatalf("failed to decode workload pools: %v", diagnostics)
+	}
+
+	if len(pools) != count {
+		t.Fatalf("got %d workload pools, want %d", len(pools), count)
+	}
+
+	for i, pool := range pools {
+		if got, want := pool.Name.ValueString(), specs[i].Name; got != want {
+			t.Fatalf("pool %d is named %q, want %q", i, got, want)
+		}
+
+		if i == count-1 {
+			if !pool.Machines.IsNull() {
+				t.Fatalf("pool %q has no status but got machines %v", pool.Name.ValueString(), pool.Machines)
+			}
+			continue
+		}
+
+		var machines []MachineModel
+		if diagnostics := pool.Machines.ElementsAs(context.Background(), &machines, false); diagnostics.HasError() {
+			t.Fatalf("failed to decode machines of pool %q: %v", pool.Name.ValueString(), diagnostics)
+		}
+
+		want := pool.Name.ValueString() + "-0"
+		if len(machines) != 1 || machines[0].Hostname.ValueString() != want {
+			t.Fatalf("pool %q has machines %v, want a single machine %q", pool.Name.ValueString(), machines, want)
+		}
+	}
+}
+
+func BenchmarkNewWorkloadPoolModels(b *testing.B) {
+	for _, count := range []int{1, 8, 64, 256} {
+		specs, statuses := testWorkloadPools(count)
+
+		b.Run(fmt.Sprintf("pools=%d", count), func(b *testing.B) {
+			for b.Loop() {
+				NewWorkloadPoolModels(specs, &statuses)
+			}
+		})
+	}
+}
+
+func TestNewWorkloadPoolModelSummarizesMachines(t *testing.T) {
+	privateIPs := []string{"10.0.0.1", "10.0.0.2"}
+	publicIP := "192.0.2.1"
+	machines := computeapi.ComputeClusterMachinesStatus{
+		{Hostname: "pool-0", PrivateIP: &privateIPs[0], PublicIP: &publicIP},
+		{Hostname: "pool-1", PrivateIP: &privateIPs[1]},
+	}
+
+	list := withoutMachineDetails(NewWorkloadPoolModels(
+		[]computeapi.ComputeClusterWorkloadPool{{Name: "pool"}},
+		&computeapi.ComputeClusterWorkloadPoolsStatus{{Name: "pool", Machines: &machines}},
+	))
+
+	var pools []WorkloadPoolModel
+	if diagnostics := list.ElementsAs(context.Background(), &pools, false); diagnostics.HasError() {
+		t.Fatalf("failed to decode workload pools: %v", diagnostics)
+	}
+
+	pool := pools[0]
+	if !pool.Machines.IsNull() {
+		t.Fatalf("machines = %v, want null without machine details", pool.Machines)
+	}
+
+	if !pool.MachinesByHostname.IsNull() {
+		t.Fatalf("machines_by_hostname = %v, want null without machine details", pool.MachinesByHostname)
+	}
+
+	if got := pool.MachineCount.ValueInt64(); got != 2 {
+		t.Fatalf("machine_count = %d, want 2", got)
+	}
+
+	var gotPrivateIPs, gotPublicIPs []string
+	pool.PrivateIPs.ElementsAs(context.Background(), &gotPrivateIPs, false)
+	pool.PublicIPs.ElementsAs(context.Background(), &gotPublicIPs, false)
+
+	if !slices.Equal(gotPrivateIPs, privateIPs) {
+		t.Fatalf("private_ips = %v, want %v", gotPrivateIPs, privateIPs)
+	}
+
+	if !slices.Equal(gotPublicIPs, []string{publicIP}) {
+		t.Fatalf("public_ips = %v, want [%s]", gotPublicIPs, publicIP)
+	}
+}
+
+func TestWithoutMachineSummaries(t *testing.T) {
+	privateIP := "10.0.0.1"
+	machines := computeapi.ComputeClusterMachinesStatus{{Hostname: "pool-0", PrivateIP: &privateIP}}
+
+	list := withoutMachineSummaries(NewWorkloadPoolModels(
+		[]computeapi.ComputeClusterWorkloadPool{{Name: "pool"}},
+		&computeapi.ComputeClusterWorkloadPoolsStatus{{Name: "pool", Machines: &machines}},
+	))
+
+	var pools []WorkloadPoolModel
+	if diagnostics := list.ElementsAs(context.Background(), &pools, false); diagnostics.HasError() {
+		t.Fatalf("failed to decode workload pools: %v", diagnostics)
+	}
+
+	pool := pools[0]
+	if len(pool.Machines.Elements()) != 1 {
+		t.Fatalf("machines = %v, want the machine kept", pool.Machines)
+	}
+
+	if !pool.MachineCount.IsNull() || !pool.PrivateIPs.IsNull() || !pool.PublicIPs.IsNull() {
+		t.Fatalf(
+			"machine_count, private_ips, public_ips = %v, %v, %v, want null with machine details",
+			pool.MachineCount, pool.PrivateIPs, pool.PublicIPs,
+		)
+	}
+}
+
+func TestNewWorkloadPoolModelIndexesMachinesByHostname(t *testing.T) {
+	privateIPs := []string{"10.0.0.1", "10.0.0.2"}
+	machines := computeapi.ComputeClusterMachinesStatus{
+		{Hostname: "pool-b", PrivateIP: &privateIPs[0]},
+		{Hostname: "pool-a", PrivateIP: &privateIPs[1]},
+	}
+
+	list := NewWorkloadPoolModels(
+		[]computeapi.ComputeClusterWorkloadPool{{Name: "pool"}},
+		&computeapi.ComputeClusterWorkloadPoolsStatus{{Name: "pool", Machines: &machines}},
+	)
+
+	var pools []WorkloadPoolModel
+	if diagnostics := list.ElementsAs(context.Background(), &pools, false); diagnostics.HasError() {
+		t.Fatalf("failed to decode workload pools: %v", diagnostics)
+	}
+
+	var byHostname map[string]MachineModel
+	diagnostics := pools[0].MachinesByHostname.ElementsAs(context.Background(), &byHostname, false)
+	if diagnostics.HasError() {
+		t.Fatalf("failed to decode machines_by_hostname: %v", diagnostics)
+	}
+
+	if len(byHostname) != len(machines) {
+		t.Fatalf("machines_by_hostname has %d machines, want %d", len(byHostname), len(machines))
+	}
+
+	for _, machine := range machines {
+		got, found := byHostname[machine.Hostname]
+		if !found || got.PrivateIP.ValueString() != *machine.PrivateIP {
+			t.Fatalf("machines_by_hostname[%q] = %v, want the machine at %s", machine.Hostname, got, *machine.PrivateIP)
+		}
+	}
+
+	if got := machinesByHostname(types.ListUnknown(MachineModelAttributeType)); !got.IsUnknown() {
+		t.Fatalf("machinesByHostname(unknown) = %v, want unknown", got)
+	}
+}
+
+func TestFirewallRulePortRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		port     int
+		portMax  *int
+		wantFrom int32
+		wantTo   int32
+	}{
+		{name: "single port", port: 22, wantFrom: 22, wantTo: 22},
+		{name: "port range", port: 80, portMax: pointer.Reference(443), wantFrom: 80, wantTo: 443},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rule := computeapi.FirewallRule{
+				Direction: computeapi.Ingress,
+				Protocol:  computeapi.FirewallRuleProtocol("tcp"),
+				Port:      testCase.port,
+				PortMax:   testCase.portMax,
+				Prefixes:  []string{"10.0.0.0/24"},
+			}
+
+			var model FirewallRuleModel
+			object, _ := NewFirewallRuleModel(rule).(types.Object)
+			if diagnostics := object.As(context.Background(), &model, basetypes.ObjectAsOptions{}); diagnostics.HasError() {
+				t.Fatalf("failed to decode firewall rule: %v", diagnostics)
+			}
+
+			if model.FromPort.ValueInt32() != testCase.wantFrom || model.ToPort.ValueInt32() != testCase.wantTo {
+				t.Fatalf("from_port, to_port = %v, %v, want %d, %d",
+					model.FromPort, model.ToPort, testCase.wantFrom, testCase.wantTo)
+			}
+
+			first, last, ok := splitPorts(model.Ports.ValueString())
+			if !ok || first != testCase.wantFrom || last != testCase.wantTo {
+				t.Fatalf("ports %q split into %d, %d, want %d, %d",
+					model.Ports.ValueString(), first, last, testCase.wantFrom, testCase.wantTo)
+			}
+		})
+	}
+}
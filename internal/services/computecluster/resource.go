@@ -20,17 +20,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -38,7 +47,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -46,12 +57,15 @@ var (
 	_ resource.Resource                = &ComputeClusterResource{}
 	_ resource.ResourceWithConfigure   = &ComputeClusterResource{}
 	_ resource.ResourceWithImportState = &ComputeClusterResource{}
+	_ resource.ResourceWithModifyPlan  = &ComputeClusterResource{}
 )
 
 type ComputeClusterResourceModel struct {
 	ComputeClusterModel
 
-	Timeouts tftimeouts.Value `tfsdk:"timeouts"`
+	StoreMachineDetails types.Bool       `tfsdk:"store_machine_details"`
+	MachineGeneration   types.Int64      `tfsdk:"machine_generation"`
+	Timeouts            tftimeouts.Value `tfsdk:"timeouts"`
 }
 
 // ComputeClusterResource embeds the generic CRUD base; only Schema and the
@@ -72,25 +86,103 @@ func NewComputeClusterResource() resource.Resource {
 // mapping into the generic resource skeleton.
 func computeClusterAdapter() nscale.ResourceAdapter[ComputeClusterResourceModel, computeapi.ComputeClusterRead] {
 	return nscale.ResourceAdapter[ComputeClusterResourceModel, computeapi.ComputeClusterRead]{
-		TypeNameSuffix: "_compute_cluster",
-		Title:          "Compute Cluster",
-		Name:           "compute cluster",
-		Create:         computeClusterCreate,
-		Update:         computeClusterUpdate,
-		Delete:         computeClusterDelete,
+		TypeNameSuffix:  "_compute_cluster",
+		Title:           "Compute Cluster",
+		Name:            "compute cluster",
+		RequiredFeature: nscale.ComputeClusterAPIV1,
+		Create:          computeClusterCreate,
+		Update:          computeClusterUpdate,
+		Delete:          computeClusterDelete,
 		Get: func(
 			ctx context.Context,
 			client *nscale.Client,
 			id string,
 		) (*computeapi.ComputeClusterRead, nscale.ResourceStatus, error) {
-			return nscale.AdaptProjectScoped(getComputeCluster(ctx, client.OrganizationID, id, client))
+			return nscale.AdaptProjectScoped(getComputeCluster(ctx, client.OrganizationID, client.ProjectID, id, client))
 		},
 		ToModel: func(api *computeapi.ComputeClusterRead, dst *ComputeClusterResourceModel) {
-			dst.ComputeClusterModel = NewComputeClusterModel(api)
+			priorPools := dst.WorkloadPools
+			priorDescription := dst.Description
+			dst.ComputeClusterModel = NewComputeClusterModel(withoutDetachedPools(api))
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
+			dst.WorkloadPools = withPoolSettings(dst.WorkloadPools, priorPools)
+			dst.WorkloadPools = withPendingImageUpdates(dst.WorkloadPools, api)
+			dst.WorkloadPools = withPriorSSHConnections(dst.WorkloadPools, priorPools)
+
+			// Imported state has no configuration to take the default from.
+			if dst.StoreMachineDetails.IsNull() {
+				dst.StoreMachineDetails = types.BoolValue(true)
+			}
+
+			if dst.StoreMachineDetails.ValueBool() {
+				dst.WorkloadPools = withoutMachineSummaries(dst.WorkloadPools)
+			} else {
+				dst.WorkloadPools = withoutMachineDetails(dst.WorkloadPools)
+			}
+
+			// The generation counts machine churn from the first read of the
+			// cluster, which a create or import makes.
+			if dst.MachineGeneration.IsNull() || dst.MachineGeneration.IsUnknown() {
+				dst.MachineGeneration = types.Int64Value(0)
+			}
+		},
+		// Only a refresh counts replaced machines: an update plans the
+		// generation as it is in state, so it may not change it.
+		Refreshed: func(prior ComputeClusterResourceModel, dst *ComputeClusterResourceModel) {
+			if machinesReplaced(prior.WorkloadPools, dst.WorkloadPools) {
+				dst.MachineGeneration = types.Int64Value(dst.MachineGeneration.ValueInt64() + 1)
+			}
 		},
-		IDFromModel:       func(m ComputeClusterResourceModel) string { return m.ID.ValueString() },
-		TimeoutsFromModel: func(m ComputeClusterResourceModel) tftimeouts.Value { return m.Timeouts },
+		Enrich: func(ctx context.Context, client *nscale.Client, dst *ComputeClusterResourceModel) diag.Diagnostics {
+			var diagnostics diag.Diagnostics
+			dst.WorkloadPools, diagnostics = withSSHConnections(
+				ctx, client, dst.RegionID.ValueString(), dst.WorkloadPools,
+			)
+			return diagnostics
+		},
+		Settled: func(api *computeapi.ComputeClusterRead, plan ComputeClusterResourceModel) bool {
+			return publicIPsSettled(api, plan.WorkloadPools)
+		},
+		MetadataOnly: func(prior, plan ComputeClusterResourceModel) bool {
+			return metadataOnly(prior.ComputeClusterModel, plan.ComputeClusterModel)
+		},
+		IDFromModel:         func(m ComputeClusterResourceModel) string { return m.ID.ValueString() },
+		TimeoutsFromModel:   func(m ComputeClusterResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:              true,
+		SensitiveAttributes: []path.Path{path.Root("ssh_private_key")},
+		ModifyPlan:          computeClusterModifyPlan,
+	}
+}
+
+// computeClusterModifyPlan checks that the images of the pools provide the
+// GPU driver and CUDA versions the pools pin.
+func computeClusterModifyPlan(
+	ctx context.Context,
+	client *nscale.Client,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	plan, diagnostics := nscale.ReadTerraformState[ComputeClusterResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	priorPools := types.ListNull(WorkloadPoolModelAttributeType)
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("workload_pools"), &priorPools)...)
+	}
+
+	regionID := plan.RegionID.ValueString()
+	if regionID == "" {
+		regionID = client.RegionID
 	}
+
+	checkVersionPins(ctx, client, regionID, poolVersionPins(plan.WorkloadPools, priorPools), &response.Diagnostics)
 }
 
 func (r *ComputeClusterResource) Schema(
@@ -123,8 +215,14 @@ func (r *ComputeClusterResource) Schema(
 			"workload_pools": schema.ListNestedAttribute{
 				MarkdownDescription: "A list of pools of workload nodes in the compute cluster.",
 				Required:            true,
+				Validators: []validator.List{
+					UniquePoolNamesValidator{},
+				},
+				PlanModifiers: []planmodifier.List{
+					unchangedPoolMachinesPlanModifier{},
+				},
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: workloadPoolAttributes(map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							MarkdownDescription: "The name of the workload pool.",
 							Required:            true,
@@ -132,131 +230,24 @@ func (r *ComputeClusterResource) Schema(
 								validators.NameValidator(),
 							},
 						},
-						"replicas": schema.Int64Attribute{
-							MarkdownDescription: "The number of replicas (VMs) to provision in this workload pool.",
-							Required:            true,
-							Validators: []validator.Int64{
-								int64validator.AtLeast(1),
-							},
-						},
-						"image_id": schema.StringAttribute{
-							MarkdownDescription: "The identifier of the image used for initializing the boot disk of the workload pool VMs.",
-							Required:            true,
-						},
-						"flavor_id": schema.StringAttribute{
-							MarkdownDescription: "The identifier of the flavor (machine type) used for the workload pool VMs.",
-							Required:            true,
-						},
-						// "disk_size": schema.Int64Attribute{
-						// 	MarkdownDescription: "The size of the boot disk for each VM in the workload pool, in GiB.",
-						// 	Optional:            true,
-						// 	Validators: []validator.Int64{
-						// 		int64validator.AtLeast(10),
-						// 	},
-						// },
-						"user_data": schema.StringAttribute{
-							MarkdownDescription: "The data to pass to the VMs at boot time.",
-							Optional:            true,
-							Validators: []validator.String{
-								validators.Base64Validator{},
-							},
-						},
-						"enable_public_ip": schema.BoolAttribute{
-							MarkdownDescription: "Whether to assign a public IP address to each VM in this workload pool. Default is `true`.",
-							Optional:            true,
-							Computed:            true,
-							Default:             booldefault.StaticBool(true),
-						},
-						"allowed_address_pairs": schema.SetNestedAttribute{
-							MarkdownDescription: "Allowed addresses that can pass through this workload pool's network ports. Each pair specifies a CIDR prefix and optionally a MAC address. Typically required when the machine is operating as a router.",
-							Optional:            true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"cidr": schema.StringAttribute{
-										MarkdownDescription: "The CIDR prefix to allow.",
-										Required:            true,
-										Validators: []validator.String{
-											validators.CIDRValidator{},
-										},
-									},
-									"mac_address": schema.StringAttribute{
-										MarkdownDescription: "The MAC address to allow. Optional.",
-										Optional:            true,
-									},
-								},
-							},
-							Validators: []validator.Set{
-								setvalidator.SizeAtLeast(1),
-							},
-						},
-						"firewall_rules": schema.ListNestedAttribute{
-							MarkdownDescription: "A list of firewall rules for the VMs in this workload pool.",
-							Optional:            true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"direction": schema.StringAttribute{
-										MarkdownDescription: "The direction of the traffic to which this firewall rule applies. Default is `ingress`.",
-										Optional:            true,
-										Computed:            true,
-										Default:             stringdefault.StaticString("ingress"),
-										Validators: []validator.String{
-											stringvalidator.OneOf("ingress", "egress"),
-										},
-									},
-									"protocol": schema.StringAttribute{
-										MarkdownDescription: "The IP protocol to which this firewall rule applies. Valid values are `tcp` or `udp`.",
-										Required:            true,
-										Validators: []validator.String{
-											stringvalidator.OneOf("tcp", "udp"),
-										},
-									},
-									"ports": schema.StringAttribute{
-										MarkdownDescription: "The ports to which this firewall rule applies. This can be a single port, or a range of ports. For example: `22`, `80-443`.",
-										Required:            true,
-										Validators: []validator.String{
-											PortsValidator{},
-										},
-									},
-									"prefixes": schema.SetAttribute{
-										MarkdownDescription: "A set of CIDR prefixes to which this firewall rule applies.",
-										ElementType:         types.StringType,
-										Required:            true,
-										Validators: []validator.Set{
-											setvalidator.SizeAtLeast(1),
-											setvalidator.ValueStringsAre(validators.CIDRValidator{}),
-										},
-									},
-								},
-							},
-							Validators: []validator.List{
-								listvalidator.SizeAtLeast(1),
-							},
-						},
-						"machines": schema.ListNestedAttribute{
-							MarkdownDescription: "A list of machines in this workload pool.",
-							Computed:            true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"hostname": schema.StringAttribute{
-										MarkdownDescription: "The hostname of the machine.",
-										Computed:            true,
-									},
-									"private_ip": schema.StringAttribute{
-										MarkdownDescription: "The private IP address of the machine.",
-										Computed:            true,
-									},
-									"public_ip": schema.StringAttribute{
-										MarkdownDescription: "The public IP address of the machine, if assigned.",
-										Computed:            true,
-									},
-								},
-							},
-						},
-					},
+					}),
+				},
+			},
+			"store_machine_details": schema.BoolAttribute{
+				MarkdownDescription: "Whether to keep the `machines` and `machines_by_hostname` of each workload pool in state. Set to `false` for very large clusters to keep only each pool's `machine_count`, `private_ips` and `public_ips`, which greatly reduces the size of the state. Default is `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"machine_generation": schema.Int64Attribute{
+				MarkdownDescription: "A counter that increases each time a refresh finds that machines of a workload pool have been replaced or removed, for example when the platform heals a failed machine. The `machines`, `machines_by_hostname`, `machine_count`, `private_ips` and `public_ips` of a workload pool are only planned to change when the pool itself changes, so this is where such churn shows up.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"ssh_private_key": schema.StringAttribute{
-				MarkdownDescription: "The SSH private key for accessing the compute cluster.",
+				MarkdownDescription: "The SSH private key for accessing the compute cluster. Null when the provider is configured with `disallow_sensitive_in_state`.",
 				Computed:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
@@ -284,13 +275,37 @@ func (r *ComputeClusterResource) Schema(
 				MarkdownDescription: "The provisioning status of the compute cluster.",
 				Computed:            true,
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("compute cluster"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					nscale.SpecFingerprintPlanModifier("workload_pools", "region_id"),
+				},
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the compute cluster was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the compute cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the compute cluster.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the compute cluster was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
@@ -302,6 +317,406 @@ func (r *ComputeClusterResource) Schema(
 	}
 }
 
+// workloadPoolManagedFields are the fields of a workload pool set from its
+// attributes, which extra_spec_json may not override.
+//
+//nolint:gochecknoglobals // constant list.
+var workloadPoolManagedFields = []string{
+	"name",
+	"machine.allowedAddressPairs",
+	"machine.firewall",
+	"machine.flavorId",
+	"machine.image",
+	"machine.publicIPAllocation",
+	"machine.replicas",
+	"machine.userData",
+}
+
+// workloadPoolAttributes adds the schema of a workload pool's machine
+// configuration to attributes, which holds the attributes that identify the
+// pool. It is shared by the pools nested in nscale_compute_cluster and the
+// standalone nscale_compute_cluster_workload_pool resource.
+//
+//nolint:funlen // flat attribute declarations, like Schema.
+func workloadPoolAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	machine := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the machine.",
+				Computed:            true,
+			},
+			"private_ip": schema.StringAttribute{
+				MarkdownDescription: "The private IP address of the machine.",
+				Computed:            true,
+			},
+			"public_ip": schema.StringAttribute{
+				MarkdownDescription: "The public IP address of the machine, if assigned.",
+				Computed:            true,
+			},
+			"ssh_connection": schema.SingleNestedAttribute{
+				MarkdownDescription: machineSSHConnectionDescription,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The address to connect to.",
+						Computed:            true,
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "The user to connect as.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"replicas": schema.Int64Attribute{
+			MarkdownDescription: "The number of replicas (VMs) to provision in this workload pool.",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"image_id": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the image used for initializing the boot disk of the workload pool VMs.",
+			Required:            true,
+		},
+		"flavor_id": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the flavor (machine type) used for the workload pool VMs.",
+			Required:            true,
+		},
+		// "disk_size": schema.Int64Attribute{
+		// 	MarkdownDescription: "The size of the boot disk for each VM in the workload pool, in GiB.",
+		// 	Optional:            true,
+		// 	Validators: []validator.Int64{
+		// 		int64validator.AtLeast(10),
+		// 	},
+		// },
+		"user_data": schema.StringAttribute{
+			MarkdownDescription: "The base64-encoded data to pass to the VMs at boot time. Values that decode to the same data, such as ones differing only in padding or line breaks, are not treated as a change.",
+			CustomType:          tftypes.Base64StringType{},
+			Optional:            true,
+			Validators: []validator.String{
+				validators.Base64Validator{},
+			},
+		},
+		"hostname_pattern": schema.StringAttribute{
+			MarkdownDescription: "The hostname to give each VM in this workload pool, so machine names are predictable for inventories, for example `train-{pool}-{ip}`. It may contain lowercase letters, digits, hyphens and the placeholders `{pool}`, for the name of the workload pool, `{hostname}`, for the hostname the platform assigns to the VM, and `{ip}`, for the VM's private IPv4 address with its dots replaced by hyphens. It must contain `{hostname}` or `{ip}` so that the VMs get different names. The machines of a pool share their user data, so there is no placeholder for a machine's index. The hostname is set on every boot by a cloud-config the provider combines with `user_data` into a multi-part message, which requires images that use cloud-init, and the `hostname` of `machines` remains the one the platform assigned. Like `user_data`, it only applies to VMs created after it changes.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(
+					hostnamePatternRegex,
+					"must only contain lowercase letters, digits, hyphens and the placeholders {pool}, {hostname} and {ip}",
+				),
+				stringvalidator.RegexMatches(hostnamePatternUniqueRegex, "must contain {hostname} or {ip}"),
+			},
+		},
+		"extra_spec_json": schema.StringAttribute{
+			MarkdownDescription: "A JSON object deep-merged into this workload pool in the compute cluster's API requests, to set fields the provider does not model yet, for example `jsonencode({ machine = { disk = { size = 100 } } })`. It may not set fields managed by other attributes. The fields it sets are not read back, so changes made outside Terraform are not detected, and they are only sent when the resource managing this pool writes the cluster.",
+			CustomType:          jsontypes.NormalizedType{},
+			Optional:            true,
+			Validators: []validator.String{
+				validators.ExtraSpecValidator{ManagedFields: workloadPoolManagedFields},
+			},
+		},
+		"image_update_policy": schema.StringAttribute{
+			MarkdownDescription: "What happens to the VMs of this workload pool when `image_id` changes. With `ignore`, they keep the image they were created from and only VMs created later use the new one, which is what the API does on its own. With `replace_all`, every VM not on the new image is evicted and replaced at once. With `rolling`, they are evicted and replaced one at a time, each replacement being provisioned before the next VM is evicted. Replacement VMs have new hostnames and IP addresses, and the disks of the evicted VMs are lost. While VMs remain on another image, for example after a replacement fails or when the policy is set on a pool with such VMs, `image_id` is read as that image, so the next plan replaces them. Default is `ignore`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(imageUpdatePolicyIgnore),
+			Validators: []validator.String{
+				stringvalidator.OneOf(imageUpdatePolicies...),
+			},
+		},
+		"gpu_driver_version": schema.StringAttribute{
+			MarkdownDescription: "The GPU driver version the image of this workload pool must provide, such as `570` or `570.124`, to pin the driver supported by the frameworks run on the VMs. A version matches itself and the more specific versions within it, so `570` matches `570.124.06`. The pool's image is checked when planning, and a plan that would boot an image with another driver, or without one, fails and lists the images that match. The API selects the driver only through the image, so this is not sent to it.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"cuda_version": schema.StringAttribute{
+			MarkdownDescription: "The CUDA version the image of this workload pool must provide, such as `12` or `12.8`, matched and checked when planning as `gpu_driver_version` is.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"enable_public_ip": schema.BoolAttribute{
+			MarkdownDescription: "Whether to assign a public IP address to each VM in this workload pool. Default is `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"allowed_address_pairs": schema.SetNestedAttribute{
+			MarkdownDescription: "Allowed addresses that can pass through this workload pool's network ports. Each pair specifies a CIDR prefix and optionally a MAC address. Typically required when the machine is operating as a router.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"cidr": schema.StringAttribute{
+						MarkdownDescription: "The CIDR prefix to allow.",
+						Required:            true,
+						Validators: []validator.String{
+							validators.CIDRValidator{},
+						},
+					},
+					"mac_address": schema.StringAttribute{
+						MarkdownDescription: "The MAC address to allow. Optional.",
+						Optional:            true,
+					},
+				},
+			},
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"firewall_rules": schema.ListNestedAttribute{
+			MarkdownDescription: "A list of firewall rules for the VMs in this workload pool.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"direction": schema.StringAttribute{
+						MarkdownDescription: "The direction of the traffic to which this firewall rule applies. Default is `ingress`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("ingress"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("ingress", "egress"),
+						},
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "The IP protocol to which this firewall rule applies. Valid values are `tcp` or `udp`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("tcp", "udp"),
+						},
+					},
+					"ports": schema.StringAttribute{
+						MarkdownDescription: "The ports to which this firewall rule applies. This can be a single port, or a range of ports. For example: `22`, `80-443`. Either this or `from_port` is required. When not set, this is computed from `from_port` and `to_port`.",
+						DeprecationMessage:  "Use from_port and to_port instead. The ports attribute will be removed in the next major release.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							PortsValidator{},
+							stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("from_port")),
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("from_port"),
+								path.MatchRelative().AtParent().AtName("to_port"),
+							),
+						},
+						PlanModifiers: []planmodifier.String{
+							firewallRulePortsPlanModifier{},
+						},
+					},
+					"from_port": schema.Int32Attribute{
+						MarkdownDescription: "The first port of the range of ports to which this firewall rule applies. When not set, this is computed from `ports`.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int32{
+							int32validator.Between(0, maxPortNumber),
+						},
+						PlanModifiers: []planmodifier.Int32{
+							firewallRulePortRangePlanModifier{first: true},
+						},
+					},
+					"to_port": schema.Int32Attribute{
+						MarkdownDescription: "The last port of the range of ports to which this firewall rule applies. Defaults to `from_port`, for a single port. When not set, this is computed from `ports` or `from_port`.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int32{
+							int32validator.Between(0, maxPortNumber),
+							int32validator.AtLeastSumOf(path.MatchRelative().AtParent().AtName("from_port")),
+							int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("from_port")),
+						},
+						PlanModifiers: []planmodifier.Int32{
+							firewallRulePortRangePlanModifier{first: false},
+						},
+					},
+					"prefixes": schema.SetAttribute{
+						MarkdownDescription: "A set of CIDR prefixes to which this firewall rule applies.",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(validators.CIDRValidator{}),
+						},
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				UniqueFirewallRulesValidator{},
+			},
+		},
+		"machines": schema.ListNestedAttribute{
+			MarkdownDescription: "A list of machines in this workload pool.",
+			Computed:            true,
+			NestedObject:        machine,
+		},
+		"machines_by_hostname": schema.MapNestedAttribute{
+			MarkdownDescription: "The machines in this workload pool by hostname, for `for_each` in other resources that keeps each machine's key when the pool is scaled.",
+			Computed:            true,
+			NestedObject:        machine,
+		},
+		"machine_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of machines in this workload pool. " + machineSummaryDescription,
+			Computed:            true,
+		},
+		"private_ips": schema.ListAttribute{
+			MarkdownDescription: "The private IP addresses of the machines in this workload pool. " +
+				machineSummaryDescription,
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"public_ips": schema.ListAttribute{
+			MarkdownDescription: "The public IP addresses of the machines in this workload pool that have one. " +
+				machineSummaryDescription,
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	})
+
+	return attributes
+}
+
+// machineSummaryDescription completes the descriptions of the pool attributes
+// that summarize its machines.
+const machineSummaryDescription = "Only set by `nscale_compute_cluster` with `store_machine_details` set to " +
+	"`false`, in place of `machines`; null otherwise."
+
+// unchangedPoolMachinesPlanModifier plans the machines of each workload pool
+// whose configuration is unchanged as they are in state. Without it, any change
+// to the cluster plans the machines of every pool as unknown, and the nested
+// diffs hide which pools the change actually touches.
+type unchangedPoolMachinesPlanModifier struct{}
+
+func (m unchangedPoolMachinesPlanModifier) Description(_ context.Context) string {
+	return "Keeps the machines of workload pools whose configuration is unchanged as they are in state."
+}
+
+func (m unchangedPoolMachinesPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m unchangedPoolMachinesPlanModifier) PlanModifyList(
+	ctx context.Context,
+	request planmodifier.ListRequest,
+	response *planmodifier.ListResponse,
+) {
+	if request.StateValue.IsNull() || request.StateValue.IsUnknown() {
+		return
+	}
+	if request.PlanValue.IsNull() || request.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Changing store_machine_details changes what is stored of every pool.
+	var planned, stored types.Bool
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("store_machine_details"), &planned)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("store_machine_details"), &stored)...)
+	if response.Diagnostics.HasError() || !planned.Equal(stored) {
+		return
+	}
+
+	response.PlanValue = withUnchangedPoolMachines(request.PlanValue, request.StateValue)
+}
+
+// firewallRulePortsPlanModifier plans the ports of a firewall rule given as
+// from_port and to_port as the API reports them back.
+type firewallRulePortsPlanModifier struct{}
+
+func (m firewallRulePortsPlanModifier) Description(_ context.Context) string {
+	return "Computes the ports from from_port and to_port when they are set instead."
+}
+
+func (m firewallRulePortsPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m firewallRulePortsPlanModifier) PlanModifyString(
+	ctx context.Context,
+	request planmodifier.StringRequest,
+	response *planmodifier.StringResponse,
+) {
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	rulePath := request.Path.ParentPath()
+
+	var fromPort, toPort types.Int32
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, rulePath.AtName("from_port"), &fromPort)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, rulePath.AtName("to_port"), &toPort)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case fromPort.IsUnknown() || toPort.IsUnknown():
+		response.PlanValue = types.StringUnknown()
+	case fromPort.IsNull():
+		response.PlanValue = types.StringNull()
+	case toPort.IsNull() || toPort.Equal(fromPort):
+		response.PlanValue = types.StringValue(strconv.Itoa(int(fromPort.ValueInt32())))
+	default:
+		response.PlanValue = types.StringValue(fmt.Sprintf("%d-%d", fromPort.ValueInt32(), toPort.ValueInt32()))
+	}
+}
+
+// firewallRulePortRangePlanModifier plans from_port, or to_port, of a firewall
+// rule given as ports, or only as from_port, as the API reports them back.
+type firewallRulePortRangePlanModifier struct {
+	// first selects from_port rather than to_port.
+	first bool
+}
+
+func (m firewallRulePortRangePlanModifier) Description(_ context.Context) string {
+	return "Computes the port from ports, or to_port from from_port, when it is not set."
+}
+
+func (m firewallRulePortRangePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m firewallRulePortRangePlanModifier) PlanModifyInt32(
+	ctx context.Context,
+	request planmodifier.Int32Request,
+	response *planmodifier.Int32Response,
+) {
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	rulePath := request.Path.ParentPath()
+
+	var ports types.String
+	var fromPort types.Int32
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, rulePath.AtName("ports"), &ports)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, rulePath.AtName("from_port"), &fromPort)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !m.first && !fromPort.IsNull() {
+		response.PlanValue = fromPort
+		return
+	}
+
+	if ports.IsUnknown() {
+		response.PlanValue = types.Int32Unknown()
+		return
+	}
+
+	response.PlanValue = types.Int32Null()
+	if first, last, ok := splitPorts(ports.ValueString()); ok && !ports.IsNull() {
+		response.PlanValue = types.Int32Value(last)
+		if m.first {
+			response.PlanValue = types.Int32Value(first)
+		}
+	}
+}
+
 func computeClusterCreate(
 	ctx context.Context,
 	client *nscale.Client,
@@ -327,17 +742,25 @@ func computeClusterCreate(
 		return nil, diagnostics
 	}
 
-	createResponse, err := client.LegacyCompute.PostApiV1OrganizationsOrganizationIDProjectsProjectIDClusters(
+	body, err := clusterRequestBody(requestData, nil, poolExtraSpecs(plan.WorkloadPools))
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("workload_pools"),
+			"Failed to Create Compute Cluster",
+			fmt.Sprintf("An error occurred while merging the extra specs into the compute cluster: %s", err),
+		)
+		return nil, diagnostics
+	}
+
+	createResponse, err := client.LegacyCompute.PostApiV1OrganizationsOrganizationIDProjectsProjectIDClustersWithBody(
 		ctx,
 		client.OrganizationID,
 		projectID,
-		requestData,
+		nscale.ExtraSpecContentType,
+		body,
 	)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Create Compute Cluster",
-			fmt.Sprintf("An error occurred while creating the compute cluster: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Compute Cluster", "compute cluster", err)
 		return nil, diagnostics
 	}
 	defer createResponse.Body.Close()
@@ -345,20 +768,22 @@ func computeClusterCreate(
 	computeCluster, err := nscale.ReadJSONResponsePointer[computeapi.ComputeClusterRead](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Create Compute Cluster",
-			fmt.Sprintf("An error occurred while creating the compute cluster: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Compute Cluster", "compute cluster", err)
 		return nil, diagnostics
 	}
 
 	return computeCluster, nil
 }
 
+// computeClusterUpdate replaces the whole cluster, as the API has no partial
+// update. To avoid discarding changes made since the plan was computed, it
+// reads the cluster first and refuses to write if the cluster no longer
+// matches the prior state.
 func computeClusterUpdate(
 	ctx context.Context,
 	client *nscale.Client,
 	id string,
+	prior *ComputeClusterResourceModel,
 	plan ComputeClusterResourceModel,
 ) (string, diag.Diagnostics) {
 	projectID, diagnostics := client.ResolveProjectID("")
@@ -372,37 +797,123 @@ func computeClusterUpdate(
 		return "", diagnostics
 	}
 
+	current, currentPools, err := getComputeClusterForWrite(ctx, client, id)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddError(
+			"Failed to Update Compute Cluster",
+			fmt.Sprintf("An error occurred while retrieving the compute cluster: %s", err),
+		)
+		return "", diagnostics
+	}
+
+	if prior != nil {
+		currentModel := NewComputeClusterModel(withoutDetachedPools(current))
+		if changed := changedAttributes(prior.ComputeClusterModel, currentModel); len(changed) > 0 {
+			diagnostics.AddError(
+				"Compute Cluster Changed Outside Terraform",
+				fmt.Sprintf(
+					"The compute cluster was modified after Terraform last read it (changed: %s). Updates replace "+
+						"the whole cluster, so applying this plan would discard those changes. Run 'terraform apply' "+
+						"again to plan against the current cluster.",
+					strings.Join(changed, ", "),
+				),
+			)
+			return "", diagnostics
+		}
+	}
+
+	// Pools owned by nscale_compute_cluster_workload_pool resources are not in
+	// the plan; carry them over rather than deleting them.
+	if err = preserveDetachedPools(&requestData, current); err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("workload_pools"),
+			"Failed to Update Compute Cluster",
+			fmt.Sprintf("An error occurred while updating the compute cluster: %s", err),
+		)
+		return "", diagnostics
+	}
+
 	// Tag the update so the watcher can confirm the PUT has propagated through
 	// the cache-backed API before reading back a terminal status. The legacy
 	// metadata shape requires the compat shim rather than nscale.WriteOperationTag.
 	operationTagKey := writeOperationTagLegacy(&requestData.Metadata)
 
-	updateResponse, err := client.LegacyCompute.PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(
+	body, err := clusterRequestBody(requestData, currentPools, poolExtraSpecs(plan.WorkloadPools))
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("workload_pools"),
+			"Failed to Update Compute Cluster",
+			fmt.Sprintf("An error occurred while merging the extra specs into the compute cluster: %s", err),
+		)
+		return "", diagnostics
+	}
+
+	updateResponse, err := client.LegacyCompute.PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDWithBody(
 		ctx,
 		client.OrganizationID,
 		projectID,
 		id,
-		requestData,
+		nscale.ExtraSpecContentType,
+		body,
 	)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Update Compute Cluster",
-			fmt.Sprintf("An error occurred while updating the compute cluster: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Compute Cluster", "compute cluster", err)
 		return "", diagnostics
 	}
 	defer updateResponse.Body.Close()
 
 	if err = nscale.ReadEmptyResponse(updateResponse); err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Update Compute Cluster",
-			fmt.Sprintf("An error occurred while updating the compute cluster: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Compute Cluster", "compute cluster", err)
 		return "", diagnostics
 	}
 
-	return operationTagKey, nil
+	if prior == nil {
+		return operationTagKey, nil
+	}
+
+	updates := imageUpdates(prior.WorkloadPools, plan.WorkloadPools)
+	if len(updates) == 0 {
+		return operationTagKey, nil
+	}
+
+	// Machines can only be replaced once the new image is in the pool's spec,
+	// so wait for the update before evicting them.
+	timeout, timeoutDiagnostics := plan.Timeouts.Update(ctx, defaultWorkloadPoolTimeout)
+	diagnostics.Append(timeoutDiagnostics...)
+	if diagnostics.HasError() {
+		return "", diagnostics
+	}
+	deadline := time.Now().Add(timeout)
+
+	stateWatcher := nscale.UpdateStateWatcher[computeapi.ComputeClusterRead]{
+		ResourceTitle: "Compute Cluster",
+		ResourceName:  "compute cluster",
+		GetFunc: func(ctx context.Context) (*computeapi.ComputeClusterRead, nscale.ResourceStatus, error) {
+			return nscale.AdaptProjectScoped(getComputeCluster(ctx, client.OrganizationID, client.ProjectID, id, client))
+		},
+	}
+	if _, ok := stateWatcher.WaitFor(ctx, operationTagKey, time.Until(deadline), &diagnostics); !ok {
+		return "", diagnostics
+	}
+
+	// Each replacement rewrites the cluster without the earlier operation tags,
+	// so the caller waits for the last one written.
+	for _, update := range updates {
+		_, replacementTagKey, ok := replaceOutdatedMachines(
+			ctx, client, id, update, poolExtraSpecs(plan.WorkloadPools), time.Until(deadline), &diagnostics,
+		)
+		if !ok {
+			return "", diagnostics
+		}
+
+		if replacementTagKey != "" {
+			operationTagKey = replacementTagKey
+		}
+	}
+
+	return operationTagKey, diagnostics
 }
 
 func computeClusterDelete(ctx context.Context, client *nscale.Client, id string) error {
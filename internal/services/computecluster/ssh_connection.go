@@ -0,0 +1,249 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+//nolint:gochecknoglobals // constant attribute type.
+var machineSSHConnectionAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"host": types.StringType,
+		"user": types.StringType,
+	},
+}
+
+// machineSSHConnectionDescription describes the ssh_connection of a machine,
+// which has no private key: it is the cluster's, so it is stored once in the
+// cluster's ssh_private_key rather than in every machine.
+const machineSSHConnectionDescription = "The details to connect to the machine over SSH with, for provisioners " +
+	"and for tools such as Ansible reading them with `terraform output`. `host` is the public IP address, or the " +
+	"private one without it. `user` is the default user of the image's distribution, and null when the " +
+	"distribution is not known. Authenticate with the compute cluster's `ssh_private_key`."
+
+// newMachineSSHConnection returns the ssh_connection of a machine with the
+// given addresses and login user.
+func newMachineSSHConnection(publicIP, privateIP, user types.String) types.Object {
+	return types.ObjectValueMust(machineSSHConnectionAttributeType.AttrTypes, map[string]attr.Value{
+		"host": nscale.SSHHost(publicIP, privateIP),
+		"user": user,
+	})
+}
+
+// machineSSHUser returns the user of the ssh_connection of a machine, or null
+// when it is not known.
+func machineSSHUser(machine types.Object) types.String {
+	connection, _ := machine.Attributes()["ssh_connection"].(types.Object)
+	if connection.IsNull() || connection.IsUnknown() {
+		return types.StringNull()
+	}
+
+	user, ok := connection.Attributes()["user"].(types.String)
+	if !ok || user.IsUnknown() {
+		return types.StringNull()
+	}
+
+	return user
+}
+
+// withMachineSSHConnections returns the machines with the user of their SSH
+// connections set. The user is that of imageID in users, or the machine's own
+// when users does not list it, and users is nil when the images could not be
+// listed.
+func withMachineSSHConnections(machines types.List, users map[string]string, imageID string) types.List {
+	if machines.IsNull() || machines.IsUnknown() {
+		return machines
+	}
+
+	elements := make([]attr.Value, 0, len(machines.Elements()))
+	for _, element := range machines.Elements() {
+		machine, ok := element.(types.Object)
+		if !ok || machine.IsNull() || machine.IsUnknown() {
+			elements = append(elements, element)
+			continue
+		}
+
+		attributes := machine.Attributes()
+		publicIP, _ := attributes["public_ip"].(types.String)
+		privateIP, _ := attributes["private_ip"].(types.String)
+
+		user := nscale.LoginUser(users, imageID, machineSSHUser(machine))
+
+		connection := newMachineSSHConnection(publicIP, privateIP, user)
+		elements = append(elements, withSSHConnection(machine, connection))
+	}
+
+	return types.ListValueMust(MachineModelAttributeType, elements)
+}
+
+// withSSHConnection returns the machine with its SSH connection replaced.
+func withSSHConnection(machine, connection types.Object) types.Object {
+	attributes := maps.Clone(machine.Attributes())
+	attributes["ssh_connection"] = connection
+	return types.ObjectValueMust(MachineModelAttributeType.AttrTypes, attributes)
+}
+
+// withPriorMachineSSHConnections returns the machines with the user of their
+// SSH connections copied from the machine of the same hostname in prior, so it
+// is kept when it cannot be read again.
+func withPriorMachineSSHConnections(machines, prior types.List) types.List {
+	if machines.IsNull() || machines.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return machines
+	}
+
+	priorUsers := map[string]types.String{}
+	for _, element := range prior.Elements() {
+		if machine, ok := element.(types.Object); ok && !machine.IsNull() && !machine.IsUnknown() {
+			hostname, _ := machine.Attributes()["hostname"].(types.String)
+			priorUsers[hostname.ValueString()] = machineSSHUser(machine)
+		}
+	}
+
+	elements := make([]attr.Value, 0, len(machines.Elements()))
+	for _, element := range machines.Elements() {
+		machine, ok := element.(types.Object)
+		if !ok || machine.IsNull() || machine.IsUnknown() {
+			elements = append(elements, element)
+			continue
+		}
+
+		attributes := machine.Attributes()
+		hostname, _ := attributes["hostname"].(types.String)
+		publicIP, _ := attributes["public_ip"].(types.String)
+		privateIP, _ := attributes["private_ip"].(types.String)
+
+		connection := newMachineSSHConnection(publicIP, privateIP, priorUsers[hostname.ValueString()])
+		elements = append(elements, withSSHConnection(machine, connection))
+	}
+
+	return types.ListValueMust(MachineModelAttributeType, elements)
+}
+
+// withPriorSSHConnections applies withPriorMachineSSHConnections to the
+// machines of each pool, from the pool of the same name in prior.
+func withPriorSSHConnections(pools, prior types.List) types.List {
+	if pools.IsNull() || pools.IsUnknown() {
+		return pools
+	}
+
+	priorPools := poolsByName(prior)
+
+	elements := make([]attr.Value, 0, len(pools.Elements()))
+	for _, element := range pools.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok || pool.IsNull() || pool.IsUnknown() {
+			elements = append(elements, element)
+			continue
+		}
+
+		name, _ := pool.Attributes()["name"].(types.String)
+		priorPool, found := priorPools[name.ValueString()]
+		if !found {
+			elements = append(elements, element)
+			continue
+		}
+
+		machines, _ := pool.Attributes()["machines"].(types.List)
+		priorMachines, _ := priorPool.Attributes()["machines"].(types.List)
+
+		elements = append(elements, withMachines(pool, withPriorMachineSSHConnections(machines, priorMachines)))
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, elements)
+}
+
+// withMachines returns the pool with its machines, and their index by
+// hostname, replaced.
+func withMachines(pool types.Object, machines types.List) types.Object {
+	return withAttributes(pool, map[string]attr.Value{
+		"machines":             machines,
+		"machines_by_hostname": machinesByHostname(machines),
+	})
+}
+
+// hasMachines reports whether any of the pools lists its machines.
+func hasMachines(pools types.List) bool {
+	for _, pool := range poolsByName(pools) {
+		if machines, ok := pool.Attributes()["machines"].(types.List); ok && len(machines.Elements()) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// machineLoginUsers returns the login users of the images of regionID for the
+// SSH connections of a cluster's machines, or nil, with a warning, when the
+// images cannot be listed.
+func machineLoginUsers(
+	ctx context.Context,
+	client *nscale.Client,
+	regionID string,
+	diagnostics *diag.Diagnostics,
+) map[string]string {
+	users, err := client.ImageLoginUsers(ctx, regionID)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		nscale.AddSSHConnectionWarning(diagnostics, "machines", err)
+		return nil
+	}
+
+	return users
+}
+
+// withSSHConnections sets the SSH connections of the machines of each pool,
+// which use the login user of the pool's image. The images are only listed
+// when a pool lists its machines.
+func withSSHConnections(
+	ctx context.Context,
+	client *nscale.Client,
+	regionID string,
+	pools types.List,
+) (types.List, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	if pools.IsNull() || pools.IsUnknown() || !hasMachines(pools) {
+		return pools, diagnostics
+	}
+
+	users := machineLoginUsers(ctx, client, regionID, &diagnostics)
+
+	elements := make([]attr.Value, 0, len(pools.Elements()))
+	for _, element := range pools.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok || pool.IsNull() || pool.IsUnknown() {
+			elements = append(elements, element)
+			continue
+		}
+
+		machines, _ := pool.Attributes()["machines"].(types.List)
+		imageID, _ := pool.Attributes()["image_id"].(types.String)
+
+		machines = withMachineSSHConnections(machines, users, imageID.ValueString())
+		elements = append(elements, withMachines(pool, machines))
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, elements), diagnostics
+}
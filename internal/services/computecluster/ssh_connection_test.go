@@ -0,0 +1,83 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/pointer"
+)
+
+func testMachines(hostnames ...string) types.List {
+	machines := make([]attr.Value, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		machines = append(machines, NewMachineModel(computeapi.ComputeClusterMachineStatus{
+			Hostname:  hostname,
+			PrivateIP: pointer.Reference("10.0.0.1"),
+		}))
+	}
+
+	return types.ListValueMust(MachineModelAttributeType, machines)
+}
+
+func testMachineUser(t *testing.T, machines types.List, index int) types.String {
+	t.Helper()
+
+	machine, _ := machines.Elements()[index].(types.Object)
+
+	return machineSSHUser(machine)
+}
+
+func TestWithMachineSSHConnections(t *testing.T) {
+	machines := withMachineSSHConnections(testMachines("a"), map[string]string{"image": "ubuntu"}, "image")
+
+	if user := testMachineUser(t, machines, 0); user.ValueString() != "ubuntu" {
+		t.Fatalf("user = %s, want \"ubuntu\"", user)
+	}
+
+	// Without the images the user is kept as it was.
+	machines = withMachineSSHConnections(machines, nil, "image")
+
+	if user := testMachineUser(t, machines, 0); user.ValueString() != "ubuntu" {
+		t.Fatalf("user = %s, want \"ubuntu\"", user)
+	}
+
+	// The private key is the cluster's, and is not copied into the machines.
+	machine, _ := machines.Elements()[0].(types.Object)
+	connection, _ := machine.Attributes()["ssh_connection"].(types.Object)
+	if _, found := connection.Attributes()["private_key"]; found {
+		t.Fatalf("ssh_connection = %s, want no private_key", connection)
+	}
+}
+
+func TestWithPriorMachineSSHConnections(t *testing.T) {
+	prior := withMachineSSHConnections(testMachines("a", "b"), map[string]string{"image": "ubuntu"}, "image")
+
+	machines := withPriorMachineSSHConnections(testMachines("b", "c"), prior)
+
+	if user := testMachineUser(t, machines, 0); user.ValueString() != "ubuntu" {
+		t.Fatalf("user of b = %s, want \"ubuntu\"", user)
+	}
+
+	if user := testMachineUser(t, machines, 1); !user.IsNull() {
+		t.Fatalf("user of c = %s, want null", user)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+var _ datasource.DataSourceWithConfigure = &ComputeClusterSSHKeyDataSource{}
+
+type ComputeClusterSSHKeyModel struct {
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	ProjectID  types.String `tfsdk:"project_id"`
+	PrivateKey types.String `tfsdk:"private_key"`
+}
+
+func NewComputeClusterSSHKeyModel(source *computeapi.ComputeClusterRead) ComputeClusterSSHKeyModel {
+	var privateKey types.String
+	if source.Status != nil {
+		privateKey = types.StringPointerValue(source.Status.SshPrivateKey)
+	}
+
+	return ComputeClusterSSHKeyModel{
+		ClusterID:  types.StringValue(source.Metadata.Id),
+		ProjectID:  types.StringValue(source.Metadata.ProjectId),
+		PrivateKey: privateKey,
+	}
+}
+
+// ComputeClusterSSHKeyDataSource reads only the SSH key of a compute cluster,
+// so that configurations needing just the credentials do not store the whole
+// cluster in their state.
+type ComputeClusterSSHKeyDataSource struct {
+	*nscale.GenericDataSource[ComputeClusterSSHKeyModel, computeapi.ComputeClusterRead]
+}
+
+func NewComputeClusterSSHKeyDataSource() datasource.DataSource {
+	return &ComputeClusterSSHKeyDataSource{
+		GenericDataSource: nscale.NewGenericDataSource(computeClusterSSHKeyAdapter()),
+	}
+}
+
+// computeClusterSSHKeyAdapter reads the SSH key for both the data source and
+// the ephemeral resource.
+func computeClusterSSHKeyAdapter() nscale.DataSourceAdapter[ComputeClusterSSHKeyModel, computeapi.ComputeClusterRead] {
+	return nscale.DataSourceAdapter[ComputeClusterSSHKeyModel, computeapi.ComputeClusterRead]{
+		TypeNameSuffix:  "_compute_cluster_ssh_key",
+		Title:           "Compute Cluster SSH Key",
+		Name:            "compute cluster SSH key",
+		RequiredFeature: nscale.ComputeClusterAPIV1,
+		Get: func(ctx context.Context, client *nscale.Client, id string) (*computeapi.ComputeClusterRead, error) {
+			cluster, _, err := getComputeCluster(ctx, client.OrganizationID, nscale.ProjectIDFromContext(ctx), id, client)
+			return cluster, err
+		},
+		ToModel:              NewComputeClusterSSHKeyModel,
+		IDFromModel:          func(m ComputeClusterSSHKeyModel) string { return m.ClusterID.ValueString() },
+		EphemeralAlternative: "nscale_compute_cluster_ssh_key",
+	}
+}
+
+func (s *ComputeClusterSSHKeyDataSource) Schema(
+	ctx context.Context,
+	request datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Compute Cluster SSH Key",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the compute cluster associated with the SSH key.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project the compute cluster belongs to. Defaults to the provider's `project_id`. When neither is set, the cluster is looked up across all projects of the organization.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The private SSH key for accessing the VMs of the compute cluster. Null until the cluster has provisioned its key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &ComputeClusterSSHKeyEphemeralResource{}
+
+// ComputeClusterSSHKeyEphemeralResource reads the SSH key of a compute cluster
+// without storing it in state, unlike the data source of the same name.
+type ComputeClusterSSHKeyEphemeralResource struct {
+	*nscale.GenericEphemeralResource[ComputeClusterSSHKeyModel, computeapi.ComputeClusterRead]
+}
+
+func NewComputeClusterSSHKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ComputeClusterSSHKeyEphemeralResource{
+		GenericEphemeralResource: nscale.NewGenericEphemeralResource(computeClusterSSHKeyAdapter()),
+	}
+}
+
+func (e *ComputeClusterSSHKeyEphemeralResource) Schema(
+	ctx context.Context,
+	request ephemeral.SchemaRequest,
+	response *ephemeral.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Compute Cluster SSH Key, read without storing it in Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the compute cluster associated with the SSH key.",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project the compute cluster belongs to. Defaults to the provider's `project_id`. When neither is set, the cluster is looked up across all projects of the organization.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The private SSH key for accessing the VMs of the compute cluster. Null until the cluster has provisioned its key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
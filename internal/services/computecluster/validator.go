@@ -19,15 +19,42 @@ package computecluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // portRangeParts is the number of components a "N-M" port range splits into.
 const portRangeParts = 2
 
+// maxPortNumber is the highest TCP or UDP port number.
+const maxPortNumber = 65535
+
+// splitPorts returns the first and last port of a ports value, which are the
+// same for a single port.
+func splitPorts(ports string) (int32, int32, bool) {
+	first, last, isRange := strings.Cut(ports, "-")
+	if !isRange {
+		last = first
+	}
+
+	firstPort, err := strconv.ParseInt(first, 10, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	lastPort, err := strconv.ParseInt(last, 10, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return int32(firstPort), int32(lastPort), true
+}
+
 type PortsValidator struct{}
 
 func (v PortsValidator) Description(ctx context.Context) string {
@@ -61,7 +88,7 @@ func (v PortsValidator) ValidateString(
 
 	for _, port := range ports {
 		portNumber, err := strconv.Atoi(port)
-		if err != nil || portNumber < 0 || portNumber > 65535 {
+		if err != nil || portNumber < 0 || portNumber > maxPortNumber {
 			response.Diagnostics.AddAttributeError(
 				request.Path,
 				"Invalid Port Number",
@@ -71,3 +98,153 @@ func (v PortsValidator) ValidateString(
 		}
 	}
 }
+
+// UniquePoolNamesValidator rejects workload pools with the same name. Pools are
+// joined with their status by name, so the machines of one would be reported
+// for all of them.
+type UniquePoolNamesValidator struct{}
+
+func (v UniquePoolNamesValidator) Description(ctx context.Context) string {
+	return "Workload pool names must be unique within the compute cluster"
+}
+
+func (v UniquePoolNamesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v UniquePoolNamesValidator) ValidateList(
+	ctx context.Context,
+	request validator.ListRequest,
+	response *validator.ListResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]struct{}{}
+	for _, element := range request.ConfigValue.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok || pool.IsNull() || pool.IsUnknown() {
+			continue
+		}
+
+		name, ok := pool.Attributes()["name"].(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		if _, duplicate := seen[name.ValueString()]; duplicate {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Duplicate Workload Pool Name",
+				fmt.Sprintf(
+					"Attribute %s contains more than one pool named %q. %s.",
+					request.Path,
+					name.ValueString(),
+					v.Description(ctx),
+				),
+			)
+			return
+		}
+		seen[name.ValueString()] = struct{}{}
+	}
+}
+
+// UniqueFirewallRulesValidator rejects firewall rules that apply to the same
+// traffic as an earlier rule of the list, whether their ports are given as
+// ports or as from_port and to_port.
+type UniqueFirewallRulesValidator struct{}
+
+func (v UniqueFirewallRulesValidator) Description(ctx context.Context) string {
+	return "Firewall rules must not repeat the direction, protocol, ports and prefixes of another rule"
+}
+
+func (v UniqueFirewallRulesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v UniqueFirewallRulesValidator) ValidateList(
+	ctx context.Context,
+	request validator.ListRequest,
+	response *validator.ListResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]int{}
+	for i, element := range request.ConfigValue.Elements() {
+		rule, ok := element.(types.Object)
+		if !ok || rule.IsNull() || rule.IsUnknown() {
+			continue
+		}
+
+		key, ok := firewallRuleKey(rule)
+		if !ok {
+			continue
+		}
+
+		if first, duplicate := seen[key]; duplicate {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtListIndex(i),
+				"Duplicate Firewall Rule",
+				fmt.Sprintf("Firewall rule %d repeats firewall rule %d. %s.", i, first, v.Description(ctx)),
+			)
+			continue
+		}
+		seen[key] = i
+	}
+}
+
+// firewallRuleKey returns a key identifying the traffic a configured firewall
+// rule applies to, or false when any part of it is not yet known. A rule
+// without a direction is an ingress rule, as the attribute's default.
+func firewallRuleKey(rule types.Object) (string, bool) {
+	attributes := rule.Attributes()
+
+	direction, _ := attributes["direction"].(types.String)
+	protocol, _ := attributes["protocol"].(types.String)
+	ports, _ := attributes["ports"].(types.String)
+	fromPort, _ := attributes["from_port"].(types.Int32)
+	toPort, _ := attributes["to_port"].(types.Int32)
+	prefixes, _ := attributes["prefixes"].(types.Set)
+
+	for _, value := range []attr.Value{direction, protocol, ports, fromPort, toPort, prefixes} {
+		if value.IsUnknown() {
+			return "", false
+		}
+	}
+
+	directionValue := "ingress"
+	if !direction.IsNull() {
+		directionValue = direction.ValueString()
+	}
+
+	var first, last int32
+	switch {
+	case !ports.IsNull():
+		var ok bool
+		if first, last, ok = splitPorts(ports.ValueString()); !ok {
+			return "", false
+		}
+	case !fromPort.IsNull():
+		first, last = fromPort.ValueInt32(), fromPort.ValueInt32()
+		if !toPort.IsNull() {
+			last = toPort.ValueInt32()
+		}
+	}
+
+	prefixValues := make([]string, 0, len(prefixes.Elements()))
+	for _, element := range prefixes.Elements() {
+		prefix, ok := element.(types.String)
+		if !ok || prefix.IsUnknown() {
+			return "", false
+		}
+		prefixValues = append(prefixValues, prefix.ValueString())
+	}
+	slices.Sort(prefixValues)
+
+	return fmt.Sprintf(
+		"%s/%s/%d-%d/%s", directionValue, protocol.ValueString(), first, last, strings.Join(prefixValues, ","),
+	), true
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testFirewallRuleConfig returns the configuration of a workload pool with a
+// single firewall rule.
+func testFirewallRuleConfig(
+	t *testing.T,
+	protocol string,
+	ports types.String,
+	fromPort, toPort types.Int32,
+) tfsdk.Config {
+	t.Helper()
+
+	poolSchema, model := testWorkloadPoolResource(t)
+	model.FirewallRules = types.ListValueMust(FirewallRuleModelAttributeType, []attr.Value{
+		types.ObjectValueMust(FirewallRuleModelAttributeType.AttrTypes, map[string]attr.Value{
+			"direction": types.StringValue("ingress"),
+			"protocol":  types.StringValue(protocol),
+			"ports":     ports,
+			"from_port": fromPort,
+			"to_port":   toPort,
+			"prefixes":  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/8")}),
+		}),
+	})
+
+	state := tfsdk.State{
+		Schema: poolSchema,
+		Raw:    tftypes.NewValue(poolSchema.Type().TerraformType(context.Background()), nil),
+	}
+	if diagnostics := state.Set(context.Background(), model); diagnostics.HasError() {
+		t.Fatalf("failed to set the configuration: %v", diagnostics)
+	}
+
+	return tfsdk.Config{Schema: poolSchema, Raw: state.Raw}
+}
+
+func TestFirewallRulePortPlanModifiers(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ports     types.String
+		fromPort  types.Int32
+		toPort    types.Int32
+		wantPorts string
+		wantFrom  int32
+		wantTo    int32
+	}{
+		{
+			name:      "port range from ports",
+			ports:     types.StringValue("80-443"),
+			fromPort:  types.Int32Null(),
+			toPort:    types.Int32Null(),
+			wantPorts: "80-443",
+			wantFrom:  80,
+			wantTo:    443,
+		},
+		{
+			name:      "port range from from_port and to_port",
+			ports:     types.StringNull(),
+			fromPort:  types.Int32Value(80),
+			toPort:    types.Int32Value(443),
+			wantPorts: "80-443",
+			wantFrom:  80,
+			wantTo:    443,
+		},
+		{
+			name:      "single port from from_port",
+			ports:     types.StringNull(),
+			fromPort:  types.Int32Value(22),
+			toPort:    types.Int32Null(),
+			wantPorts: "22",
+			wantFrom:  22,
+			wantTo:    22,
+		},
+	}
+
+	ctx := context.Background()
+	rulePath := path.Root("firewall_rules").AtListIndex(0)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := testFirewallRuleConfig(t, "tcp", testCase.ports, testCase.fromPort, testCase.toPort)
+
+			portsResponse := planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+			firewallRulePortsPlanModifier{}.PlanModifyString(ctx, planmodifier.StringRequest{
+				Path:        rulePath.AtName("ports"),
+				Config:      config,
+				ConfigValue: testCase.ports,
+			}, &portsResponse)
+
+			fromResponse := planmodifier.Int32Response{PlanValue: types.Int32Unknown()}
+			firewallRulePortRangePlanModifier{first: true}.PlanModifyInt32(ctx, planmodifier.Int32Request{
+				Path:        rulePath.AtName("from_port"),
+				Config:      config,
+				ConfigValue: testCase.fromPort,
+			}, &fromResponse)
+
+			toResponse := planmodifier.Int32Response{PlanValue: types.Int32Unknown()}
+			firewallRulePortRangePlanModifier{first: false}.PlanModifyInt32(ctx, planmodifier.Int32Request{
+				Path:        rulePath.AtName("to_port"),
+				Config:      config,
+				ConfigValue: testCase.toPort,
+			}, &toResponse)
+
+			// Configured values are left for the framework to plan as configured.
+			ports, fromPort, toPort := portsResponse.PlanValue, fromResponse.PlanValue, toResponse.PlanValue
+			if !testCase.ports.IsNull() {
+				ports = testCase.ports
+			}
+			if !testCase.fromPort.IsNull() {
+				fromPort = testCase.fromPort
+			}
+			if !testCase.toPort.IsNull() {
+				toPort = testCase.toPort
+			}
+
+			if ports.ValueString() != testCase.wantPorts ||
+				fromPort.ValueInt32() != testCase.wantFrom || toPort.ValueInt32() != testCase.wantTo {
+				t.Fatalf("planned ports %v, from_port %v, to_port %v, want %s, %d, %d",
+					ports, fromPort, toPort, testCase.wantPorts, testCase.wantFrom, testCase.wantTo)
+			}
+		})
+	}
+}
+
+func TestUniquePoolNamesValidator(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pools   types.List
+		wantErr bool
+	}{
+		{
+			name: "distinct names",
+			pools: testPools(t,
+				map[string]attr.Value{"name": types.StringValue("a")},
+				map[string]attr.Value{"name": types.StringValue("b")},
+			),
+		},
+		{
+			name: "duplicate names",
+			pools: testPools(t,
+				map[string]attr.Value{"name": types.StringValue("a")},
+				map[string]attr.Value{"name": types.StringValue("a")},
+			),
+			wantErr: true,
+		},
+		{
+			name: "unknown names are skipped",
+			pools: testPools(t,
+				map[string]attr.Value{"name": types.StringUnknown()},
+				map[string]attr.Value{"name": types.StringUnknown()},
+			),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := validator.ListRequest{Path: path.Root("workload_pools"), ConfigValue: testCase.pools}
+			response := validator.ListResponse{}
+			UniquePoolNamesValidator{}.ValidateList(context.Background(), request, &response)
+
+			if got := response.Diagnostics.HasError(); got != testCase.wantErr {
+				t.Fatalf("HasError() = %v, want %v (diags: %v)", got, testCase.wantErr, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func testFirewallRule(
+	direction, protocol, ports types.String,
+	fromPort, toPort types.Int32,
+	prefixes ...string,
+) attr.Value {
+	prefixValues := make([]attr.Value, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		prefixValues = append(prefixValues, types.StringValue(prefix))
+	}
+
+	return types.ObjectValueMust(FirewallRuleModelAttributeType.AttrTypes, map[string]attr.Value{
+		"direction": direction,
+		"protocol":  protocol,
+		"ports":     ports,
+		"from_port": fromPort,
+		"to_port":   toPort,
+		"prefixes":  types.SetValueMust(types.StringType, prefixValues),
+	})
+}
+
+func TestUniqueFirewallRulesValidator(t *testing.T) {
+	tcp := types.StringValue("tcp")
+	ingress := types.StringValue("ingress")
+	noPorts, noPort := types.StringNull(), types.Int32Null()
+
+	testCases := []struct {
+		name    string
+		rules   []attr.Value
+		wantErr bool
+	}{
+		{
+			name: "distinct ports",
+			rules: []attr.Value{
+				testFirewallRule(ingress, tcp, types.StringValue("22"), noPort, noPort, "10.0.0.0/8"),
+				testFirewallRule(ingress, tcp, types.StringValue("80"), noPort, noPort, "10.0.0.0/8"),
+			},
+		},
+		{
+			name: "distinct directions",
+			rules: []attr.Value{
+				testFirewallRule(ingress, tcp, types.StringValue("22"), noPort, noPort, "10.0.0.0/8"),
+				testFirewallRule(types.StringValue("egress"), tcp, types.StringValue("22"), noPort, noPort, "10.0.0.0/8"),
+			},
+		},
+		{
+			name: "same rule",
+			rules: []attr.Value{
+				testFirewallRule(ingress, tcp, types.StringValue("22"), noPort, noPort, "10.0.0.0/8"),
+				testFirewallRule(ingress, tcp, types.StringValue("22"), noPort, noPort, "10.0.0.0/8"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "same rule with ports given differently",
+			rules: []attr.Value{
+				testFirewallRule(ingress, tcp, types.StringValue("80-443"), noPort, noPort, "a", "b"),
+				testFirewallRule(types.StringNull(), tcp, noPorts, types.Int32Value(80), types.Int32Value(443), "b", "a"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown ports are skipped",
+			rules: []attr.Value{
+				testFirewallRule(ingress, tcp, types.StringUnknown(), noPort, noPort, "10.0.0.0/8"),
+				testFirewallRule(ingress, tcp, types.StringUnknown(), noPort, noPort, "10.0.0.0/8"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := validator.ListRequest{
+				Path:        path.Root("firewall_rules"),
+				ConfigValue: types.ListValueMust(FirewallRuleModelAttributeType, testCase.rules),
+			}
+			response := validator.ListResponse{}
+			UniqueFirewallRulesValidator{}.ValidateList(context.Background(), request, &response)
+
+			if got := response.Diagnostics.HasError(); got != testCase.wantErr {
+				t.Fatalf("HasError() = %v, want %v (diags: %v)", got, testCase.wantErr, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+// maxSuggestedImages is how many matching images a failed version pin lists.
+const maxSuggestedImages = 3
+
+// pinnedVersion is a version a workload pool requires of its image, set by
+// one of the pool's attributes.
+type pinnedVersion struct {
+	attribute string
+	// label names the versioned software in diagnostics.
+	label string
+	// version returns the image's version of the software, if it has it.
+	version func(image *regionapi.Image) (string, bool)
+}
+
+//nolint:gochecknoglobals // the pinnable versions, in the order they are checked.
+var pinnedVersions = []pinnedVersion{
+	{
+		attribute: "gpu_driver_version",
+		label:     "GPU driver",
+		version: func(image *regionapi.Image) (string, bool) {
+			if image.Spec.Gpu == nil {
+				return "", false
+			}
+			return image.Spec.Gpu.Driver, true
+		},
+	},
+	{
+		attribute: "cuda_version",
+		label:     "CUDA",
+		version: func(image *regionapi.Image) (string, bool) {
+			if image.Spec.SoftwareVersions == nil {
+				return "", false
+			}
+			version, ok := (*image.Spec.SoftwareVersions)["cuda"]
+			return version, ok
+		},
+	},
+}
+
+// versionPin is a workload pool whose image must provide the versions the
+// pool pins, by attribute.
+type versionPin struct {
+	path     path.Path
+	pool     string
+	imageID  string
+	versions map[string]string
+}
+
+// poolVersionPin returns the versions pinned by pool, found at poolPath, when
+// its image is known and it or the pins have changed since prior, the pool's
+// state. Unchanged pools were checked when they were planned.
+func poolVersionPin(pool, prior types.Object, poolPath path.Path) (versionPin, bool) {
+	if pool.IsNull() || pool.IsUnknown() {
+		return versionPin{}, false
+	}
+
+	attributes := pool.Attributes()
+	imageID, _ := attributes["image_id"].(types.String)
+	if imageID.IsNull() || imageID.IsUnknown() {
+		return versionPin{}, false
+	}
+
+	unchanged := !prior.IsNull() && !prior.IsUnknown() && imageID.Equal(prior.Attributes()["image_id"])
+
+	versions := map[string]string{}
+	for _, pinned := range pinnedVersions {
+		version, _ := attributes[pinned.attribute].(types.String)
+		if version.IsNull() || version.IsUnknown() {
+			continue
+		}
+		versions[pinned.attribute] = version.ValueString()
+		unchanged = unchanged && version.Equal(prior.Attributes()[pinned.attribute])
+	}
+
+	if len(versions) == 0 || unchanged {
+		return versionPin{}, false
+	}
+
+	name, _ := attributes["name"].(types.String)
+
+	return versionPin{path: poolPath, pool: name.ValueString(), imageID: imageID.ValueString(), versions: versions}, true
+}
+
+// poolVersionPins returns the version pins of the pools of plan, comparing
+// each pool with the pool of the same name in prior.
+func poolVersionPins(plan, prior types.List) []versionPin {
+	if plan.IsNull() || plan.IsUnknown() {
+		return nil
+	}
+
+	priorPools := poolsByName(prior)
+
+	var pins []versionPin
+	for i, element := range plan.Elements() {
+		pool, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+
+		name, _ := pool.Attributes()["name"].(types.String)
+		priorPool, found := priorPools[name.ValueString()]
+		if !found {
+			priorPool = types.ObjectNull(WorkloadPoolModelAttributeType.AttrTypes)
+		}
+
+		if pin, ok := poolVersionPin(pool, priorPool, path.Root("workload_pools").AtListIndex(i)); ok {
+			pins = append(pins, pin)
+		}
+	}
+
+	return pins
+}
+
+// unmetBy returns the attributes of the versions image does not provide,
+// with a description of what it has instead.
+func (p versionPin) unmetBy(image *regionapi.Image) map[string]string {
+	unmet := map[string]string{}
+	for _, pinned := range pinnedVersions {
+		want, ok := p.versions[pinned.attribute]
+		if !ok {
+			continue
+		}
+
+		version, ok := pinned.version(image)
+		switch {
+		case !ok:
+			unmet[pinned.attribute] = "no " + pinned.label
+		case !nscale.VersionHasPrefix(version, want):
+			unmet[pinned.attribute] = fmt.Sprintf("%s %s", pinned.label, version)
+		}
+	}
+
+	return unmet
+}
+
+// suggestedImages describes the newest of images that provide the pinned
+// versions.
+func (p versionPin) suggestedImages(images []regionapi.Image) string {
+	var matched []*regionapi.Image
+	for i := range images {
+		if len(p.unmetBy(&images[i])) == 0 {
+			matched = append(matched, &images[i])
+		}
+	}
+
+	if len(matched) == 0 {
+		return "No image available in the region provides them."
+	}
+
+	slices.SortStableFunc(matched, func(a, b *regionapi.Image) int {
+		return b.Metadata.CreationTime.Compare(a.Metadata.CreationTime)
+	})
+
+	suggestions := make([]string, 0, maxSuggestedImages)
+	for _, image := range matched[:min(len(matched), maxSuggestedImages)] {
+		suggestions = append(suggestions, fmt.Sprintf("'%s' (%s)", image.Metadata.Id, image.Metadata.Name))
+	}
+
+	return fmt.Sprintf("Images that provide them, newest first: %s.", strings.Join(suggestions, ", "))
+}
+
+// checkVersionPins adds an error for each pinned version the image of a pool
+// does not provide, looking the images up in the region. The API only
+// selects the GPU driver and CUDA version through the image, so a pin that
+// its image does not meet could never be honoured.
+func checkVersionPins(
+	ctx context.Context,
+	client *nscale.Client,
+	rawRegionID string,
+	pins []versionPin,
+	diagnostics *diag.Diagnostics,
+) {
+	if len(pins) == 0 {
+		return
+	}
+
+	regionID, ok := nscale.ParseID(rawRegionID, "Region", regionids.ParseRegionID, diagnostics)
+	if !ok {
+		return
+	}
+
+	images, err := client.ListAvailableImages(ctx, regionID)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddError(
+			"Failed to Check Pinned Versions",
+			fmt.Sprintf("An error occurred while listing the images of the region '%s': %s", rawRegionID, err),
+		)
+		return
+	}
+
+	for _, pin := range pins {
+		index := slices.IndexFunc(images, func(image regionapi.Image) bool { return image.Metadata.Id == pin.imageID })
+		if index < 0 {
+			diagnostics.AddAttributeWarning(
+				pin.path.AtName("image_id"),
+				"Unable to Check Pinned Versions",
+				fmt.Sprintf(
+					"The image '%s' of the workload pool '%s' is not among the images available in the region '%s', "+
+						"so the versions it provides could not be checked.",
+					pin.imageID, pin.pool, rawRegionID,
+				),
+			)
+			continue
+		}
+
+		unmet := pin.unmetBy(&images[index])
+		for _, pinned := range pinnedVersions {
+			has, ok := unmet[pinned.attribute]
+			if !ok {
+				continue
+			}
+
+			diagnostics.AddAttributeError(
+				pin.path.AtName(pinned.attribute),
+				"Image Does Not Provide Pinned Version",
+				fmt.Sprintf(
+					"The image '%s' of the workload pool '%s' has %s, but the pool pins %s %s. %s",
+					pin.imageID, pin.pool, has, pinned.label, pin.versions[pinned.attribute],
+					pin.suggestedImages(images),
+				),
+			)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"maps"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+func testGPUImage(id string, day int, driver, cuda string) regionapi.Image {
+	image := regionapi.Image{
+		Metadata: coreapi.StaticResourceMetadata{
+			Id:           id,
+			Name:         id,
+			CreationTime: time.Date(2026, time.January, day, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	if driver != "" {
+		image.Spec.Gpu = &regionapi.ImageGpu{Vendor: regionapi.GpuVendorNVIDIA, Driver: driver}
+	}
+	if cuda != "" {
+		image.Spec.SoftwareVersions = &regionapi.SoftwareVersions{"cuda": cuda}
+	}
+
+	return image
+}
+
+func TestVersionPinUnmetBy(t *testing.T) {
+	pin := versionPin{versions: map[string]string{"gpu_driver_version": "570", "cuda_version": "12.8"}}
+
+	testCases := []struct {
+		name  string
+		image regionapi.Image
+		want  map[string]string
+	}{
+		{
+			name:  "more specific versions",
+			image: testGPUImage("a", 1, "570.124.06", "12.8.1"),
+			want:  map[string]string{},
+		},
+		{
+			name:  "other versions",
+			image: testGPUImage("a", 1, "5700", "12.6"),
+			want:  map[string]string{"gpu_driver_version": "GPU driver 5700", "cuda_version": "CUDA 12.6"},
+		},
+		{
+			name:  "no driver",
+			image: testGPUImage("a", 1, "", ""),
+			want:  map[string]string{"gpu_driver_version": "no GPU driver", "cuda_version": "no CUDA"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := pin.unmetBy(&testCase.image); !maps.Equal(got, testCase.want) {
+				t.Fatalf("unmetBy() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestVersionPinSuggestedImages(t *testing.T) {
+	pin := versionPin{versions: map[string]string{"gpu_driver_version": "570"}}
+	images := []regionapi.Image{
+		testGPUImage("old", 1, "570.86", ""),
+		testGPUImage("other", 5, "550.54", ""),
+		testGPUImage("new", 3, "570.124", ""),
+	}
+
+	if got, want := pin.suggestedImages(images), "'new' (new), 'old' (old)."; !strings.HasSuffix(got, want) {
+		t.Fatalf("suggestedImages() = %q, want suffix %q", got, want)
+	}
+
+	if got := pin.suggestedImages(images[1:2]); !strings.HasPrefix(got, "No image") {
+		t.Fatalf("suggestedImages() = %q, want none", got)
+	}
+}
+
+func TestPoolVersionPins(t *testing.T) {
+	prior := testPools(t,
+		map[string]attr.Value{
+			"name":               types.StringValue("a"),
+			"image_id":           types.StringValue("old"),
+			"gpu_driver_version": types.StringValue("570"),
+		},
+		map[string]attr.Value{"name": types.StringValue("b"), "image_id": types.StringValue("old")},
+	)
+
+	testCases := []struct {
+		name     string
+		plan     types.List
+		wantPins int
+	}{
+		{
+			name: "unchanged pin",
+			plan: testPools(t, map[string]attr.Value{
+				"name":               types.StringValue("a"),
+				"image_id":           types.StringValue("old"),
+				"gpu_driver_version": types.StringValue("570"),
+			}),
+		},
+		{
+			name: "no pin",
+			plan: testPools(t, map[string]attr.Value{"name": types.StringValue("b"), "image_id": types.StringValue("new")}),
+		},
+		{
+			name: "unknown image",
+			plan: testPools(t, map[string]attr.Value{
+				"name":         types.StringValue("b"),
+				"image_id":     types.StringUnknown(),
+				"cuda_version": types.StringValue("12"),
+			}),
+		},
+		{
+			name: "changed image",
+			plan: testPools(t, map[string]attr.Value{
+				"name":               types.StringValue("a"),
+				"image_id":           types.StringValue("new"),
+				"gpu_driver_version": types.StringValue("570"),
+			}),
+			wantPins: 1,
+		},
+		{
+			name: "new pin",
+			plan: testPools(t,
+				map[string]attr.Value{"name": types.StringValue("a"), "image_id": types.StringValue("old")},
+				map[string]attr.Value{
+					"name":         types.StringValue("b"),
+					"image_id":     types.StringValue("old"),
+					"cuda_version": types.StringValue("12"),
+				},
+			),
+			wantPins: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pins := poolVersionPins(testCase.plan, prior)
+			if len(pins) != testCase.wantPins {
+				t.Fatalf("poolVersionPins() = %v, want %d pins", pins, testCase.wantPins)
+			}
+		})
+	}
+
+	pins := poolVersionPins(testPools(t,
+		map[string]attr.Value{"name": types.StringValue("a"), "image_id": types.StringValue("old")},
+		map[string]attr.Value{
+			"name":         types.StringValue("c"),
+			"image_id":     types.StringValue("new"),
+			"cuda_version": types.StringValue("12"),
+		},
+	), prior)
+	if want := path.Root("workload_pools").AtListIndex(1); len(pins) != 1 || !pins[0].path.Equal(want) {
+		t.Fatalf("poolVersionPins() = %v, want a pin at %s", pins, want)
+	}
+}
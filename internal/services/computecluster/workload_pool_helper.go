@@ -0,0 +1,265 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	common "github.com/nscaledev/nscale-sdk-go/common"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	legacycore "github.com/unikorn-cloud/core/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+// Workload pools have no metadata of their own, so a pool managed by a
+// standalone nscale_compute_cluster_workload_pool is recorded as a tag on its
+// cluster. The tag sits under the reserved prefix, so it never surfaces in the
+// cluster's tags attribute and users cannot set it themselves.
+const (
+	detachedPoolTagPrefix = nscale.TerraformOperationTagPrefix + "workload-pool/"
+	detachedPoolTagValue  = "nscale_compute_cluster_workload_pool"
+)
+
+// clusterLocks serializes the read-modify-write of a cluster's workload pools
+// between the pool resources of a single Terraform run.
+//
+//nolint:gochecknoglobals // shared by every resource instance in the provider process.
+var clusterLocks nscale.KeyedLocks
+
+// untaggedPoolPrivateKey flags, in the private state of a pool resource, a
+// pool that its cluster does not mark as managed by a pool resource, as after
+// an import. ModifyPlan then plans an update, which marks it.
+const untaggedPoolPrivateKey = "untagged_pool"
+
+func detachedPoolTagName(poolName string) string {
+	return detachedPoolTagPrefix + poolName
+}
+
+// poolTagged reports whether the cluster marks the named pool as managed by a
+// standalone pool resource.
+func poolTagged(cluster *computeapi.ComputeClusterRead, name string) bool {
+	_, ok := detachedPoolNames(cluster.Metadata.Tags)[name]
+	return ok
+}
+
+// detachedPoolNames returns the names of the pools that standalone pool
+// resources manage on a cluster.
+func detachedPoolNames(tags *legacycore.TagList) map[string]struct{} {
+	names := map[string]struct{}{}
+	if tags == nil {
+		return names
+	}
+
+	for _, tag := range *tags {
+		if name, ok := strings.CutPrefix(tag.Name, detachedPoolTagPrefix); ok {
+			names[name] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+// withoutDetachedPools returns a copy of the cluster whose spec omits the pools
+// managed by standalone pool resources, which the cluster resource must not
+// report as drift.
+func withoutDetachedPools(source *computeapi.ComputeClusterRead) *computeapi.ComputeClusterRead {
+	detached := detachedPoolNames(source.Metadata.Tags)
+	if len(detached) == 0 {
+		return source
+	}
+
+	cluster := *source
+	cluster.Spec.WorkloadPools = slices.DeleteFunc(
+		slices.Clone(source.Spec.WorkloadPools),
+		func(pool computeapi.ComputeClusterWorkloadPool) bool {
+			_, ok := detached[pool.Name]
+			return ok
+		},
+	)
+
+	return &cluster
+}
+
+// preserveDetachedPools carries the pools managed by standalone pool resources,
+// and the tags that mark them, from the current cluster into a cluster write
+// built from the cluster resource's plan, so the full-spec PUT does not delete
+// them. It fails if the plan declares one of those pools itself.
+func preserveDetachedPools(
+	request *computeapi.ComputeClusterWrite,
+	current *computeapi.ComputeClusterRead,
+) error {
+	detached := detachedPoolNames(current.Metadata.Tags)
+	if len(detached) == 0 {
+		return nil
+	}
+
+	for _, pool := range request.Spec.WorkloadPools {
+		if _, ok := detached[pool.Name]; ok {
+			return fmt.Errorf(
+				"workload pool '%s' is managed by an nscale_compute_cluster_workload_pool resource; "+
+					"remove it from workload_pools",
+				pool.Name,
+			)
+		}
+	}
+
+	for _, pool := range current.Spec.WorkloadPools {
+		if _, ok := detached[pool.Name]; ok {
+			request.Spec.WorkloadPools = append(request.Spec.WorkloadPools, pool)
+		}
+	}
+
+	tags := legacycore.TagList{}
+	if request.Metadata.Tags != nil {
+		tags = *request.Metadata.Tags
+	}
+
+	for _, tag := range *current.Metadata.Tags {
+		if strings.HasPrefix(tag.Name, detachedPoolTagPrefix) {
+			tags = append(tags, tag)
+		}
+	}
+
+	request.Metadata.Tags = &tags
+
+	return nil
+}
+
+// clusterWriteFromRead builds a cluster write that leaves the cluster as it is,
+// for the pool resources to modify. Stale operation tags are dropped, but the
+// tags marking detached pools are kept.
+func clusterWriteFromRead(source *computeapi.ComputeClusterRead) computeapi.ComputeClusterWrite {
+	var tags *legacycore.TagList
+	if source.Metadata.Tags != nil {
+		retained := legacycore.TagList{}
+		for _, tag := range *source.Metadata.Tags {
+			if !strings.HasPrefix(tag.Name, nscale.TerraformOperationTagPrefix) ||
+				strings.HasPrefix(tag.Name, detachedPoolTagPrefix) {
+				retained = append(retained, tag)
+			}
+		}
+		tags = &retained
+	}
+
+	return computeapi.ComputeClusterWrite{
+		Metadata: legacycore.ResourceWriteMetadata{
+			Name:        source.Metadata.Name,
+			Description: source.Metadata.Description,
+			Tags:        tags,
+		},
+		Spec: computeapi.ComputeClusterSpec{
+			RegionId:      source.Spec.RegionId,
+			WorkloadPools: slices.Clone(source.Spec.WorkloadPools),
+		},
+	}
+}
+
+// putDetachedPool adds or replaces the named pool in a cluster write and marks
+// it as managed by a standalone pool resource.
+func putDetachedPool(request *computeapi.ComputeClusterWrite, pool computeapi.ComputeClusterWorkloadPool) {
+	index := slices.IndexFunc(request.Spec.WorkloadPools, func(p computeapi.ComputeClusterWorkloadPool) bool {
+		return p.Name == pool.Name
+	})
+	if index < 0 {
+		request.Spec.WorkloadPools = append(request.Spec.WorkloadPools, pool)
+	} else {
+		request.Spec.WorkloadPools[index] = pool
+	}
+
+	tags := legacycore.TagList{}
+	if request.Metadata.Tags != nil {
+		tags = *request.Metadata.Tags
+	}
+
+	tagName := detachedPoolTagName(pool.Name)
+	if !slices.ContainsFunc(tags, func(tag legacycore.Tag) bool { return tag.Name == tagName }) {
+		tags = append(tags, legacycore.Tag{Name: tagName, Value: detachedPoolTagValue})
+	}
+
+	request.Metadata.Tags = &tags
+}
+
+// removeDetachedPool removes the named pool, and the tag marking it, from a
+// cluster write.
+func removeDetachedPool(request *computeapi.ComputeClusterWrite, name string) {
+	request.Spec.WorkloadPools = slices.DeleteFunc(
+		request.Spec.WorkloadPools,
+		func(pool computeapi.ComputeClusterWorkloadPool) bool { return pool.Name == name },
+	)
+
+	if request.Metadata.Tags != nil {
+		tags := slices.DeleteFunc(
+			*request.Metadata.Tags,
+			func(tag legacycore.Tag) bool { return tag.Name == detachedPoolTagName(name) },
+		)
+		request.Metadata.Tags = &tags
+	}
+}
+
+func findWorkloadPool(
+	cluster *computeapi.ComputeClusterRead,
+	name string,
+) (*computeapi.ComputeClusterWorkloadPool, *computeapi.ComputeClusterWorkloadPoolStatus) {
+	var spec *computeapi.ComputeClusterWorkloadPool
+	for i := range cluster.Spec.WorkloadPools {
+		if cluster.Spec.WorkloadPools[i].Name == name {
+			spec = &cluster.Spec.WorkloadPools[i]
+			break
+		}
+	}
+
+	if spec == nil || cluster.Status == nil || cluster.Status.WorkloadPools == nil {
+		return spec, nil
+	}
+
+	for i := range *cluster.Status.WorkloadPools {
+		if (*cluster.Status.WorkloadPools)[i].Name == name {
+			return spec, &(*cluster.Status.WorkloadPools)[i]
+		}
+	}
+
+	return spec, nil
+}
+
+// getWorkloadPool fetches the cluster that holds the named pool, reporting a
+// missing pool as not found so the shared reader drops it from state.
+func getWorkloadPool(
+	ctx context.Context,
+	client *nscale.Client,
+	clusterID, name string,
+) (*computeapi.ComputeClusterRead, *common.ProjectScopedResourceReadMetadata, error) {
+	cluster, metadata, err := getComputeCluster(ctx, client.OrganizationID, client.ProjectID, clusterID, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if spec, _ := findWorkloadPool(cluster, name); spec == nil {
+		err = &nscale.APIError{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("failed to find workload pool '%s' in compute cluster '%s'", name, clusterID),
+		}
+
+		return nil, nil, err
+	}
+
+	return cluster, metadata, nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	legacycore "github.com/unikorn-cloud/core/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+func testClusterWithPools(tags legacycore.TagList, names ...string) *computeapi.ComputeClusterRead {
+	cluster := &computeapi.ComputeClusterRead{}
+	cluster.Metadata.Tags = &tags
+	for _, name := range names {
+		cluster.Spec.WorkloadPools = append(cluster.Spec.WorkloadPools, computeapi.ComputeClusterWorkloadPool{Name: name})
+	}
+	return cluster
+}
+
+func poolNames(pools []computeapi.ComputeClusterWorkloadPool) []string {
+	names := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		names = append(names, pool.Name)
+	}
+	return names
+}
+
+func tagNames(tags *legacycore.TagList) []string {
+	if tags == nil {
+		return nil
+	}
+	names := make([]string, 0, len(*tags))
+	for _, tag := range *tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func TestWithoutDetachedPools(t *testing.T) {
+	cluster := testClusterWithPools(
+		legacycore.TagList{{Name: detachedPoolTagName("burst"), Value: detachedPoolTagValue}},
+		"default", "burst",
+	)
+
+	got := withoutDetachedPools(cluster)
+
+	if names := poolNames(got.Spec.WorkloadPools); !slices.Equal(names, []string{"default"}) {
+		t.Fatalf("withoutDetachedPools() pools = %v, want [default]", names)
+	}
+
+	if names := poolNames(cluster.Spec.WorkloadPools); !slices.Equal(names, []string{"default", "burst"}) {
+		t.Fatalf("withoutDetachedPools() modified its input: pools = %v", names)
+	}
+}
+
+func TestPreserveDetachedPools(t *testing.T) {
+	current := testClusterWithPools(
+		legacycore.TagList{
+			{Name: "team", Value: "ml"},
+			{Name: detachedPoolTagName("burst"), Value: detachedPoolTagValue},
+		},
+		"default", "burst",
+	)
+
+	t.Run("carries detached pools and their tags", func(t *testing.T) {
+		request := computeapi.ComputeClusterWrite{}
+		request.Metadata.Tags = &legacycore.TagList{{Name: "team", Value: "ml"}}
+		request.Spec.WorkloadPools = []computeapi.ComputeClusterWorkloadPool{{Name: "default"}}
+
+		if err := preserveDetachedPools(&request, current); err != nil {
+			t.Fatalf("preserveDetachedPools() error = %v", err)
+		}
+
+		if names := poolNames(request.Spec.WorkloadPools); !slices.Equal(names, []string{"default", "burst"}) {
+			t.Fatalf("preserveDetachedPools() pools = %v, want [default burst]", names)
+		}
+
+		wantTags := []string{"team", detachedPoolTagName("burst")}
+		if names := tagNames(request.Metadata.Tags); !slices.Equal(names, wantTags) {
+			t.Fatalf("preserveDetachedPools() tags = %v, want %v", names, wantTags)
+		}
+	})
+
+	t.Run("rejects a detached pool declared by the cluster", func(t *testing.T) {
+		request := computeapi.ComputeClusterWrite{}
+		request.Spec.WorkloadPools = []computeapi.ComputeClusterWorkloadPool{{Name: "default"}, {Name: "burst"}}
+
+		if err := preserveDetachedPools(&request, current); err == nil {
+			t.Fatal("preserveDetachedPools() error = nil, want an error")
+		}
+	})
+}
+
+func TestClusterWriteFromRead(t *testing.T) {
+	operationTag := nscale.TerraformOperationTagPrefix + "00000000-0000-0000-0000-000000000000"
+	cluster := testClusterWithPools(
+		legacycore.TagList{
+			{Name: "team", Value: "ml"},
+			{Name: operationTag, Value: "2026-01-01T00:00:00Z"},
+			{Name: detachedPoolTagName("burst"), Value: detachedPoolTagValue},
+		},
+		"default", "burst",
+	)
+
+	request := clusterWriteFromRead(cluster)
+
+	wantTags := []string{"team", detachedPoolTagName("burst")}
+	if names := tagNames(request.Metadata.Tags); !slices.Equal(names, wantTags) {
+		t.Fatalf("clusterWriteFromRead() tags = %v, want %v", names, wantTags)
+	}
+
+	removeDetachedPool(&request, "burst")
+
+	if names := poolNames(cluster.Spec.WorkloadPools); !slices.Equal(names, []string{"default", "burst"}) {
+		t.Fatalf("modifying the write changed the cluster it was built from: pools = %v", names)
+	}
+}
+
+func TestPutAndRemoveDetachedPool(t *testing.T) {
+	request := clusterWriteFromRead(testClusterWithPools(legacycore.TagList{}, "default"))
+
+	putDetachedPool(&request, computeapi.ComputeClusterWorkloadPool{Name: "burst"})
+	putDetachedPool(&request, computeapi.ComputeClusterWorkloadPool{
+		Name:    "burst",
+		Machine: computeapi.MachinePool{Replicas: 3},
+	})
+
+	if names := poolNames(request.Spec.WorkloadPools); !slices.Equal(names, []string{"default", "burst"}) {
+		t.Fatalf("putDetachedPool() pools = %v, want [default burst]", names)
+	}
+
+	if replicas := request.Spec.WorkloadPools[1].Machine.Replicas; replicas != 3 {
+		t.Fatalf("putDetachedPool() did not replace the existing pool: replicas = %d, want 3", replicas)
+	}
+
+	if names := tagNames(request.Metadata.Tags); !slices.Equal(names, []string{detachedPoolTagName("burst")}) {
+		t.Fatalf("putDetachedPool() tags = %v, want exactly one marker tag", names)
+	}
+
+	removeDetachedPool(&request, "burst")
+
+	if names := poolNames(request.Spec.WorkloadPools); !slices.Equal(names, []string{"default"}) {
+		t.Fatalf("removeDetachedPool() pools = %v, want [default]", names)
+	}
+
+	if names := tagNames(request.Metadata.Tags); len(names) != 0 {
+		t.Fatalf("removeDetachedPool() tags = %v, want none", names)
+	}
+}
+
+// testWorkloadPoolResource returns the schema of the workload pool resource
+// and a model of the pool of testComputeCluster that fits it.
+func testWorkloadPoolResource(t *testing.T) (schema.Schema, ComputeClusterWorkloadPoolResourceModel) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResponse resource.SchemaResponse
+	(&ComputeClusterWorkloadPoolResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	timeoutsType, diagnostics := schemaResponse.Schema.TypeAtPath(ctx, path.Root("timeouts"))
+	if diagnostics.HasError() {
+		t.Fatalf("failed to get the timeouts type: %v", diagnostics)
+	}
+
+	objectType, ok := timeoutsType.(tftimeouts.Type)
+	if !ok {
+		t.Fatalf("timeouts type = %T, want tftimeouts.Type", timeoutsType)
+	}
+
+	model := ComputeClusterWorkloadPoolResourceModel{
+		ID:        types.StringValue("cluster/default"),
+		ClusterID: types.StringValue("cluster"),
+		Timeouts:  tftimeouts.Value{Object: types.ObjectNull(objectType.AttrTypes)},
+	}
+	model.Name = types.StringValue("default")
+	if diagnostics := model.setWorkloadPool(ctx, testComputeCluster()); diagnostics.HasError() {
+		t.Fatalf("setWorkloadPool() error: %v", diagnostics)
+	}
+
+	return schemaResponse.Schema, model
+}
+
+func TestWorkloadPoolModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	poolResource := &ComputeClusterWorkloadPoolResource{}
+	poolSchema, state := testWorkloadPoolResource(t)
+
+	testCases := []struct {
+		name        string
+		replicas    int64
+		wantUnknown bool
+	}{
+		{name: "unchanged pool keeps its machines", replicas: 1},
+		{name: "resized pool plans its machines as unknown", replicas: 2, wantUnknown: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			plan := state
+			plan.Replicas = types.Int64Value(testCase.replicas)
+			plan.Machines = types.ListUnknown(MachineModelAttributeType)
+			plan.MachineCount = types.Int64Unknown()
+			plan.PrivateIPs = types.ListUnknown(types.StringType)
+			plan.PublicIPs = types.ListUnknown(types.StringType)
+
+			request := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: poolSchema},
+				Plan:  tfsdk.Plan{Schema: poolSchema},
+			}
+			request.State.Raw = tftypes.NewValue(poolSchema.Type().TerraformType(ctx), nil)
+			request.Plan.Raw = request.State.Raw
+			if diagnostics := request.State.Set(ctx, state); diagnostics.HasError() {
+				t.Fatalf("failed to set state: %v", diagnostics)
+			}
+			if diagnostics := request.Plan.Set(ctx, plan); diagnostics.HasError() {
+				t.Fatalf("failed to set plan: %v", diagnostics)
+			}
+
+			response := resource.ModifyPlanResponse{Plan: request.Plan}
+			poolResource.ModifyPlan(ctx, request, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() error: %v", response.Diagnostics)
+			}
+
+			var machineCount types.Int64
+			response.Plan.GetAttribute(ctx, path.Root("machine_count"), &machineCount)
+			if machineCount.IsUnknown() != testCase.wantUnknown {
+				t.Fatalf("planned machine_count = %v, want unknown %v", machineCount, testCase.wantUnknown)
+			}
+			if !testCase.wantUnknown && !machineCount.Equal(state.MachineCount) {
+				t.Fatalf("planned machine_count = %v, want %v from state", machineCount, state.MachineCount)
+			}
+		})
+	}
+}
+
+// withPrivateState gives a request or response the empty private state the
+// framework passes to resources, whose type is internal to the framework.
+func withPrivateState(requestOrResponse any) {
+	field := reflect.ValueOf(requestOrResponse).Elem().FieldByName("Private")
+	field.Set(reflect.New(field.Type().Elem()))
+}
+
+func TestWorkloadPoolImportHandover(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := testComputeCluster()
+	cluster.Status = nil
+
+	// The fake only serves reads, so any write fails the test.
+	legacyCompute := &fakeLegacyComputeClient{clusters: []computeapi.ComputeClusterRead{*cluster}, t: t}
+	poolResource := &ComputeClusterWorkloadPoolResource{
+		client: &nscale.Client{OrganizationID: "organization", LegacyCompute: legacyCompute},
+	}
+	poolSchema, _ := testWorkloadPoolResource(t)
+	nullState := tftypes.NewValue(poolSchema.Type().TerraformType(ctx), nil)
+
+	importResponse := resource.ImportStateResponse{State: tfsdk.State{Schema: poolSchema, Raw: nullState}}
+	withPrivateState(&importResponse)
+	poolResource.ImportState(ctx, resource.ImportStateRequest{ID: "cluster/default"}, &importResponse)
+	if importResponse.Diagnostics.HasError() {
+		t.Fatalf("ImportState() error: %v", importResponse.Diagnostics)
+	}
+
+	readResponse := resource.ReadResponse{State: importResponse.State, Private: importResponse.Private}
+	poolResource.Read(ctx, resource.ReadRequest{State: importResponse.State}, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("Read() error: %v", readResponse.Diagnostics)
+	}
+
+	untagged, _ := nscale.PrivateFlag(ctx, readResponse.Private, untaggedPoolPrivateKey)
+	if !untagged {
+		t.Fatal("Read() did not flag the imported pool as untagged")
+	}
+
+	request := resource.ModifyPlanRequest{
+		State:   readResponse.State,
+		Plan:    tfsdk.Plan{Schema: poolSchema, Raw: readResponse.State.Raw},
+		Private: readResponse.Private,
+	}
+	response := resource.ModifyPlanResponse{Plan: request.Plan, Private: request.Private}
+	poolResource.ModifyPlan(ctx, request, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() error: %v", response.Diagnostics)
+	}
+
+	var machines types.List
+	response.Plan.GetAttribute(ctx, path.Root("machines"), &machines)
+	if !machines.IsUnknown() {
+		t.Fatalf("planned machines = %v, want unknown so that the apply tags the pool", machines)
+	}
+
+	cluster.Metadata.Tags = &legacycore.TagList{{Name: detachedPoolTagName("default"), Value: detachedPoolTagValue}}
+	legacyCompute.clusters = []computeapi.ComputeClusterRead{*cluster}
+
+	poolResource.Read(ctx, resource.ReadRequest{State: readResponse.State}, &readResponse)
+	if untagged, _ = nscale.PrivateFlag(ctx, readResponse.Private, untaggedPoolPrivateKey); untagged {
+		t.Fatal("Read() flagged a tagged pool as untagged")
+	}
+}
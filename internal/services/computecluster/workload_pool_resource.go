@@ -0,0 +1,699 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computecluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	computeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+const defaultWorkloadPoolTimeout = 30 * time.Minute
+
+var (
+	_ resource.ResourceWithConfigure   = &ComputeClusterWorkloadPoolResource{}
+	_ resource.ResourceWithImportState = &ComputeClusterWorkloadPoolResource{}
+	_ resource.ResourceWithModifyPlan  = &ComputeClusterWorkloadPoolResource{}
+)
+
+type ComputeClusterWorkloadPoolResourceModel struct {
+	WorkloadPoolModel
+
+	ID        types.String     `tfsdk:"id"`
+	ClusterID types.String     `tfsdk:"cluster_id"`
+	Timeouts  tftimeouts.Value `tfsdk:"timeouts"`
+}
+
+// ComputeClusterWorkloadPoolResource manages a single workload pool of a
+// compute cluster. The cluster API has no endpoint for pools, so every write
+// is a read-modify-write of the cluster spec, serialized per cluster through
+// clusterLocks.
+type ComputeClusterWorkloadPoolResource struct {
+	client *nscale.Client
+}
+
+func NewComputeClusterWorkloadPoolResource() resource.Resource {
+	return &ComputeClusterWorkloadPoolResource{}
+}
+
+func (r *ComputeClusterWorkloadPoolResource) Configure(
+	ctx context.Context,
+	request resource.ConfigureRequest,
+	response *resource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.ComputeClusterAPIV1, "Compute cluster workload pool")...)
+}
+
+// ImportState parses a composite ID of the form "<cluster_id>/<name>", since
+// pools are identified by their name within the cluster, and checks that the
+// pool exists. Import only reads, as Terraform imports while planning: the
+// pool is marked as managed by a pool resource, so that the cluster resource
+// stops managing it, by the first apply after the import; see
+// untaggedPoolPrivateKey.
+func (r *ComputeClusterWorkloadPoolResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	const importIDParts = 2
+	parts := strings.SplitN(request.ID, "/", importIDParts)
+	if len(parts) != importIDParts || parts[0] == "" || parts[1] == "" {
+		response.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be of the form '<cluster_id>/<name>'.",
+		)
+		return
+	}
+
+	clusterID, name := parts[0], parts[1]
+
+	ctx = r.client.WithProjectID(ctx, "")
+
+	if _, _, err := getWorkloadPool(ctx, r.client, clusterID, name); err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Compute Cluster Workload Pool", "compute cluster workload pool", err)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (r *ComputeClusterWorkloadPoolResource) Metadata(
+	ctx context.Context,
+	request resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_compute_cluster_workload_pool"
+}
+
+func (r *ComputeClusterWorkloadPoolResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Compute Cluster Workload Pool",
+		Attributes: workloadPoolAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the workload pool, of the form `<cluster_id>/<name>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the compute cluster the workload pool belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workload pool. It must be unique within the compute cluster.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		}),
+		Blocks: map[string]schema.Block{
+			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan checks that the pool's image provides the versions it pins, and
+// plans the pool's machines as they are in state unless the pool's
+// configuration changes, as unchangedPoolMachinesPlanModifier does for the
+// pools of nscale_compute_cluster. Scaling the pool, or changing its image or
+// flavor, still plans them as unknown, so the new addresses are read on apply,
+// as does a pool the cluster has yet to mark as managed by a pool resource, so
+// that the apply marks it.
+func (r *ComputeClusterWorkloadPoolResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.checkVersionPins(ctx, request, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if request.State.Raw.IsNull() || len(response.RequiresReplace) > 0 {
+		return
+	}
+
+	plan, diagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	untagged, diagnostics := nscale.PrivateFlag(ctx, request.Private, untaggedPoolPrivateKey)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	if untagged {
+		plan.Machines = types.ListUnknown(MachineModelAttributeType)
+		plan.MachinesByHostname = types.MapUnknown(MachineModelAttributeType)
+		response.Diagnostics.Append(response.Plan.Set(ctx, plan)...)
+		return
+	}
+
+	state, diagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	planned, diagnostics := types.ObjectValueFrom(ctx, WorkloadPoolModelAttributeType.AttrTypes, plan.WorkloadPoolModel)
+	response.Diagnostics.Append(diagnostics...)
+	stored, diagnostics := types.ObjectValueFrom(ctx, WorkloadPoolModelAttributeType.AttrTypes, state.WorkloadPoolModel)
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ClusterID.Equal(state.ClusterID) || !withoutPoolMachines(planned).Equal(withoutPoolMachines(stored)) {
+		return
+	}
+
+	plan.Machines = state.Machines
+	plan.MachinesByHostname = state.MachinesByHostname
+	plan.MachineCount = state.MachineCount
+	plan.PrivateIPs = state.PrivateIPs
+	plan.PublicIPs = state.PublicIPs
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, plan)...)
+}
+
+// checkVersionPins checks that the planned pool's image provides the versions
+// it pins, in the region of its cluster. A cluster yet to be created is taken
+// to be in the provider's region.
+func (r *ComputeClusterWorkloadPoolResource) checkVersionPins(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	diagnostics *diag.Diagnostics,
+) {
+	plan, planDiagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.Plan.Get)
+	if planDiagnostics.HasError() {
+		diagnostics.Append(planDiagnostics...)
+		return
+	}
+
+	poolType := WorkloadPoolModelAttributeType.AttrTypes
+	planned, planDiagnostics := types.ObjectValueFrom(ctx, poolType, plan.WorkloadPoolModel)
+	diagnostics.Append(planDiagnostics...)
+
+	prior := types.ObjectNull(poolType)
+	if !request.State.Raw.IsNull() {
+		state, stateDiagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.State.Get)
+		diagnostics.Append(stateDiagnostics...)
+
+		// A pool moved to another cluster is checked in the region of its new one.
+		if plan.ClusterID.Equal(state.ClusterID) {
+			prior, stateDiagnostics = types.ObjectValueFrom(ctx, poolType, state.WorkloadPoolModel)
+			diagnostics.Append(stateDiagnostics...)
+		}
+	}
+
+	if diagnostics.HasError() {
+		return
+	}
+
+	pin, ok := poolVersionPin(planned, prior, path.Empty())
+	if !ok {
+		return
+	}
+
+	ctx = r.client.WithProjectID(ctx, "")
+
+	regionID := r.client.RegionID
+	if !plan.ClusterID.IsUnknown() {
+		clusterID := plan.ClusterID.ValueString()
+		cluster, _, err := getComputeCluster(ctx, r.client.OrganizationID, r.client.ProjectID, clusterID, r.client)
+		if err != nil {
+			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+			diagnostics.AddError(
+				"Failed to Check Pinned Versions",
+				fmt.Sprintf("An error occurred while retrieving the compute cluster: %s", err),
+			)
+			return
+		}
+		regionID = cluster.Spec.RegionId
+	}
+
+	checkVersionPins(ctx, r.client, regionID, []versionPin{pin}, diagnostics)
+}
+
+func (r *ComputeClusterWorkloadPoolResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	// Compute clusters live in the provider's default project.
+	ctx = r.client.WithProjectID(ctx, "")
+
+	data, diagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	timeout, diagnostics := data.Timeouts.Create(ctx, defaultWorkloadPoolTimeout)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	pool, diagnostics := data.NscaleWorkloadPool()
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	clusterID := data.ClusterID.ValueString()
+
+	cluster, ok := r.modifyCluster(ctx, clusterID, timeout, data.extraSpecs(), data.publicIPsSettled,
+		&response.Diagnostics,
+		func(cluster *computeapi.ComputeClusterRead, request *computeapi.ComputeClusterWrite) error {
+			if existing, _ := findWorkloadPool(cluster, pool.Name); existing != nil {
+				return fmt.Errorf("the compute cluster already has a workload pool named '%s'", pool.Name)
+			}
+
+			putDetachedPool(request, pool)
+			return nil
+		},
+	)
+	if !ok {
+		return
+	}
+
+	data.ID = types.StringValue(clusterID + "/" + pool.Name)
+	response.Diagnostics.Append(data.setWorkloadPool(ctx, cluster)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(data.setSSHConnections(ctx, r.client, cluster)...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ComputeClusterWorkloadPoolResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	ctx = r.client.WithProjectID(ctx, "")
+
+	data, diagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resourceReader := nscale.ResourceReader[computeapi.ComputeClusterRead]{
+		ResourceTitle: "Compute Cluster Workload Pool",
+		ResourceName:  "compute cluster workload pool",
+		GetFunc: func(ctx context.Context, _ string) (*computeapi.ComputeClusterRead, nscale.ResourceStatus, error) {
+			return nscale.AdaptProjectScoped(
+				getWorkloadPool(ctx, r.client, data.ClusterID.ValueString(), data.Name.ValueString()),
+			)
+		},
+	}
+
+	cluster, ok := resourceReader.Read(ctx, data.ID.ValueString(), response)
+	if !ok {
+		return
+	}
+
+	untagged := !poolTagged(cluster, data.Name.ValueString())
+	response.Diagnostics.Append(nscale.SetPrivateFlag(ctx, response.Private, untaggedPoolPrivateKey, untagged)...)
+
+	response.Diagnostics.Append(data.setWorkloadPool(ctx, cluster)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(data.setSSHConnections(ctx, r.client, cluster)...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ComputeClusterWorkloadPoolResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	ctx = r.client.WithProjectID(ctx, "")
+
+	defer nscale.ReconcileFailedUpdate(ctx, r.Read, response)
+
+	data, diagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	timeout, diagnostics := data.Timeouts.Update(ctx, defaultWorkloadPoolTimeout)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	pool, diagnostics := data.NscaleWorkloadPool()
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	state, diagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	cluster, ok := r.modifyCluster(ctx, data.ClusterID.ValueString(), timeout, data.extraSpecs(), data.publicIPsSettled,
+		&response.Diagnostics,
+		func(_ *computeapi.ComputeClusterRead, request *computeapi.ComputeClusterWrite) error {
+			putDetachedPool(request, pool)
+			return nil
+		},
+	)
+	if !ok {
+		return
+	}
+
+	if data.replacesMachinesFrom(state) {
+		update := imageUpdate{
+			pool:    pool.Name,
+			imageID: data.ImageID.ValueString(),
+			policy:  data.ImageUpdatePolicy.ValueString(),
+		}
+
+		var replaced *computeapi.ComputeClusterRead
+		replaced, _, ok = replaceOutdatedMachines(
+			ctx, r.client, data.ClusterID.ValueString(), update, data.extraSpecs(), time.Until(deadline),
+			&response.Diagnostics,
+		)
+		if !ok {
+			return
+		}
+
+		cluster = replaced
+	}
+
+	// modifyCluster has marked the pool as managed by a pool resource.
+	response.Diagnostics.Append(nscale.SetPrivateFlag(ctx, response.Private, untaggedPoolPrivateKey, false)...)
+
+	response.Diagnostics.Append(data.setWorkloadPool(ctx, cluster)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(data.setSSHConnections(ctx, r.client, cluster)...)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ComputeClusterWorkloadPoolResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	ctx = r.client.WithProjectID(ctx, "")
+
+	data, diagnostics := nscale.ReadTerraformState[ComputeClusterWorkloadPoolResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	timeout, diagnostics := data.Timeouts.Delete(ctx, defaultWorkloadPoolTimeout)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	// A pool whose cluster is already gone has been deleted along with it.
+	clusterID := data.ClusterID.ValueString()
+	_, _, err := getComputeCluster(ctx, r.client.OrganizationID, r.client.ProjectID, clusterID, r.client)
+	if nscale.IsAPIErrorNotFound(err) {
+		return
+	}
+
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Compute Cluster", "compute cluster", err)
+		return
+	}
+
+	r.modifyCluster(ctx, clusterID, timeout, nil, nil, &response.Diagnostics,
+		func(_ *computeapi.ComputeClusterRead, request *computeapi.ComputeClusterWrite) error {
+			removeDetachedPool(request, name)
+			return nil
+		},
+	)
+}
+
+// modifyCluster applies mutate to the current cluster and writes it back with
+// extraSpecs merged into the pools they name, holding the cluster's lock until
+// the write is observed, so the next pool resource to modify the cluster reads
+// it with this change applied. When settled is set, the write is only taken as
+// observed once it reports the cluster has caught up with it.
+func (r *ComputeClusterWorkloadPoolResource) modifyCluster(
+	ctx context.Context,
+	clusterID string,
+	timeout time.Duration,
+	extraSpecs map[string]string,
+	settled func(cluster *computeapi.ComputeClusterRead) bool,
+	diagnostics *diag.Diagnostics,
+	mutate func(cluster *computeapi.ComputeClusterRead, request *computeapi.ComputeClusterWrite) error,
+) (*computeapi.ComputeClusterRead, bool) {
+	unlock := clusterLocks.Lock(clusterID)
+	defer unlock()
+
+	cluster, currentPools, err := getComputeClusterForWrite(ctx, r.client, clusterID)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(diagnostics, apidiag.Read, "Compute Cluster", "compute cluster", err)
+		return nil, false
+	}
+
+	requestData := clusterWriteFromRead(cluster)
+	if err = mutate(cluster, &requestData); err != nil {
+		apidiag.Add(diagnostics, apidiag.Update, "Compute Cluster Workload Pool", "compute cluster workload pool", err)
+		return nil, false
+	}
+
+	operationTagKey := writeOperationTagLegacy(&requestData.Metadata)
+
+	body, err := clusterRequestBody(requestData, currentPools, extraSpecs)
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to Update Compute Cluster Workload Pool",
+			fmt.Sprintf("An error occurred while merging the extra spec into the workload pool: %s", err),
+		)
+		return nil, false
+	}
+
+	updateResponse, err := r.client.LegacyCompute.PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDWithBody(
+		ctx,
+		r.client.OrganizationID,
+		cluster.Metadata.ProjectId,
+		clusterID,
+		nscale.ExtraSpecContentType,
+		body,
+	)
+	if err != nil {
+		apidiag.Add(diagnostics, apidiag.Update, "Compute Cluster Workload Pool", "compute cluster workload pool", err)
+		return nil, false
+	}
+	defer updateResponse.Body.Close()
+
+	if err = nscale.ReadEmptyResponse(updateResponse); err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(diagnostics, apidiag.Update, "Compute Cluster Workload Pool", "compute cluster workload pool", err)
+		return nil, false
+	}
+
+	stateWatcher := nscale.UpdateStateWatcher[computeapi.ComputeClusterRead]{
+		ResourceTitle: "Compute Cluster",
+		ResourceName:  "compute cluster",
+		GetFunc: func(ctx context.Context) (*computeapi.ComputeClusterRead, nscale.ResourceStatus, error) {
+			cluster, status, err := nscale.AdaptProjectScoped(
+				getComputeCluster(ctx, r.client.OrganizationID, r.client.ProjectID, clusterID, r.client),
+			)
+			if err == nil && settled != nil && !settled(cluster) {
+				status.Tags = nil
+			}
+
+			return cluster, status, err
+		},
+	}
+
+	return stateWatcher.WaitFor(ctx, operationTagKey, timeout, diagnostics)
+}
+
+// setWorkloadPool copies the named pool of the cluster into the model.
+func (m *ComputeClusterWorkloadPoolResourceModel) setWorkloadPool(
+	ctx context.Context,
+	cluster *computeapi.ComputeClusterRead,
+) diag.Diagnostics {
+	spec, status := findWorkloadPool(cluster, m.Name.ValueString())
+	if spec == nil {
+		return NewErrorDiagnostics(
+			"Failed to Read Compute Cluster Workload Pool",
+			fmt.Sprintf(
+				"The workload pool '%s' was not found in the compute cluster '%s'.",
+				m.Name.ValueString(),
+				m.ClusterID.ValueString(),
+			),
+		)
+	}
+
+	// The API does not return the fields an extra spec sets, the image update
+	// policy, nor the pinned versions.
+	extraSpec, imageUpdatePolicy := m.ExtraSpecJSON, m.ImageUpdatePolicy
+	gpuDriverVersion, cudaVersion := m.GPUDriverVersion, m.CUDAVersion
+	machines := m.Machines
+	diagnostics := NewWorkloadPoolModel(*spec, status).As(ctx, &m.WorkloadPoolModel, basetypes.ObjectAsOptions{})
+	m.ExtraSpecJSON = extraSpec
+	m.ImageUpdatePolicy = imageUpdatePolicy
+	m.GPUDriverVersion, m.CUDAVersion = gpuDriverVersion, cudaVersion
+	m.Machines = withPriorMachineSSHConnections(m.Machines, machines)
+	m.MachinesByHostname = machinesByHostname(m.Machines)
+
+	// The pool always lists its machines, which the summaries would only
+	// repeat.
+	m.MachineCount = types.Int64Null()
+	m.PrivateIPs = types.ListNull(types.StringType)
+	m.PublicIPs = types.ListNull(types.StringType)
+
+	// Imported state has no configuration to take the policy from.
+	if m.ImageUpdatePolicy.IsNull() {
+		m.ImageUpdatePolicy = types.StringValue(imageUpdatePolicyIgnore)
+	}
+
+	// Machines left on an old image by a failed replacement show as a change
+	// back to the configured image, which the next apply finishes.
+	if replacesMachines(m.ImageUpdatePolicy) {
+		if pending := pendingImageID(cluster, m.Name.ValueString(), m.ImageID.ValueString()); pending != "" {
+			m.ImageID = types.StringValue(pending)
+		}
+	}
+
+	return diagnostics
+}
+
+// setSSHConnections sets the user of the SSH connections of the pool's
+// machines, which setWorkloadPool keeps from the prior state.
+func (m *ComputeClusterWorkloadPoolResourceModel) setSSHConnections(
+	ctx context.Context,
+	client *nscale.Client,
+	cluster *computeapi.ComputeClusterRead,
+) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if m.Machines.IsNull() || m.Machines.IsUnknown() || len(m.Machines.Elements()) == 0 {
+		return diagnostics
+	}
+
+	users := machineLoginUsers(ctx, client, cluster.Spec.RegionId, &diagnostics)
+	m.Machines = withMachineSSHConnections(m.Machines, users, m.ImageID.ValueString())
+	m.MachinesByHostname = machinesByHostname(m.Machines)
+
+	return diagnostics
+}
+
+// replacesMachinesFrom reports whether the pool's policy replaces the machines
+// on an old image, and either its image has changed since prior or its policy
+// did not replace them in prior.
+func (m *ComputeClusterWorkloadPoolResourceModel) replacesMachinesFrom(
+	prior ComputeClusterWorkloadPoolResourceModel,
+) bool {
+	return replacesMachines(m.ImageUpdatePolicy) &&
+		(!m.ImageID.Equal(prior.ImageID) || !replacesMachines(prior.ImageUpdatePolicy))
+}
+
+// publicIPsSettled reports whether the pool's machines have public IP
+// addresses exactly when the pool enables them.
+func (m *ComputeClusterWorkloadPoolResourceModel) publicIPsSettled(cluster *computeapi.ComputeClusterRead) bool {
+	return poolPublicIPsSettled(cluster, m.Name.ValueString(), m.EnablePublicIP)
+}
+
+// extraSpecs maps the pool's name to its extra spec, or to an empty string if
+// it has none, for clusterRequestBody.
+func (m *ComputeClusterWorkloadPoolResourceModel) extraSpecs() map[string]string {
+	if m.ExtraSpecJSON.IsNull() || m.ExtraSpecJSON.IsUnknown() {
+		return map[string]string{m.Name.ValueString(): ""}
+	}
+
+	return map[string]string{m.Name.ValueString(): m.ExtraSpecJSON.ValueString()}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	legacycomputeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+var _ datasource.DataSourceWithConfigure = &ResourceEventsDataSource{}
+
+// ResourceEventsDataSource reports the lifecycle of an instance or a compute
+// cluster and the current statuses of it and its machines, so a pipeline can
+// print why a resource failed without leaving Terraform.
+type ResourceEventsDataSource struct {
+	client *nscale.Client
+}
+
+func NewResourceEventsDataSource() datasource.DataSource {
+	return &ResourceEventsDataSource{}
+}
+
+func (s *ResourceEventsDataSource) Configure(
+	ctx context.Context,
+	request datasource.ConfigureRequest,
+	response *datasource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	s.client = client
+}
+
+func (s *ResourceEventsDataSource) Metadata(
+	ctx context.Context,
+	request datasource.MetadataRequest,
+	response *datasource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_resource_events"
+}
+
+func (s *ResourceEventsDataSource) Schema(
+	ctx context.Context,
+	request datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Resource Events",
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the instance or compute cluster whose events to read.",
+				Required:            true,
+			},
+			"resource_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the resource, either `instance` or `compute_cluster`. If not specified, the resource is looked up as an instance and then as a compute cluster.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(resourceTypeInstance, resourceTypeComputeCluster),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project the compute cluster belongs to. Defaults to the provider's `project_id`. Ignored for instances.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "The events of the resource, oldest first, followed by the current statuses of the resource and of each of its machines. The API keeps no event history, so the events are the resource's creation, its last update and the start of its deletion.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"time": schema.StringAttribute{
+							MarkdownDescription: "The time of the event, in RFC 3339 format, or null for a current status.",
+							Computed:            true,
+						},
+						"subject": schema.StringAttribute{
+							MarkdownDescription: "What the event is about, such as `instance`, `compute cluster` or `machine <pool>/<hostname>`.",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "The kind of event, one of `Created`, `Updated`, `Deleting`, `ProvisioningStatus`, `HealthStatus` and `PowerState`.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The detail of the event, such as the user who made it or the value of a status.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"summary": schema.StringAttribute{
+				MarkdownDescription: "The events, one per line, for printing into a job log with `terraform output -raw`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (s *ResourceEventsDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[ResourceEventsModel](ctx, request.Config.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	var events []resourceEvent
+
+	resourceType := data.ResourceType.ValueString()
+	if resourceType == "" || resourceType == resourceTypeInstance {
+		var found bool
+		events, found, diagnostics = s.readInstanceEvents(ctx, data.ResourceID.ValueString(), resourceType == "")
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if found {
+			resourceType = resourceTypeInstance
+		}
+	}
+
+	if resourceType != resourceTypeInstance {
+		resourceType = resourceTypeComputeCluster
+		events, diagnostics = s.readComputeClusterEvents(ctx, &data)
+		response.Diagnostics.Append(diagnostics...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		data.ProjectID = types.StringNull()
+	}
+
+	data.ResourceType = types.StringValue(resourceType)
+	data.setEvents(events)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// readInstanceEvents reads the events of the instance with the given ID.
+// When lookup is set, an instance that does not exist, or an environment
+// without instances, is reported as not found rather than as an error, so
+// the resource can be looked up as a compute cluster instead.
+func (s *ResourceEventsDataSource) readInstanceEvents(
+	ctx context.Context,
+	instanceID string,
+	lookup bool,
+) ([]resourceEvent, bool, diag.Diagnostics) {
+	diagnostics := s.client.RequireFeature(ctx, nscale.ComputeAPIV2, "Instance events")
+	if diagnostics.HasError() {
+		if lookup {
+			return nil, false, nil
+		}
+		return nil, false, diagnostics
+	}
+
+	ctx = s.client.WithInstanceProjectID(ctx, instanceID)
+
+	instance, err := s.client.GetInstance(ctx, instanceID)
+	if err != nil {
+		if lookup && nscale.IsAPIErrorNotFound(err) {
+			return nil, false, diagnostics
+		}
+
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Read, "Resource Events", "instance", err)
+		return nil, false, diagnostics
+	}
+
+	return newInstanceEvents(instance), true, diagnostics
+}
+
+// readComputeClusterEvents reads the events of the compute cluster in the
+// model's project, defaulting the project to the provider's.
+func (s *ResourceEventsDataSource) readComputeClusterEvents(
+	ctx context.Context,
+	data *ResourceEventsModel,
+) ([]resourceEvent, diag.Diagnostics) {
+	diagnostics := s.client.RequireFeature(ctx, nscale.ComputeClusterAPIV1, "Compute cluster events")
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	projectID, diagnostics := s.client.ResolveProjectID(data.ProjectID.ValueString())
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+	data.ProjectID = types.StringValue(projectID)
+
+	ctx = s.client.WithProjectID(ctx, projectID)
+
+	clusterResponse, err := s.client.LegacyCompute.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(
+		ctx,
+		s.client.OrganizationID,
+		projectID,
+		data.ResourceID.ValueString(),
+	)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Read, "Resource Events", "compute cluster", err)
+		return nil, diagnostics
+	}
+	defer clusterResponse.Body.Close()
+
+	cluster, err := nscale.ReadJSONResponsePointer[legacycomputeapi.ComputeClusterRead](clusterResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+
+		detail := fmt.Sprintf("An error occurred while retrieving the compute cluster: %s", err)
+		if nscale.IsAPIErrorNotFound(err) && data.ResourceType.IsNull() {
+			detail = fmt.Sprintf(
+				"No instance or compute cluster with ID %s was found in the project %s.",
+				data.ResourceID.ValueString(),
+				projectID,
+			)
+		}
+
+		diagnostics.AddError("Failed to Read Resource Events", detail)
+		return nil, diagnostics
+	}
+
+	return newComputeClusterEvents(cluster), diagnostics
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+	legacycomputeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+)
+
+const (
+	resourceTypeInstance       = "instance"
+	resourceTypeComputeCluster = "compute_cluster"
+)
+
+//nolint:gochecknoglobals // constant attribute type.
+var ResourceEventModelAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"time":    types.StringType,
+		"subject": types.StringType,
+		"reason":  types.StringType,
+		"message": types.StringType,
+	},
+}
+
+type ResourceEventsModel struct {
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	Events       types.List   `tfsdk:"events"`
+	Summary      types.String `tfsdk:"summary"`
+}
+
+// resourceEvent is a lifecycle event of a resource or one of its machines.
+// The API keeps no event history, so events are derived from the times a
+// resource records and from its current statuses, which have no time.
+type resourceEvent struct {
+	time    *time.Time
+	subject string
+	reason  string
+	message string
+}
+
+// String formats the event as a line of the summary.
+func (e resourceEvent) String() string {
+	line := fmt.Sprintf("%s %s: %s", e.subject, e.reason, e.message)
+	if e.time != nil {
+		line = e.time.UTC().Format(time.RFC3339) + " " + line
+	}
+
+	return line
+}
+
+// metadataEvents returns the events recorded by the metadata of a resource:
+// its creation, its last update and the start of its deletion.
+func metadataEvents(
+	subject string,
+	creationTime time.Time,
+	createdBy *string,
+	modifiedTime *time.Time,
+	modifiedBy *string,
+	deletionTime *time.Time,
+) []resourceEvent {
+	events := []resourceEvent{
+		{time: &creationTime, subject: subject, reason: "Created", message: byUser("created", createdBy)},
+	}
+
+	if modifiedTime != nil && !modifiedTime.Equal(creationTime) {
+		events = append(events, resourceEvent{
+			time: modifiedTime, subject: subject, reason: "Updated", message: byUser("updated", modifiedBy),
+		})
+	}
+
+	if deletionTime != nil {
+		events = append(events, resourceEvent{
+			time: deletionTime, subject: subject, reason: "Deleting", message: "deletion requested",
+		})
+	}
+
+	return events
+}
+
+// byUser returns action, followed by the user who took it when it is known.
+func byUser(action string, user *string) string {
+	if user == nil || *user == "" {
+		return action
+	}
+
+	return action + " by " + *user
+}
+
+// statusEvents returns the current statuses of a resource or machine as
+// events without a time. The power state is skipped when it is empty.
+func statusEvents(subject, provisioningStatus, healthStatus, powerState string) []resourceEvent {
+	events := []resourceEvent{
+		{subject: subject, reason: "ProvisioningStatus", message: provisioningStatus},
+		{subject: subject, reason: "HealthStatus", message: healthStatus},
+	}
+
+	if powerState != "" {
+		events = append(events, resourceEvent{subject: subject, reason: "PowerState", message: powerState})
+	}
+
+	return events
+}
+
+// newInstanceEvents returns the events of an instance.
+func newInstanceEvents(source *computeapi.InstanceRead) []resourceEvent {
+	metadata := source.Metadata
+
+	events := metadataEvents(
+		resourceTypeInstance,
+		metadata.CreationTime,
+		metadata.CreatedBy,
+		metadata.ModifiedTime,
+		metadata.ModifiedBy,
+		metadata.DeletionTime,
+	)
+
+	var powerState string
+	if source.Status.PowerState != nil {
+		powerState = string(*source.Status.PowerState)
+	}
+
+	return append(events, statusEvents(
+		resourceTypeInstance,
+		string(metadata.ProvisioningStatus),
+		string(metadata.HealthStatus),
+		powerState,
+	)...)
+}
+
+// newComputeClusterEvents returns the events of a compute cluster, followed
+// by the statuses of its machines, which are named after their pool and
+// hostname.
+func newComputeClusterEvents(source *legacycomputeapi.ComputeClusterRead) []resourceEvent {
+	const subject = "compute cluster"
+
+	metadata := source.Metadata
+
+	events := metadataEvents(
+		subject,
+		metadata.CreationTime,
+		metadata.CreatedBy,
+		metadata.ModifiedTime,
+		metadata.ModifiedBy,
+		metadata.DeletionTime,
+	)
+	events = append(events, statusEvents(
+		subject,
+		string(metadata.ProvisioningStatus),
+		string(metadata.HealthStatus),
+		"",
+	)...)
+
+	if source.Status == nil || source.Status.WorkloadPools == nil {
+		return events
+	}
+
+	for _, pool := range *source.Status.WorkloadPools {
+		if pool.Machines == nil {
+			continue
+		}
+
+		for _, machine := range *pool.Machines {
+			events = append(events, statusEvents(
+				fmt.Sprintf("machine %s/%s", pool.Name, machine.Hostname),
+				string(machine.ProvisioningStatus),
+				string(machine.HealthStatus),
+				string(machine.Status),
+			)...)
+		}
+	}
+
+	return events
+}
+
+// setEvents sets the events, oldest first and followed by the current
+// statuses, and the summary made of them.
+func (m *ResourceEventsModel) setEvents(events []resourceEvent) {
+	slices.SortStableFunc(events, func(a, b resourceEvent) int {
+		switch {
+		case a.time == nil && b.time == nil:
+			return 0
+		case a.time == nil:
+			return 1
+		case b.time == nil:
+			return -1
+		default:
+			return a.time.Compare(*b.time)
+		}
+	})
+
+	elements := make([]attr.Value, 0, len(events))
+	lines := make([]string, 0, len(events))
+
+	for _, event := range events {
+		eventTime := types.StringNull()
+		if event.time != nil {
+			eventTime = types.StringValue(event.time.UTC().Format(time.RFC3339))
+		}
+
+		elements = append(elements, types.ObjectValueMust(
+			ResourceEventModelAttributeType.AttrTypes,
+			map[string]attr.Value{
+				"time":    eventTime,
+				"subject": types.StringValue(event.subject),
+				"reason":  types.StringValue(event.reason),
+				"message": types.StringValue(event.message),
+			},
+		))
+		lines = append(lines, event.String())
+	}
+
+	m.Events = types.ListValueMust(ResourceEventModelAttributeType, elements)
+	m.Summary = types.StringValue(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+	legacycomputeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+	legacycore "github.com/unikorn-cloud/core/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/pointer"
+)
+
+func TestInstanceEvents(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	modified := created.Add(time.Hour)
+
+	instance := &computeapi.InstanceRead{
+		Metadata: coreapi.ProjectScopedResourceReadMetadata{
+			CreationTime:       created,
+			CreatedBy:          pointer.Reference("alice"),
+			ModifiedTime:       &modified,
+			ProvisioningStatus: coreapi.ResourceProvisioningStatusError,
+			HealthStatus:       coreapi.ResourceHealthStatusUnknown,
+		},
+	}
+
+	var model ResourceEventsModel
+	model.setEvents(newInstanceEvents(instance))
+
+	want := strings.Join([]string{
+		"2026-10-01T12:00:00Z instance Created: created by alice",
+		"2026-10-01T13:00:00Z instance Updated: updated",
+		"instance ProvisioningStatus: error",
+		"instance HealthStatus: unknown",
+	}, "\n")
+
+	if got := model.Summary.ValueString(); got != want {
+		t.Fatalf("summary =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := len(model.Events.Elements()); got != 4 {
+		t.Fatalf("events = %d, want 4", got)
+	}
+}
+
+func TestComputeClusterEventsIncludeMachines(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	deleted := created.Add(2 * time.Hour)
+
+	machines := legacycomputeapi.ComputeClusterMachinesStatus{
+		{
+			Hostname:           "gpu-0",
+			ProvisioningStatus: legacycore.ResourceProvisioningStatusError,
+			HealthStatus:       legacycore.ResourceHealthStatusDegraded,
+			Status:             "Error",
+		},
+	}
+
+	cluster := &legacycomputeapi.ComputeClusterRead{
+		Metadata: legacycore.ProjectScopedResourceReadMetadata{
+			CreationTime:       created,
+			ModifiedTime:       &created,
+			DeletionTime:       &deleted,
+			ProvisioningStatus: legacycore.ResourceProvisioningStatusDeprovisioning,
+			HealthStatus:       legacycore.ResourceHealthStatusHealthy,
+		},
+		Status: &legacycomputeapi.ComputeClusterStatus{
+			WorkloadPools: &legacycomputeapi.ComputeClusterWorkloadPoolsStatus{
+				{Name: "gpu", Machines: &machines},
+			},
+		},
+	}
+
+	var model ResourceEventsModel
+	model.setEvents(newComputeClusterEvents(cluster))
+
+	// An update at the creation time is the creation itself.
+	want := strings.Join([]string{
+		"2026-10-01T12:00:00Z compute cluster Created: created",
+		"2026-10-01T14:00:00Z compute cluster Deleting: deletion requested",
+		"compute cluster ProvisioningStatus: deprovisioning",
+		"compute cluster HealthStatus: healthy",
+		"machine gpu/gpu-0 ProvisioningStatus: error",
+		"machine gpu/gpu-0 HealthStatus: degraded",
+		"machine gpu/gpu-0 PowerState: Error",
+	}, "\n")
+
+	if got := model.Summary.ValueString(); got != want {
+		t.Fatalf("summary =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
 
@@ -60,6 +61,8 @@ func (s *FileStorageClassDataSource) Configure(
 	}
 
 	s.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.RegionAPIV2, "File storage class")...)
 }
 
 func (s *FileStorageClassDataSource) Metadata(
@@ -131,10 +134,7 @@ func (s *FileStorageClassDataSource) Read(
 
 	storageClassListResponse, err := s.client.Region.GetApiV2Filestorageclasses(ctx, params)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Read File Storage Class",
-			fmt.Sprintf("An error occurred while retrieving the file storage class: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "File Storage Class", "file storage class", err)
 		return
 	}
 	defer storageClassListResponse.Body.Close()
@@ -142,10 +142,7 @@ func (s *FileStorageClassDataSource) Read(
 	storageClasses, err := nscale.ReadJSONResponseValue[[]regionapi.StorageClassV2Read](storageClassListResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Read File Storage Class",
-			fmt.Sprintf("An error occurred while retrieving the file storage class: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "File Storage Class", "file storage class", err)
 		return
 	}
 
@@ -19,6 +19,7 @@ package filestorage
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,9 +40,10 @@ func NewFileStorageDataSource() datasource.DataSource {
 	return &FileStorageDataSource{
 		GenericDataSource: nscale.NewGenericDataSource(
 			nscale.DataSourceAdapter[FileStorageModel, regionapi.StorageV2Read]{
-				TypeNameSuffix: "_file_storage",
-				Title:          "File Storage",
-				Name:           "file storage",
+				TypeNameSuffix:  "_file_storage",
+				Title:           "File Storage",
+				Name:            "file storage",
+				RequiredFeature: nscale.RegionAPIV2,
 				Get: func(ctx context.Context, client *nscale.Client, id string) (*regionapi.StorageV2Read, error) {
 					fs, _, err := getFileStorage(ctx, id, client)
 					return fs, err
@@ -151,8 +153,26 @@ func (s *FileStorageDataSource) Schema(
 				MarkdownDescription: "The identifier of the region where the file storage is provisioned.",
 				Computed:            true,
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("file storage"),
+				Computed:            true,
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the file storage was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the file storage.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the file storage.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the file storage was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
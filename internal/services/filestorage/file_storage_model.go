@@ -19,8 +19,8 @@ package filestorage
 import (
 	"context"
 	"sort"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -33,18 +33,22 @@ import (
 )
 
 type FileStorageModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	StorageClassID types.String `tfsdk:"storage_class_id"`
-	Size           types.Int64  `tfsdk:"size"`
-	Capacity       types.Int64  `tfsdk:"capacity"`
-	RootSquash     types.Bool   `tfsdk:"root_squash"`
-	Network        types.List   `tfsdk:"network"`
-	Tags           types.Map    `tfsdk:"tags"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	RegionID       types.String `tfsdk:"region_id"`
-	CreationTime   types.String `tfsdk:"creation_time"`
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	StorageClassID   types.String      `tfsdk:"storage_class_id"`
+	Size             types.Int64       `tfsdk:"size"`
+	Capacity         types.Int64       `tfsdk:"capacity"`
+	RootSquash       types.Bool        `tfsdk:"root_squash"`
+	Network          types.List        `tfsdk:"network"`
+	Tags             types.Map         `tfsdk:"tags"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	RegionID         types.String      `tfsdk:"region_id"`
+	SpecFingerprint  types.String      `tfsdk:"spec_fingerprint"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
 
 	// DefaultSnapshotProtectionEnabled mirrors the API-resolved platform-managed
 	// Default Snapshot Protection setting. It is separate from any user-managed
@@ -184,18 +188,22 @@ func NewFileStorageModel(source *regionapi.StorageV2Read) FileStorageModel {
 	tags := nscale.RemoveOperationTags(source.Metadata.Tags)
 
 	return FileStorageModel{
-		ID:             types.StringValue(source.Metadata.Id),
-		Name:           types.StringValue(source.Metadata.Name),
-		Description:    types.StringPointerValue(source.Metadata.Description),
-		StorageClassID: types.StringValue(source.Status.StorageClassId),
-		Size:           size,
-		Capacity:       types.Int64Value(source.Spec.SizeGiB),
-		RootSquash:     rootSquash,
-		Network:        networks,
-		Tags:           tftypes.TagMapValueMust(tags),
-		ProjectID:      types.StringValue(source.Metadata.ProjectId),
-		RegionID:       types.StringValue(source.Status.RegionId),
-		CreationTime:   types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		StorageClassID:   types.StringValue(source.Status.StorageClassId),
+		Size:             size,
+		Capacity:         types.Int64Value(source.Spec.SizeGiB),
+		RootSquash:       rootSquash,
+		Network:          networks,
+		Tags:             tftypes.TagMapValueMust(tags),
+		ProjectID:        types.StringValue(source.Metadata.ProjectId),
+		RegionID:         types.StringValue(source.Status.RegionId),
+		SpecFingerprint:  nscale.SpecFingerprint(source.Spec),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 
 		DefaultSnapshotProtectionEnabled: types.BoolPointerValue(source.Spec.DefaultSnapshotProtectionEnabled),
 		SnapshotPolicies:                 NewFileStorageSnapshotPolicies(source.Spec.SnapshotPolicies),
@@ -22,6 +22,7 @@ import (
 	"net/http"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -36,13 +37,16 @@ import (
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 	regionids "github.com/unikorn-cloud/region/pkg/ids"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
 var (
 	_ resource.ResourceWithConfigure   = &FileStorageResource{}
 	_ resource.ResourceWithImportState = &FileStorageResource{}
+	_ resource.ResourceWithModifyPlan  = &FileStorageResource{}
 )
 
 type FileStorageResourceModel struct {
@@ -82,6 +86,8 @@ func (r *FileStorageResource) Configure(
 	}
 
 	r.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.RegionAPIV2, "File storage")...)
 }
 
 func (r *FileStorageResource) ImportState(
@@ -244,13 +250,44 @@ func (r *FileStorageResource) Schema(
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("file storage"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					nscale.SpecFingerprintPlanModifier(
+						"storage_class_id",
+						"capacity",
+						"root_squash",
+						"default_snapshot_protection_enabled",
+						"snapshot_policies",
+						"network",
+					),
+				},
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the file storage was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the file storage.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the file storage.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the file storage was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"network": schema.ListNestedBlock{
@@ -320,6 +357,14 @@ func configuredSnapshotPolicies(
 	return value
 }
 
+// setFileStorage sets the attributes read from the file storage, keeping the
+// prior form of an empty description.
+func (m *FileStorageResourceModel) setFileStorage(source *regionapi.StorageV2Read) {
+	priorDescription := m.Description
+	m.FileStorageModel = NewFileStorageModel(source)
+	m.Description = tftypes.StringWithPriorEmpty(m.Description, priorDescription)
+}
+
 func (m *FileStorageResourceModel) preserveSizeIfUsageRefreshDisabled(previousSize types.Int64) {
 	if m.RefreshUsage.ValueBool() {
 		return
@@ -328,11 +373,21 @@ func (m *FileStorageResourceModel) preserveSizeIfUsageRefreshDisabled(previousSi
 	m.Size = previousSize
 }
 
+func (r *FileStorageResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	nscale.EnforceRequiredTags(ctx, r.client, request, response)
+}
+
 func (r *FileStorageResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
 	response *resource.CreateResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.Plan.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[FileStorageResourceModel](ctx, request.Plan.Get, r.setDefaults)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -362,12 +417,13 @@ func (r *FileStorageResource) Create(
 		return
 	}
 
-	fileStorageCreateResponse, err := r.client.Region.PostApiV2Filestorage(ctx, params)
+	fileStorageCreateResponse, err := r.client.RetryDependencyNotFound(ctx, data.Timeouts,
+		func(ctx context.Context) (*http.Response, error) {
+			return r.client.Region.PostApiV2Filestorage(ctx, params)
+		},
+	)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Create File Storage",
-			fmt.Sprintf("An error occurred while creating the file storage: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "File Storage", "file storage", err)
 		return
 	}
 	defer fileStorageCreateResponse.Body.Close()
@@ -375,14 +431,11 @@ func (r *FileStorageResource) Create(
 	fileStorage, err := nscale.ReadJSONResponsePointer[regionapi.StorageV2Read](fileStorageCreateResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Create File Storage",
-			fmt.Sprintf("An error occurred while creating the file storage: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "File Storage", "file storage", err)
 		return
 	}
 
-	data.FileStorageModel = NewFileStorageModel(fileStorage)
+	data.setFileStorage(fileStorage)
 	if diagnostics = response.State.Set(ctx, data); diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
@@ -402,11 +455,13 @@ func (r *FileStorageResource) Create(
 		return
 	}
 
-	data.FileStorageModel = NewFileStorageModel(fileStorage)
+	data.setFileStorage(fileStorage)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
 func (r *FileStorageResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[FileStorageResourceModel](ctx, request.State.Get, r.setDefaults)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -427,7 +482,7 @@ func (r *FileStorageResource) Read(ctx context.Context, request resource.ReadReq
 		return
 	}
 
-	data.FileStorageModel = NewFileStorageModel(fileStorage)
+	data.setFileStorage(fileStorage)
 	data.preserveSizeIfUsageRefreshDisabled(previousSize)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
@@ -437,6 +492,10 @@ func (r *FileStorageResource) Update(
 	request resource.UpdateRequest,
 	response *resource.UpdateResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.Plan.GetAttribute)
+
+	defer nscale.ReconcileFailedUpdate(ctx, r.Read, response)
+
 	priorState, diagnostics := nscale.ReadTerraformState[FileStorageResourceModel](
 		ctx,
 		request.State.Get,
@@ -480,10 +539,7 @@ func (r *FileStorageResource) Update(
 
 	fileStorageUpdateResponse, err := r.client.Region.PutApiV2FilestorageFilestorageID(ctx, fileStorageID, params)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Update File Storage",
-			fmt.Sprintf("An error occurred while updating the file storage: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Update, "File Storage", "file storage", err)
 		return
 	}
 	defer fileStorageUpdateResponse.Body.Close()
@@ -492,10 +548,7 @@ func (r *FileStorageResource) Update(
 		fileStorageUpdateResponse,
 	); readErr != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, readErr)
-		response.Diagnostics.AddError(
-			"Failed to Update File Storage",
-			fmt.Sprintf("An error occurred while updating the file storage: %s", readErr),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Update, "File Storage", "file storage", readErr)
 		return
 	}
 
@@ -512,7 +565,7 @@ func (r *FileStorageResource) Update(
 		return
 	}
 
-	data.FileStorageModel = NewFileStorageModel(fileStorage)
+	data.setFileStorage(fileStorage)
 	data.preserveSizeIfUsageRefreshDisabled(priorState.Size)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
@@ -522,6 +575,8 @@ func (r *FileStorageResource) Delete(
 	request resource.DeleteRequest,
 	response *resource.DeleteResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[FileStorageResourceModel](ctx, request.State.Get, r.setDefaults)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -537,21 +592,15 @@ func (r *FileStorageResource) Delete(
 
 	fileStorageDeleteResponse, err := r.client.Region.DeleteApiV2FilestorageFilestorageID(ctx, fileStorageID)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Delete File Storage",
-			fmt.Sprintf("An error occurred while deleting the file storage: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Delete, "File Storage", "file storage", err)
 		return
 	}
 	defer fileStorageDeleteResponse.Body.Close()
 
 	if err = nscale.ReadEmptyResponse(fileStorageDeleteResponse); err != nil {
-		if e, isAPIError := nscale.AsAPIError(err); isAPIError && e.StatusCode != http.StatusNotFound {
+		if !nscale.IsAPIErrorNotFound(err) {
 			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-			response.Diagnostics.AddError(
-				"Failed to Delete File Storage",
-				fmt.Sprintf("An error occurred while deleting the file storage: %s", err),
-			)
+			apidiag.Add(&response.Diagnostics, apidiag.Delete, "File Storage", "file storage", err)
 			return
 		}
 	}
@@ -19,6 +19,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -114,6 +115,20 @@ func (s *GroupDataSource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the group was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the group.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the group.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the group was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 			"provisioning_status": schema.StringAttribute{
@@ -18,8 +18,8 @@ package identity
 
 import (
 	"context"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -31,16 +31,19 @@ import (
 )
 
 type GroupModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	Tags               types.Map    `tfsdk:"tags"`
-	RoleIDs            types.Set    `tfsdk:"role_ids"`
-	ServiceAccountIDs  types.Set    `tfsdk:"service_account_ids"`
-	UserIDs            types.Set    `tfsdk:"user_ids"`
-	Subjects           types.Set    `tfsdk:"subjects"`
-	CreationTime       types.String `tfsdk:"creation_time"`
-	ProvisioningStatus types.String `tfsdk:"provisioning_status"`
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Description        types.String      `tfsdk:"description"`
+	Tags               types.Map         `tfsdk:"tags"`
+	RoleIDs            types.Set         `tfsdk:"role_ids"`
+	ServiceAccountIDs  types.Set         `tfsdk:"service_account_ids"`
+	UserIDs            types.Set         `tfsdk:"user_ids"`
+	Subjects           types.Set         `tfsdk:"subjects"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy          types.String      `tfsdk:"created_by"`
+	ModifiedBy         types.String      `tfsdk:"modified_by"`
+	LastModifiedTime   timetypes.RFC3339 `tfsdk:"last_modified_time"`
+	ProvisioningStatus types.String      `tfsdk:"provisioning_status"`
 }
 
 var SubjectModelAttributeType = types.ObjectType{
@@ -114,7 +117,10 @@ func NewGroupModel(source *identityapi.GroupRead) GroupModel {
 		ServiceAccountIDs:  stringSet(source.Spec.ServiceAccountIDs),
 		UserIDs:            userIDs,
 		Subjects:           subjects,
-		CreationTime:       types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		CreationTime:       timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:          types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:         types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:   timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 		ProvisioningStatus: types.StringValue(string(source.Metadata.ProvisioningStatus)),
 	}
 }
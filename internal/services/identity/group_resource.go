@@ -18,9 +18,9 @@ package identity
 
 import (
 	"context"
-	"fmt"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,7 +32,9 @@ import (
 	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
 	identityids "github.com/unikorn-cloud/identity/pkg/ids"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -40,6 +42,7 @@ var (
 	_ resource.Resource                = &GroupResource{}
 	_ resource.ResourceWithConfigure   = &GroupResource{}
 	_ resource.ResourceWithImportState = &GroupResource{}
+	_ resource.ResourceWithModifyPlan  = &GroupResource{}
 )
 
 type GroupResourceModel struct {
@@ -78,10 +81,13 @@ func groupAdapter() nscale.ResourceAdapter[GroupResourceModel, identityapi.Group
 			return getGroupStatus(ctx, id, client)
 		},
 		ToModel: func(api *identityapi.GroupRead, dst *GroupResourceModel) {
+			priorDescription := dst.Description
 			dst.GroupModel = NewGroupModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
 		},
 		IDFromModel:       func(m GroupResourceModel) string { return m.ID.ValueString() },
 		TimeoutsFromModel: func(m GroupResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:            true,
 	}
 }
 
@@ -162,11 +168,28 @@ func (r *GroupResource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the group was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the group.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the group was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 			"provisioning_status": schema.StringAttribute{
 				MarkdownDescription: "The provisioning status of the group.",
 				Computed:            true,
@@ -211,10 +234,7 @@ func groupCreate(
 		params,
 	)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Create Group",
-			fmt.Sprintf("An error occurred while creating the group: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Group", "group", err)
 		return nil, diagnostics
 	}
 	defer createResponse.Body.Close()
@@ -222,10 +242,7 @@ func groupCreate(
 	group, err := nscale.ReadJSONResponsePointer[identityapi.GroupRead](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Create Group",
-			fmt.Sprintf("An error occurred while creating the group: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Group", "group", err)
 		return nil, diagnostics
 	}
 
@@ -236,6 +253,7 @@ func groupUpdate(
 	ctx context.Context,
 	client *nscale.Client,
 	id string,
+	_ *GroupResourceModel,
 	plan GroupResourceModel,
 ) (string, diag.Diagnostics) {
 	params, diagnostics := plan.NscaleGroupUpdateParams(ctx)
@@ -269,20 +287,14 @@ func groupUpdate(
 		params,
 	)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Update Group",
-			fmt.Sprintf("An error occurred while updating the group: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Group", "group", err)
 		return "", diagnostics
 	}
 	defer updateResponse.Body.Close()
 
 	if err = nscale.ReadEmptyResponse(updateResponse); err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Update Group",
-			fmt.Sprintf("An error occurred while updating the group: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Group", "group", err)
 		return "", diagnostics
 	}
 
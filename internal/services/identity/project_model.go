@@ -18,8 +18,8 @@ package identity
 
 import (
 	"context"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -31,13 +31,16 @@ import (
 )
 
 type ProjectModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	Tags               types.Map    `tfsdk:"tags"`
-	GroupIDs           types.Set    `tfsdk:"group_ids"`
-	CreationTime       types.String `tfsdk:"creation_time"`
-	ProvisioningStatus types.String `tfsdk:"provisioning_status"`
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Description        types.String      `tfsdk:"description"`
+	Tags               types.Map         `tfsdk:"tags"`
+	GroupIDs           types.Set         `tfsdk:"group_ids"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy          types.String      `tfsdk:"created_by"`
+	ModifiedBy         types.String      `tfsdk:"modified_by"`
+	LastModifiedTime   timetypes.RFC3339 `tfsdk:"last_modified_time"`
+	ProvisioningStatus types.String      `tfsdk:"provisioning_status"`
 }
 
 func NewProjectModel(source *identityapi.ProjectRead) ProjectModel {
@@ -56,7 +59,10 @@ func NewProjectModel(source *identityapi.ProjectRead) ProjectModel {
 		// Faithful: the API returns groupIDs as `[]` (never null), so an empty
 		// configured set must round-trip as an empty set, not null.
 		GroupIDs:           types.SetValueMust(types.StringType, groupIDs),
-		CreationTime:       types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		CreationTime:       timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:          types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:         types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:   timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 		ProvisioningStatus: types.StringValue(string(source.Metadata.ProvisioningStatus)),
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	coreapi "github.com/nscaledev/nscale-sdk-go/common"
 	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
@@ -27,6 +28,7 @@ import (
 
 func TestNewProjectModel(t *testing.T) {
 	creationTime := time.Date(2026, time.May, 29, 12, 0, 0, 0, time.UTC)
+	modifiedTime := time.Date(2026, time.June, 2, 9, 30, 0, 0, time.UTC)
 
 	testCases := []struct {
 		name                string
@@ -34,6 +36,9 @@ func TestNewProjectModel(t *testing.T) {
 		expectedDescription types.String
 		expectedTagsNull    bool
 		expectedGroupIDs    []string
+		expectedCreatedBy   types.String
+		expectedModifiedBy  types.String
+		expectedModifiedAt  timetypes.RFC3339
 	}{
 		{
 			name: "full",
@@ -44,6 +49,9 @@ func TestNewProjectModel(t *testing.T) {
 					Description:        new("a description"),
 					OrganizationId:     "org-1",
 					CreationTime:       creationTime,
+					CreatedBy:          new("alice@example.com"),
+					ModifiedBy:         new("bob@example.com"),
+					ModifiedTime:       &modifiedTime,
 					ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned,
 					Tags: &[]coreapi.Tag{
 						{Name: "team", Value: "platform"},
@@ -56,6 +64,9 @@ func TestNewProjectModel(t *testing.T) {
 			expectedDescription: types.StringValue("a description"),
 			expectedTagsNull:    false,
 			expectedGroupIDs:    []string{"group-a", "group-b"},
+			expectedCreatedBy:   types.StringValue("alice@example.com"),
+			expectedModifiedBy:  types.StringValue("bob@example.com"),
+			expectedModifiedAt:  timetypes.NewRFC3339TimeValue(modifiedTime),
 		},
 		{
 			name: "nil description and tags and empty groups",
@@ -74,6 +85,9 @@ func TestNewProjectModel(t *testing.T) {
 			expectedDescription: types.StringNull(),
 			expectedTagsNull:    true,
 			expectedGroupIDs:    []string{},
+			expectedCreatedBy:   types.StringNull(),
+			expectedModifiedBy:  types.StringNull(),
+			expectedModifiedAt:  timetypes.NewRFC3339Null(),
 		},
 	}
 
@@ -97,6 +111,15 @@ func TestNewProjectModel(t *testing.T) {
 			if model.CreationTime.ValueString() != wantCreationTime {
 				t.Errorf("CreationTime = %q, want %q", model.CreationTime.ValueString(), wantCreationTime)
 			}
+			if !model.CreatedBy.Equal(testCase.expectedCreatedBy) {
+				t.Errorf("CreatedBy = %v, want %v", model.CreatedBy, testCase.expectedCreatedBy)
+			}
+			if !model.ModifiedBy.Equal(testCase.expectedModifiedBy) {
+				t.Errorf("ModifiedBy = %v, want %v", model.ModifiedBy, testCase.expectedModifiedBy)
+			}
+			if !model.LastModifiedTime.Equal(testCase.expectedModifiedAt) {
+				t.Errorf("LastModifiedTime = %v, want %v", model.LastModifiedTime, testCase.expectedModifiedAt)
+			}
 
 			groupIDs := setValues(t, model.GroupIDs)
 			assertStringSliceEqual(t, "GroupIDs", groupIDs, testCase.expectedGroupIDs)
@@ -18,9 +18,9 @@ package identity
 
 import (
 	"context"
-	"fmt"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,7 +32,9 @@ import (
 	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
 	identityids "github.com/unikorn-cloud/identity/pkg/ids"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -40,6 +42,7 @@ var (
 	_ resource.Resource                = &ProjectResource{}
 	_ resource.ResourceWithConfigure   = &ProjectResource{}
 	_ resource.ResourceWithImportState = &ProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectResource{}
 )
 
 type ProjectResourceModel struct {
@@ -78,10 +81,13 @@ func projectAdapter() nscale.ResourceAdapter[ProjectResourceModel, identityapi.P
 			return getProjectStatus(ctx, id, client)
 		},
 		ToModel: func(api *identityapi.ProjectRead, dst *ProjectResourceModel) {
+			priorDescription := dst.Description
 			dst.ProjectModel = NewProjectModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
 		},
 		IDFromModel:       func(m ProjectResourceModel) string { return m.ID.ValueString() },
 		TimeoutsFromModel: func(m ProjectResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:            true,
 	}
 }
 
@@ -127,11 +133,28 @@ func (r *ProjectResource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the project was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the project.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the project was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 			"provisioning_status": schema.StringAttribute{
 				MarkdownDescription: "The provisioning status of the project.",
 				Computed:            true,
@@ -176,10 +199,7 @@ func projectCreate(
 		params,
 	)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Create Project",
-			fmt.Sprintf("An error occurred while creating the project: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Project", "project", err)
 		return nil, diagnostics
 	}
 	defer createResponse.Body.Close()
@@ -187,10 +207,7 @@ func projectCreate(
 	project, err := nscale.ReadJSONResponsePointer[identityapi.ProjectRead](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Create Project",
-			fmt.Sprintf("An error occurred while creating the project: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Project", "project", err)
 		return nil, diagnostics
 	}
 
@@ -201,6 +218,7 @@ func projectUpdate(
 	ctx context.Context,
 	client *nscale.Client,
 	id string,
+	_ *ProjectResourceModel,
 	plan ProjectResourceModel,
 ) (string, diag.Diagnostics) {
 	params, diagnostics := plan.NscaleProjectUpdateParams(ctx)
@@ -234,20 +252,14 @@ func projectUpdate(
 		params,
 	)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Update Project",
-			fmt.Sprintf("An error occurred while updating the project: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Project", "project", err)
 		return "", diagnostics
 	}
 	defer updateResponse.Body.Close()
 
 	if err = nscale.ReadEmptyResponse(updateResponse); err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Update Project",
-			fmt.Sprintf("An error occurred while updating the project: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Project", "project", err)
 		return "", diagnostics
 	}
 
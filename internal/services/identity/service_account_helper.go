@@ -0,0 +1,83 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
+	identityids "github.com/unikorn-cloud/identity/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+// getServiceAccount reads a service account of the organization. The API
+// cannot read a single service account, so it is found in the list of them.
+func getServiceAccount(
+	ctx context.Context,
+	id string,
+	client *nscale.Client,
+) (*identityapi.ServiceAccountRead, error) {
+	organizationID, err := identityids.ParseOrganizationID(client.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccountListResponse, err := client.Identity.GetApiV1OrganizationsOrganizationIDServiceaccounts(
+		ctx,
+		organizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer serviceAccountListResponse.Body.Close()
+
+	serviceAccounts, err := nscale.ReadJSONResponseValue[identityapi.ServiceAccounts](serviceAccountListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serviceAccount := range serviceAccounts {
+		if serviceAccount.Metadata.Id == id {
+			return &serviceAccount, nil
+		}
+	}
+
+	err = &nscale.APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("failed to find service account '%s' in the list response", id),
+	}
+
+	return nil, err
+}
+
+// getServiceAccountStatus reads a service account and adapts it to the shared
+// watchers' (resource, ResourceStatus, error) shape.
+func getServiceAccountStatus(
+	ctx context.Context,
+	id string,
+	client *nscale.Client,
+) (*identityapi.ServiceAccountRead, nscale.ResourceStatus, error) {
+	serviceAccount, err := getServiceAccount(ctx, id, client)
+	if err != nil {
+		return nil, nscale.ResourceStatus{}, err
+	}
+
+	return serviceAccount, nscale.StatusFromOrgScoped(&serviceAccount.Metadata), nil
+}
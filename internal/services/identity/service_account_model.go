@@ -0,0 +1,91 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
+)
+
+type ServiceAccountModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Description        types.String      `tfsdk:"description"`
+	GroupIDs           types.Set         `tfsdk:"group_ids"`
+	RotateWhenChanged  types.Map         `tfsdk:"rotate_when_changed"`
+	AccessToken        types.String      `tfsdk:"access_token"`
+	Expiry             timetypes.RFC3339 `tfsdk:"expiry"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy          types.String      `tfsdk:"created_by"`
+	ModifiedBy         types.String      `tfsdk:"modified_by"`
+	LastModifiedTime   timetypes.RFC3339 `tfsdk:"last_modified_time"`
+	ProvisioningStatus types.String      `tfsdk:"provisioning_status"`
+}
+
+// NewServiceAccountModel returns the model of a service account. The access
+// token is only returned when the account is created or its token rotated,
+// so it is null for other reads, and rotate_when_changed, which only
+// Terraform knows, is always null: the caller keeps both from prior state.
+func NewServiceAccountModel(source *identityapi.ServiceAccountRead) ServiceAccountModel {
+	return ServiceAccountModel{
+		ID:                 types.StringValue(source.Metadata.Id),
+		Name:               types.StringValue(source.Metadata.Name),
+		Description:        types.StringPointerValue(source.Metadata.Description),
+		GroupIDs:           stringSet(source.Spec.GroupIDs),
+		RotateWhenChanged:  types.MapNull(types.StringType),
+		AccessToken:        types.StringPointerValue(source.Status.AccessToken),
+		Expiry:             timetypes.NewRFC3339TimeValue(source.Status.Expiry),
+		CreationTime:       timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:          types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:         types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:   timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
+		ProvisioningStatus: types.StringValue(string(source.Metadata.ProvisioningStatus)),
+	}
+}
+
+// NewServiceAccountModelFromCreate returns the model of a service account
+// from the response to creating it or rotating its token, which carries the
+// new access token.
+func NewServiceAccountModelFromCreate(source *identityapi.ServiceAccountCreate) ServiceAccountModel {
+	return NewServiceAccountModel((*identityapi.ServiceAccountRead)(source))
+}
+
+// NscaleServiceAccountWrite produces the body of both the POST and the PUT,
+// which share the ServiceAccountWrite shape.
+func (m *ServiceAccountModel) NscaleServiceAccountWrite(
+	ctx context.Context,
+) (identityapi.ServiceAccountWrite, diag.Diagnostics) {
+	groupIDs, diagnostics := setToStrings(ctx, m.GroupIDs)
+	if diagnostics.HasError() {
+		return identityapi.ServiceAccountWrite{}, diagnostics
+	}
+
+	return identityapi.ServiceAccountWrite{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name:        m.Name.ValueString(),
+			Description: m.Description.ValueStringPointer(),
+		},
+		Spec: identityapi.ServiceAccountSpec{
+			GroupIDs: groupIDs,
+		},
+	}, nil
+}
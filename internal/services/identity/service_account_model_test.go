@@ -0,0 +1,143 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
+)
+
+func TestNewServiceAccountModelFromCreate(t *testing.T) {
+	creationTime := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	expiry := creationTime.AddDate(0, 3, 0)
+
+	source := &identityapi.ServiceAccountCreate{
+		Metadata: coreapi.OrganizationScopedResourceReadMetadata{
+			Id:                 "sa-1",
+			Name:               "pipeline",
+			OrganizationId:     "org-1",
+			CreationTime:       creationTime,
+			ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned,
+		},
+		Spec: identityapi.ServiceAccountSpec{
+			GroupIDs: []string{"group-a"},
+		},
+		Status: identityapi.ServiceAccountStatus{
+			AccessToken: new("token"),
+			Expiry:      expiry,
+		},
+	}
+
+	model := NewServiceAccountModelFromCreate(source)
+
+	if model.ID.ValueString() != "sa-1" {
+		t.Errorf("ID = %q, want %q", model.ID.ValueString(), "sa-1")
+	}
+	if model.AccessToken.ValueString() != "token" {
+		t.Errorf("AccessToken = %q, want %q", model.AccessToken.ValueString(), "token")
+	}
+	if got, _ := model.Expiry.ValueRFC3339Time(); !got.Equal(expiry) {
+		t.Errorf("Expiry = %v, want %v", got, expiry)
+	}
+	if !model.Description.IsNull() {
+		t.Errorf("Description = %v, want null", model.Description)
+	}
+	if !model.RotateWhenChanged.IsNull() {
+		t.Errorf("RotateWhenChanged = %v, want null", model.RotateWhenChanged)
+	}
+	assertStringSliceEqual(t, "GroupIDs", setValues(t, model.GroupIDs), []string{"group-a"})
+}
+
+func TestNewServiceAccountModelWithoutToken(t *testing.T) {
+	source := &identityapi.ServiceAccountRead{
+		Metadata: coreapi.OrganizationScopedResourceReadMetadata{
+			Id:                 "sa-1",
+			Name:               "pipeline",
+			OrganizationId:     "org-1",
+			ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned,
+		},
+		Spec: identityapi.ServiceAccountSpec{
+			GroupIDs: []string{},
+		},
+	}
+
+	model := NewServiceAccountModel(source)
+
+	// Reads do not return the token, which the resource keeps from state.
+	if !model.AccessToken.IsNull() {
+		t.Errorf("AccessToken = %v, want null", model.AccessToken)
+	}
+	if model.GroupIDs.IsNull() {
+		t.Errorf("GroupIDs is null, want empty set")
+	}
+}
+
+func TestSetServiceAccountKeepsPriorValues(t *testing.T) {
+	data := ServiceAccountResourceModel{
+		ServiceAccountModel: ServiceAccountModel{
+			Description: types.StringValue(""),
+			RotateWhenChanged: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"rotation": types.StringValue("1"),
+			}),
+			AccessToken: types.StringValue("token"),
+		},
+	}
+
+	data.setServiceAccount(NewServiceAccountModel(&identityapi.ServiceAccountRead{
+		Metadata: coreapi.OrganizationScopedResourceReadMetadata{Id: "sa-1", Name: "pipeline"},
+	}))
+
+	if data.Description.IsNull() || data.Description.ValueString() != "" {
+		t.Errorf("Description = %v, want the prior empty string", data.Description)
+	}
+	if len(data.RotateWhenChanged.Elements()) != 1 {
+		t.Errorf("RotateWhenChanged = %v, want the prior value", data.RotateWhenChanged)
+	}
+	if data.AccessToken.ValueString() != "token" {
+		t.Errorf("AccessToken = %q, want the prior token", data.AccessToken.ValueString())
+	}
+}
+
+// TestServiceAccountWriteGroupIDsSerialized guards that the required groupIDs
+// is sent as an empty array, not omitted, when the account is in no groups.
+func TestServiceAccountWriteGroupIDsSerialized(t *testing.T) {
+	model := ServiceAccountModel{
+		Name:     types.StringValue("pipeline"),
+		GroupIDs: types.SetNull(types.StringType),
+	}
+
+	params, diagnostics := model.NscaleServiceAccountWrite(t.Context())
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+
+	encoded, err := json.Marshal(params.Spec)
+	if err != nil {
+		t.Fatalf("failed to marshal spec: %v", err)
+	}
+
+	if body := string(encoded); !strings.Contains(body, `"groupIDs":[]`) {
+		t.Errorf("marshalled spec missing empty groupIDs array: %s", body)
+	}
+}
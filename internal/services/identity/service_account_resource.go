@@ -0,0 +1,587 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	identityapi "github.com/nscaledev/nscale-sdk-go/identity"
+	identityids "github.com/unikorn-cloud/identity/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = &ServiceAccountResource{}
+	_ resource.ResourceWithImportState = &ServiceAccountResource{}
+	_ resource.ResourceWithModifyPlan  = &ServiceAccountResource{}
+)
+
+type ServiceAccountResourceModel struct {
+	ServiceAccountModel
+
+	Timeouts tftimeouts.Value `tfsdk:"timeouts"`
+}
+
+// ServiceAccountResource is not built on the generic base: the access token
+// is only returned when the account is created or its token rotated, so it
+// has to be carried from those responses into state, as the object storage
+// access key's secret is.
+type ServiceAccountResource struct {
+	client *nscale.Client
+}
+
+func NewServiceAccountResource() resource.Resource {
+	return &ServiceAccountResource{}
+}
+
+func (r *ServiceAccountResource) Configure(
+	ctx context.Context,
+	request resource.ConfigureRequest,
+	response *resource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceAccountResource) Metadata(
+	ctx context.Context,
+	request resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_identity_service_account"
+}
+
+func (r *ServiceAccountResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Service Account. A non-human identity of the organization with a long-lived access token, for pipelines to authenticate with. The account has the roles of the groups it is a member of, in the projects those groups are granted, so scope it to a project with a group of that project. The access token is returned only when the account is created or the token is rotated; protect Terraform state accordingly.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the service account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service account.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the service account.",
+				Optional:            true,
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "The set of identifiers of the groups the service account is a member of, which grant it their roles in their projects. Defaults to none. Manage membership either here or in the `service_account_ids` of `nscale_identity_group`, not both, or each overwrites the other.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"rotate_when_changed": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that rotate the access token when they change, such as a date to rotate it on a schedule with the `time_rotating` resource. The old token stops working once the new one is issued.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The long-lived access token of the service account, for pipelines to authenticate with. Returned only when the account is created or the token is rotated, so it is null after import. Null when the provider is configured with `disallow_sensitive_in_state`, which also fails plans that create the account or rotate its token.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiry": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the access token expires. Rotate the token before then.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the service account was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the service account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the service account.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the service account was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"provisioning_status": schema.StringAttribute{
+				MarkdownDescription: "The provisioning status of the service account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan marks the access token and its expiry unknown when
+// rotate_when_changed changes, as the update rotates the token. Plans that
+// create the account or rotate its token fail when the provider disallows
+// sensitive values in state, since the token is only returned then.
+func (r *ServiceAccountResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	if request.State.Raw.IsNull() {
+		if r.client != nil && r.client.DisallowSensitiveInState {
+			nscale.AddSensitiveInStateError(&response.Diagnostics, "the access token of a new service account", "")
+		}
+		return
+	}
+
+	var plan, state types.Map
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("rotate_when_changed"), &plan)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("rotate_when_changed"), &state)...)
+	if response.Diagnostics.HasError() || plan.Equal(state) {
+		return
+	}
+
+	if r.client != nil && r.client.DisallowSensitiveInState {
+		nscale.AddSensitiveInStateError(&response.Diagnostics, "the rotated access token of a service account", "")
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("access_token"), types.StringUnknown())...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("expiry"), timetypes.NewRFC3339Unknown())...)
+}
+
+// setServiceAccount sets the attributes read from the service account, keeping
+// the prior form of an empty description and what the API does not return.
+func (m *ServiceAccountResourceModel) setServiceAccount(model ServiceAccountModel) {
+	model.Description = tftypes.StringWithPriorEmpty(model.Description, m.Description)
+	model.RotateWhenChanged = m.RotateWhenChanged
+	if model.AccessToken.IsNull() {
+		model.AccessToken = m.AccessToken
+	}
+	m.ServiceAccountModel = model
+}
+
+func (r *ServiceAccountResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[ServiceAccountResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	params, diagnostics := data.NscaleServiceAccountWrite(ctx)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	organizationID, ok := nscale.ParseID(
+		r.client.OrganizationID,
+		"Organization",
+		identityids.ParseOrganizationID,
+		&response.Diagnostics,
+	)
+	if !ok {
+		return
+	}
+
+	createResponse, err := r.client.Identity.PostApiV1OrganizationsOrganizationIDServiceaccounts(
+		ctx,
+		organizationID,
+		params,
+	)
+	if err != nil {
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Service Account", "service account", err)
+		return
+	}
+	defer createResponse.Body.Close()
+
+	created, err := nscale.ReadJSONResponsePointer[identityapi.ServiceAccountCreate](createResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Service Account", "service account", err)
+		return
+	}
+
+	if created.Status.AccessToken == nil || *created.Status.AccessToken == "" {
+		response.Diagnostics.AddError(
+			"Missing Access Token in Create Response",
+			"The Nscale API did not return an access token in the create response. The service account "+
+				"has been created, but its token cannot be retrieved later. Set `rotate_when_changed` to "+
+				"issue a new token, or delete the service account and contact Nscale support.",
+		)
+		return
+	}
+
+	// Persist what we have to state immediately, so a watcher failure later
+	// doesn't strand the access token.
+	data.setServiceAccount(NewServiceAccountModelFromCreate(created))
+	if diagnostics = response.State.Set(ctx, data); diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	stateWatcher := nscale.CreateStateWatcher[identityapi.ServiceAccountRead]{
+		ResourceTitle: "Service Account",
+		ResourceName:  "service account",
+		GetFunc: func(ctx context.Context) (*identityapi.ServiceAccountRead, nscale.ResourceStatus, error) {
+			return getServiceAccountStatus(ctx, created.Metadata.Id, r.client)
+		},
+	}
+
+	settled, ok := stateWatcher.Wait(ctx, data.Timeouts, response)
+	if !ok {
+		return
+	}
+
+	data.setServiceAccount(NewServiceAccountModel(settled))
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ServiceAccountResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[ServiceAccountResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	resourceReader := nscale.ResourceReader[identityapi.ServiceAccountRead]{
+		ResourceTitle: "Service Account",
+		ResourceName:  "service account",
+		GetFunc: func(ctx context.Context, id string) (*identityapi.ServiceAccountRead, nscale.ResourceStatus, error) {
+			return getServiceAccountStatus(ctx, id, r.client)
+		},
+	}
+
+	serviceAccount, ok := resourceReader.Read(ctx, data.ID.ValueString(), response)
+	if !ok {
+		return
+	}
+
+	data.setServiceAccount(NewServiceAccountModel(serviceAccount))
+	data.AccessToken = r.client.SensitiveValue(data.AccessToken)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// Update writes any change to the name, description or groups of the service
+// account, and then rotates its token when rotate_when_changed changed.
+func (r *ServiceAccountResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	plan, diagnostics := nscale.ReadTerraformState[ServiceAccountResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	state, diagnostics := nscale.ReadTerraformState[ServiceAccountResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	id := state.ID.ValueString()
+
+	organizationID, ok := nscale.ParseID(
+		r.client.OrganizationID,
+		"Organization",
+		identityids.ParseOrganizationID,
+		&response.Diagnostics,
+	)
+	if !ok {
+		return
+	}
+
+	serviceAccountID, ok := nscale.ParseID(id, "Service Account", identityids.ParseServiceAccountID, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	data := plan
+	data.AccessToken = state.AccessToken
+	data.Expiry = state.Expiry
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) ||
+		!plan.GroupIDs.Equal(state.GroupIDs) {
+		updated, ok := r.updateServiceAccount(ctx, organizationID, serviceAccountID, plan, response)
+		if !ok {
+			return
+		}
+
+		data.setServiceAccount(NewServiceAccountModel(updated))
+	}
+
+	switch {
+	case !plan.RotateWhenChanged.Equal(state.RotateWhenChanged):
+		rotated, ok := r.rotateServiceAccount(ctx, organizationID, serviceAccountID, &response.Diagnostics)
+		if !ok {
+			return
+		}
+
+		data.setServiceAccount(NewServiceAccountModelFromCreate(rotated))
+	case data.LastModifiedTime.IsUnknown():
+		// Only the timeouts changed, so read back what the plan left unknown.
+		serviceAccount, err := getServiceAccount(ctx, id, r.client)
+		if err != nil {
+			apidiag.Add(&response.Diagnostics, apidiag.Read, "Service Account", "service account", err)
+			return
+		}
+
+		data.setServiceAccount(NewServiceAccountModel(serviceAccount))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// updateServiceAccount writes the plan's name, description and groups to the
+// service account, and waits for the write to be observed.
+func (r *ServiceAccountResource) updateServiceAccount(
+	ctx context.Context,
+	organizationID identityapi.OrganizationIDParameter,
+	serviceAccountID identityapi.ServiceAccountIDParameter,
+	plan ServiceAccountResourceModel,
+	response *resource.UpdateResponse,
+) (*identityapi.ServiceAccountRead, bool) {
+	params, diagnostics := plan.NscaleServiceAccountWrite(ctx)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return nil, false
+	}
+
+	// Tag the update so the watcher can confirm the PUT has propagated through
+	// the cache-backed API before reading back a terminal status.
+	operationTagKey := nscale.WriteOperationTag(&params.Metadata)
+
+	updateResponse, err := r.client.Identity.PutApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID(
+		ctx,
+		organizationID,
+		serviceAccountID,
+		params,
+	)
+	if err != nil {
+		apidiag.Add(&response.Diagnostics, apidiag.Update, "Service Account", "service account", err)
+		return nil, false
+	}
+	defer updateResponse.Body.Close()
+
+	if err = nscale.ReadEmptyResponse(updateResponse); err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&response.Diagnostics, apidiag.Update, "Service Account", "service account", err)
+		return nil, false
+	}
+
+	stateWatcher := nscale.UpdateStateWatcher[identityapi.ServiceAccountRead]{
+		ResourceTitle: "Service Account",
+		ResourceName:  "service account",
+		GetFunc: func(ctx context.Context) (*identityapi.ServiceAccountRead, nscale.ResourceStatus, error) {
+			return getServiceAccountStatus(ctx, serviceAccountID.String(), r.client)
+		},
+	}
+
+	return stateWatcher.Wait(ctx, operationTagKey, plan.Timeouts, response)
+}
+
+// rotateServiceAccount issues a new access token for the service account,
+// which revokes the old one.
+func (r *ServiceAccountResource) rotateServiceAccount(
+	ctx context.Context,
+	organizationID identityapi.OrganizationIDParameter,
+	serviceAccountID identityapi.ServiceAccountIDParameter,
+	diagnostics *diag.Diagnostics,
+) (*identityapi.ServiceAccountCreate, bool) {
+	rotateResponse, err := r.client.Identity.PostApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountIDRotate(
+		ctx,
+		organizationID,
+		serviceAccountID,
+	)
+	if err != nil {
+		apidiag.Add(diagnostics, apidiag.Update, "Service Account", "service account's access token", err)
+		return nil, false
+	}
+	defer rotateResponse.Body.Close()
+
+	rotated, err := nscale.ReadJSONResponsePointer[identityapi.ServiceAccountCreate](rotateResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(diagnostics, apidiag.Update, "Service Account", "service account's access token", err)
+		return nil, false
+	}
+
+	if rotated.Status.AccessToken == nil || *rotated.Status.AccessToken == "" {
+		diagnostics.AddError(
+			"Missing Access Token in Rotate Response",
+			"The Nscale API did not return an access token when rotating it, so the new token cannot be "+
+				"retrieved. Change `rotate_when_changed` again to issue another.",
+		)
+		return nil, false
+	}
+
+	return rotated, true
+}
+
+func (r *ServiceAccountResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[ServiceAccountResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	organizationID, ok := nscale.ParseID(
+		r.client.OrganizationID,
+		"Organization",
+		identityids.ParseOrganizationID,
+		&response.Diagnostics,
+	)
+	if !ok {
+		return
+	}
+
+	serviceAccountID, ok := nscale.ParseID(id, "Service Account", identityids.ParseServiceAccountID, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	deleteResponse, err := r.client.Identity.DeleteApiV1OrganizationsOrganizationIDServiceaccountsServiceAccountID(
+		ctx,
+		organizationID,
+		serviceAccountID,
+	)
+	if err != nil {
+		apidiag.Add(&response.Diagnostics, apidiag.Delete, "Service Account", "service account", err)
+		return
+	}
+	defer deleteResponse.Body.Close()
+
+	if err = nscale.ReadEmptyResponse(deleteResponse); err != nil {
+		if !nscale.IsAPIErrorNotFound(err) {
+			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+			apidiag.Add(&response.Diagnostics, apidiag.Delete, "Service Account", "service account", err)
+			return
+		}
+	}
+
+	stateWatcher := nscale.DeleteStateWatcher{
+		ResourceTitle: "Service Account",
+		ResourceName:  "service account",
+		GetFunc: func(ctx context.Context) (any, nscale.ResourceStatus, error) {
+			return getServiceAccountStatus(ctx, id, r.client)
+		},
+	}
+
+	stateWatcher.Wait(ctx, data.Timeouts, response)
+}
+
+// ImportState imports a service account by its ID, warning that its access
+// token cannot be recovered through import.
+func (r *ServiceAccountResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.AddWarning(
+		"Access Token Cannot Be Imported",
+		"The Nscale API only returns the access token of a service account when it is created or rotated. "+
+			"After import, `access_token` will be null in state. To issue a new token, set or change "+
+			"`rotate_when_changed`.",
+	)
+}
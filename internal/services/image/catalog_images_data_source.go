@@ -0,0 +1,312 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+var _ datasource.DataSourceWithConfigure = &CatalogImagesDataSource{}
+
+type CatalogImagesDataSource struct {
+	client *nscale.Client
+}
+
+func NewCatalogImagesDataSource() datasource.DataSource {
+	return &CatalogImagesDataSource{}
+}
+
+func (s *CatalogImagesDataSource) Configure(
+	ctx context.Context,
+	request datasource.ConfigureRequest,
+	response *datasource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	s.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.RegionAPIV2, "Catalog images")...)
+}
+
+func (s *CatalogImagesDataSource) Metadata(
+	ctx context.Context,
+	request datasource.MetadataRequest,
+	response *datasource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_catalog_images"
+}
+
+func (s *CatalogImagesDataSource) Schema(
+	ctx context.Context,
+	request datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Lists the ready images available to the organization in a region, such as the platform's CUDA and PyTorch images, optionally filtered. Images are sorted newest first, so `images[0]` is the latest image that matches.",
+		Attributes: map[string]schema.Attribute{
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the region to list images in. If not specified, this defaults to the region ID configured in the provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression, in [RE2 syntax](https://github.com/google/re2/wiki/Syntax), that image names must match.",
+				Optional:            true,
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "The CPU architecture images must be built for. Possible values are `x86_64` and `aarch64`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(regionapi.ArchitectureX8664),
+						string(regionapi.ArchitectureAarch64),
+					),
+				},
+			},
+			"virtualization": schema.StringAttribute{
+				MarkdownDescription: "The kind of machine images must boot on. Possible values are `virtualized` and `baremetal`. Images built for any kind of machine always match.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(regionapi.ImageVirtualizationVirtualized),
+						string(regionapi.ImageVirtualizationBaremetal),
+					),
+				},
+			},
+			"os_distro": schema.StringAttribute{
+				MarkdownDescription: "The operating system distribution images must run, such as `ubuntu`.",
+				Optional:            true,
+			},
+			"os_version": schema.StringAttribute{
+				MarkdownDescription: "The operating system version images must run. A version matches itself and the more specific versions within it, so `24` matches `24.04`.",
+				Optional:            true,
+			},
+			"gpu_vendor": schema.StringAttribute{
+				MarkdownDescription: "The GPU vendor whose driver images must include. Possible values are `NVIDIA` and `AMD`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(regionapi.GpuVendorNVIDIA),
+						string(regionapi.GpuVendorAMD),
+					),
+				},
+			},
+			"software_versions": schema.MapAttribute{
+				MarkdownDescription: "The preinstalled software images must include, by name, and its version. A version matches itself and the more specific versions within it, compared by whole dot-separated components and ignoring a leading `v`, so `2.4` matches `2.4.1` but not `2.40`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"images": schema.ListNestedAttribute{
+				MarkdownDescription: "The images that match, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "A unique identifier for the image.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the image.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the image.",
+							Computed:            true,
+						},
+						"architecture": schema.StringAttribute{
+							MarkdownDescription: "The CPU architecture the image is built for.",
+							Computed:            true,
+						},
+						"virtualization": schema.StringAttribute{
+							MarkdownDescription: "The kind of machine the image boots on: `virtualized`, `baremetal` or `any`.",
+							Computed:            true,
+						},
+						"size_gib": schema.Int64Attribute{
+							MarkdownDescription: "The minimum disk size needed to use the image, in gibibytes.",
+							Computed:            true,
+						},
+						"os": schema.SingleNestedAttribute{
+							MarkdownDescription: "The operating system the image runs.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"distro": schema.StringAttribute{
+									MarkdownDescription: "The distribution name, such as `ubuntu`.",
+									Computed:            true,
+								},
+								"family": schema.StringAttribute{
+									MarkdownDescription: "The family of the operating system, which typically defines its package format.",
+									Computed:            true,
+								},
+								"kernel": schema.StringAttribute{
+									MarkdownDescription: "The kernel type.",
+									Computed:            true,
+								},
+								"version": schema.StringAttribute{
+									MarkdownDescription: "The version of the operating system, such as `24.04`.",
+									Computed:            true,
+								},
+								"codename": schema.StringAttribute{
+									MarkdownDescription: "The code name of the release, such as `noble`.",
+									Computed:            true,
+								},
+								"variant": schema.StringAttribute{
+									MarkdownDescription: "The variant of the release, such as `server`.",
+									Computed:            true,
+								},
+							},
+						},
+						"gpu": schema.SingleNestedAttribute{
+							MarkdownDescription: "The GPU driver installed in the image, if any.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"vendor": schema.StringAttribute{
+									MarkdownDescription: "The GPU vendor.",
+									Computed:            true,
+								},
+								"driver": schema.StringAttribute{
+									MarkdownDescription: "The driver version, which is vendor specific.",
+									Computed:            true,
+								},
+								"models": schema.ListAttribute{
+									MarkdownDescription: "The GPU models the driver supports.",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+							},
+						},
+						"software_versions": schema.MapAttribute{
+							MarkdownDescription: "The versions of the software preinstalled in the image, by name.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"creation_time": schema.StringAttribute{
+							MarkdownDescription: "The timestamp when the image was created.",
+							CustomType:          timetypes.RFC3339Type{},
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *CatalogImagesDataSource) setDefaultRegionID(data *CatalogImagesModel) {
+	if data.RegionID.ValueString() == "" {
+		data.RegionID = types.StringValue(s.client.RegionID)
+	}
+}
+
+func (s *CatalogImagesDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[CatalogImagesModel](ctx, request.Config.Get, s.setDefaultRegionID)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	if data.RegionID.ValueString() == "" {
+		response.Diagnostics.AddError(
+			"Missing Region ID",
+			"A region ID is required to list images. Either set `region_id` on the data source or configure `region_id` on the provider.",
+		)
+		return
+	}
+
+	regionID, ok := nscale.ParseID(data.RegionID.ValueString(), "Region", regionids.ParseRegionID, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	filter := catalogImageFilter{
+		architecture:   data.Architecture.ValueString(),
+		virtualization: data.Virtualization.ValueString(),
+		osDistro:       data.OSDistro.ValueString(),
+		osVersion:      data.OSVersion.ValueString(),
+		gpuVendor:      data.GPUVendor.ValueString(),
+	}
+
+	if nameRegex := data.NameRegex.ValueString(); nameRegex != "" {
+		compiled, err := regexp.Compile(nameRegex)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("The name regex could not be compiled: %s", err),
+			)
+			return
+		}
+		filter.nameRegex = compiled
+	}
+
+	if diagnostics = data.SoftwareVersions.ElementsAs(ctx, &filter.softwareVersions, false); diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	images, err := s.client.ListAvailableImages(ctx, regionID)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		response.Diagnostics.AddError(
+			"Failed to Read Catalog Images",
+			fmt.Sprintf("An error occurred while listing images: %s", err),
+		)
+		return
+	}
+
+	matched := filterCatalogImages(images, &filter)
+
+	values := make([]attr.Value, 0, len(matched))
+	for i := range matched {
+		values = append(values, NewCatalogImageModel(&matched[i]))
+	}
+
+	data.Images = types.ListValueMust(CatalogImageModelAttributeType, values)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccCatalogImagesDataSource_basic lists the provider region's images. It
+// is a read-only lookup and provisions nothing.
+func TestAccCatalogImagesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nscale_catalog_images" "test" {
+  architecture = "x86_64"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nscale_catalog_images.test", "region_id"),
+					resource.TestCheckResourceAttrSet("data.nscale_catalog_images.test", "images.0.id"),
+					resource.TestCheckResourceAttr("data.nscale_catalog_images.test", "images.0.architecture", "x86_64"),
+				),
+			},
+		},
+	})
+}
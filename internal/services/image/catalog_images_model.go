@@ -0,0 +1,212 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+)
+
+type CatalogImagesModel struct {
+	RegionID         types.String `tfsdk:"region_id"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	Architecture     types.String `tfsdk:"architecture"`
+	Virtualization   types.String `tfsdk:"virtualization"`
+	OSDistro         types.String `tfsdk:"os_distro"`
+	OSVersion        types.String `tfsdk:"os_version"`
+	GPUVendor        types.String `tfsdk:"gpu_vendor"`
+	SoftwareVersions types.Map    `tfsdk:"software_versions"`
+	Images           types.List   `tfsdk:"images"`
+}
+
+// catalogImageFilter is the parsed form of the model's filter attributes.
+type catalogImageFilter struct {
+	nameRegex        *regexp.Regexp
+	architecture     string
+	virtualization   string
+	osDistro         string
+	osVersion        string
+	gpuVendor        string
+	softwareVersions map[string]string
+}
+
+// matches reports whether image passes every filter that is set.
+func (f *catalogImageFilter) matches(image *regionapi.Image) bool {
+	spec := &image.Spec
+
+	if f.nameRegex != nil && !f.nameRegex.MatchString(image.Metadata.Name) {
+		return false
+	}
+
+	if f.architecture != "" && string(spec.Architecture) != f.architecture {
+		return false
+	}
+
+	// An image for any virtualization type boots on either kind of machine.
+	if f.virtualization != "" && spec.Virtualization != regionapi.ImageVirtualizationAny &&
+		string(spec.Virtualization) != f.virtualization {
+		return false
+	}
+
+	if f.osDistro != "" && spec.Os.Distro != f.osDistro {
+		return false
+	}
+
+	if f.osVersion != "" && !nscale.VersionHasPrefix(spec.Os.Version, f.osVersion) {
+		return false
+	}
+
+	if f.gpuVendor != "" && (spec.Gpu == nil || string(spec.Gpu.Vendor) != f.gpuVendor) {
+		return false
+	}
+
+	for name, want := range f.softwareVersions {
+		if spec.SoftwareVersions == nil {
+			return false
+		}
+
+		version, ok := (*spec.SoftwareVersions)[name]
+		if !ok || !nscale.VersionHasPrefix(version, want) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterCatalogImages returns the images that match filter, newest first.
+func filterCatalogImages(images []regionapi.Image, filter *catalogImageFilter) []regionapi.Image {
+	var matched []regionapi.Image
+
+	for i := range images {
+		if filter.matches(&images[i]) {
+			matched = append(matched, images[i])
+		}
+	}
+
+	slices.SortStableFunc(matched, func(a, b regionapi.Image) int {
+		if c := b.Metadata.CreationTime.Compare(a.Metadata.CreationTime); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Metadata.Name, b.Metadata.Name)
+	})
+
+	return matched
+}
+
+var CatalogImageModelAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                types.StringType,
+		"name":              types.StringType,
+		"description":       types.StringType,
+		"architecture":      types.StringType,
+		"virtualization":    types.StringType,
+		"size_gib":          types.Int64Type,
+		"os":                CatalogImageOSModelAttributeType,
+		"gpu":               CatalogImageGPUModelAttributeType,
+		"software_versions": types.MapType{ElemType: types.StringType},
+		"creation_time":     timetypes.RFC3339Type{},
+	},
+}
+
+var CatalogImageOSModelAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"distro":   types.StringType,
+		"family":   types.StringType,
+		"kernel":   types.StringType,
+		"version":  types.StringType,
+		"codename": types.StringType,
+		"variant":  types.StringType,
+	},
+}
+
+var CatalogImageGPUModelAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"vendor": types.StringType,
+		"driver": types.StringType,
+		"models": types.ListType{ElemType: types.StringType},
+	},
+}
+
+func NewCatalogImageModel(source *regionapi.Image) types.Object {
+	spec := &source.Spec
+
+	operatingSystem := types.ObjectValueMust(
+		CatalogImageOSModelAttributeType.AttrTypes,
+		map[string]attr.Value{
+			"distro":   types.StringValue(spec.Os.Distro),
+			"family":   types.StringValue(spec.Os.Family),
+			"kernel":   types.StringValue(string(spec.Os.Kernel)),
+			"version":  types.StringValue(spec.Os.Version),
+			"codename": types.StringPointerValue(spec.Os.Codename),
+			"variant":  types.StringPointerValue(spec.Os.Variant),
+		},
+	)
+
+	gpu := types.ObjectNull(CatalogImageGPUModelAttributeType.AttrTypes)
+	if spec.Gpu != nil {
+		var models []attr.Value
+		if spec.Gpu.Models != nil {
+			for _, model := range *spec.Gpu.Models {
+				models = append(models, types.StringValue(model))
+			}
+		}
+
+		gpu = types.ObjectValueMust(
+			CatalogImageGPUModelAttributeType.AttrTypes,
+			map[string]attr.Value{
+				"vendor": types.StringValue(string(spec.Gpu.Vendor)),
+				"driver": types.StringValue(spec.Gpu.Driver),
+				"models": tftypes.NullableListValueMust(types.StringType, models),
+			},
+		)
+	}
+
+	softwareVersions := types.MapNull(types.StringType)
+	if spec.SoftwareVersions != nil {
+		versions := make(map[string]attr.Value, len(*spec.SoftwareVersions))
+		for name, version := range *spec.SoftwareVersions {
+			versions[name] = types.StringValue(version)
+		}
+		softwareVersions = types.MapValueMust(types.StringType, versions)
+	}
+
+	return types.ObjectValueMust(
+		CatalogImageModelAttributeType.AttrTypes,
+		map[string]attr.Value{
+			"id":                types.StringValue(source.Metadata.Id),
+			"name":              types.StringValue(source.Metadata.Name),
+			"description":       types.StringPointerValue(source.Metadata.Description),
+			"architecture":      types.StringValue(string(spec.Architecture)),
+			"virtualization":    types.StringValue(string(spec.Virtualization)),
+			"size_gib":          types.Int64Value(int64(spec.SizeGiB)),
+			"os":                operatingSystem,
+			"gpu":               gpu,
+			"software_versions": softwareVersions,
+			"creation_time":     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		},
+	)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+func testImage(name string, created time.Time, softwareVersions regionapi.SoftwareVersions) regionapi.Image {
+	return regionapi.Image{
+		Metadata: coreapi.StaticResourceMetadata{Id: name, Name: name, CreationTime: created},
+		Spec: regionapi.ImageSpec{
+			Architecture:     regionapi.ArchitectureX8664,
+			Virtualization:   regionapi.ImageVirtualizationAny,
+			Os:               regionapi.ImageOS{Distro: "ubuntu", Version: "24.04"},
+			Gpu:              &regionapi.ImageGpu{Vendor: regionapi.GpuVendorNVIDIA, Driver: "570"},
+			SoftwareVersions: &softwareVersions,
+		},
+	}
+}
+
+func TestFilterCatalogImages(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2026, time.January, n, 0, 0, 0, 0, time.UTC) }
+
+	images := []regionapi.Image{
+		testImage("pytorch-2-4-old", day(1), regionapi.SoftwareVersions{"pytorch": "v2.4.0", "cuda": "12.4"}),
+		testImage("pytorch-2-4-new", day(3), regionapi.SoftwareVersions{"pytorch": "2.4.1", "cuda": "12.6"}),
+		testImage("pytorch-2-40", day(4), regionapi.SoftwareVersions{"pytorch": "2.40.0"}),
+		testImage("plain", day(2), nil),
+	}
+
+	testCases := []struct {
+		name   string
+		filter catalogImageFilter
+		want   []string
+	}{
+		{
+			name:   "no filter lists newest first",
+			filter: catalogImageFilter{},
+			want:   []string{"pytorch-2-40", "pytorch-2-4-new", "plain", "pytorch-2-4-old"},
+		},
+		{
+			name:   "software version prefix",
+			filter: catalogImageFilter{softwareVersions: map[string]string{"pytorch": "2.4"}},
+			want:   []string{"pytorch-2-4-new", "pytorch-2-4-old"},
+		},
+		{
+			name:   "every software version must match",
+			filter: catalogImageFilter{softwareVersions: map[string]string{"pytorch": "2.4", "cuda": "12.6"}},
+			want:   []string{"pytorch-2-4-new"},
+		},
+		{
+			name:   "name regex",
+			filter: catalogImageFilter{nameRegex: regexp.MustCompile(`^plain$`)},
+			want:   []string{"plain"},
+		},
+		{
+			name:   "any virtualization matches a specific one",
+			filter: catalogImageFilter{virtualization: "baremetal", osVersion: "24"},
+			want:   []string{"pytorch-2-40", "pytorch-2-4-new", "plain", "pytorch-2-4-old"},
+		},
+		{
+			name:   "other GPU vendor",
+			filter: catalogImageFilter{gpuVendor: "AMD"},
+			want:   nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var got []string
+			for _, image := range filterCatalogImages(images, &testCase.filter) {
+				got = append(got, image.Metadata.Name)
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Fatalf("filterCatalogImages() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
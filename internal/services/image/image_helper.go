@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+// getImage reads an image of the organization in a region. The API cannot read
+// a single image, so it is found in the list of the organization's images.
+func getImage(ctx context.Context, client *nscale.Client, rawRegionID, id string) (*regionImage, error) {
+	regionID, err := regionids.ParseRegionID(rawRegionID)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := regionapi.GetApiV2RegionsRegionIDImagesParamsScopeOwned
+	params := &regionapi.GetApiV2RegionsRegionIDImagesParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{client.OrganizationID},
+		Scope:          &scope,
+	}
+
+	imageListResponse, err := client.Region.GetApiV2RegionsRegionIDImages(ctx, regionID, params)
+	if err != nil {
+		return nil, err
+	}
+	defer imageListResponse.Body.Close()
+
+	images, err := nscale.ReadJSONResponseValue[regionapi.Images](imageListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, image := range images {
+		if image.Metadata.Id == id {
+			return &regionImage{Image: image, RegionID: rawRegionID}, nil
+		}
+	}
+
+	err = &nscale.APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("failed to find image '%s' in the list response", id),
+	}
+
+	return nil, err
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+type ImageModel struct {
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	SourceURL        types.String      `tfsdk:"source_url"`
+	Architecture     types.String      `tfsdk:"architecture"`
+	Virtualization   types.String      `tfsdk:"virtualization"`
+	OS               types.Object      `tfsdk:"os"`
+	GPU              types.Object      `tfsdk:"gpu"`
+	SoftwareVersions types.Map         `tfsdk:"software_versions"`
+	SizeGiB          types.Int64       `tfsdk:"size_gib"`
+	State            types.String      `tfsdk:"state"`
+	RegionID         types.String      `tfsdk:"region_id"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
+}
+
+type ImageOSModel struct {
+	Distro   types.String `tfsdk:"distro"`
+	Family   types.String `tfsdk:"family"`
+	Kernel   types.String `tfsdk:"kernel"`
+	Version  types.String `tfsdk:"version"`
+	Codename types.String `tfsdk:"codename"`
+	Variant  types.String `tfsdk:"variant"`
+}
+
+type ImageGPUModel struct {
+	Vendor types.String `tfsdk:"vendor"`
+	Driver types.String `tfsdk:"driver"`
+	Models types.List   `tfsdk:"models"`
+}
+
+// regionImage is an image with the region it is in, which the API does not
+// return with it.
+type regionImage struct {
+	regionapi.Image
+
+	RegionID string
+}
+
+// NewImageModel returns the image's model. The source URL is not returned by
+// the API, so it is left null for the caller to keep.
+func NewImageModel(source *regionImage) ImageModel {
+	catalogImage := NewCatalogImageModel(&source.Image).Attributes()
+
+	operatingSystem, _ := catalogImage["os"].(types.Object)
+	gpu, _ := catalogImage["gpu"].(types.Object)
+	softwareVersions, _ := catalogImage["software_versions"].(types.Map)
+
+	return ImageModel{
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		SourceURL:        types.StringNull(),
+		Architecture:     types.StringValue(string(source.Spec.Architecture)),
+		Virtualization:   types.StringValue(string(source.Spec.Virtualization)),
+		OS:               operatingSystem,
+		GPU:              gpu,
+		SoftwareVersions: softwareVersions,
+		SizeGiB:          types.Int64Value(int64(source.Spec.SizeGiB)),
+		State:            types.StringValue(string(source.Status.State)),
+		RegionID:         types.StringValue(source.RegionID),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
+	}
+}
+
+// NscaleImageCreate returns the request to upload the image from its source
+// URL.
+func (m *ImageModel) NscaleImageCreate(ctx context.Context) (regionapi.ImageCreate, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	var operatingSystem ImageOSModel
+	diagnostics.Append(m.OS.As(ctx, &operatingSystem, basetypes.ObjectAsOptions{})...)
+	if diagnostics.HasError() {
+		return regionapi.ImageCreate{}, diagnostics
+	}
+
+	var gpu *regionapi.ImageGpu
+	if !m.GPU.IsNull() {
+		var model ImageGPUModel
+		diagnostics.Append(m.GPU.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		if diagnostics.HasError() {
+			return regionapi.ImageCreate{}, diagnostics
+		}
+
+		gpu = &regionapi.ImageGpu{
+			Vendor: regionapi.GpuVendor(model.Vendor.ValueString()),
+			Driver: model.Driver.ValueString(),
+		}
+
+		if !model.Models.IsNull() {
+			var models regionapi.GpuModelList
+			diagnostics.Append(model.Models.ElementsAs(ctx, &models, false)...)
+			gpu.Models = &models
+		}
+	}
+
+	var softwareVersions *regionapi.SoftwareVersions
+	if !m.SoftwareVersions.IsNull() {
+		versions := regionapi.SoftwareVersions{}
+		diagnostics.Append(m.SoftwareVersions.ElementsAs(ctx, &versions, false)...)
+		softwareVersions = &versions
+	}
+
+	if diagnostics.HasError() {
+		return regionapi.ImageCreate{}, diagnostics
+	}
+
+	return regionapi.ImageCreate{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name:        m.Name.ValueString(),
+			Description: m.Description.ValueStringPointer(),
+		},
+		Spec: regionapi.ImageCreateSpec{
+			Architecture:   regionapi.Architecture(m.Architecture.ValueString()),
+			Virtualization: regionapi.ImageVirtualization(m.Virtualization.ValueString()),
+			Os: regionapi.ImageOS{
+				Distro:   operatingSystem.Distro.ValueString(),
+				Family:   operatingSystem.Family.ValueString(),
+				Kernel:   regionapi.OsKernel(operatingSystem.Kernel.ValueString()),
+				Version:  operatingSystem.Version.ValueString(),
+				Codename: operatingSystem.Codename.ValueStringPointer(),
+				Variant:  operatingSystem.Variant.ValueStringPointer(),
+			},
+			Gpu:              gpu,
+			SoftwareVersions: softwareVersions,
+			Uri:              m.SourceURL.ValueString(),
+		},
+	}, diagnostics
+}
+
+// imageStatus returns the status of an image as a provisioning status, for the
+// state watchers to wait for it to be uploaded.
+func imageStatus(image *regionImage) nscale.ResourceStatus {
+	if image == nil {
+		return nscale.ResourceStatus{}
+	}
+
+	provisioningStatus := coreapi.ResourceProvisioningStatusUnknown
+	switch image.Status.State {
+	case regionapi.ImageStatePending:
+		provisioningStatus = coreapi.ResourceProvisioningStatusPending
+	case regionapi.ImageStateCreating:
+		provisioningStatus = coreapi.ResourceProvisioningStatusProvisioning
+	case regionapi.ImageStateReady:
+		provisioningStatus = coreapi.ResourceProvisioningStatusProvisioned
+	case regionapi.ImageStateFailed:
+		provisioningStatus = coreapi.ResourceProvisioningStatusError
+	}
+
+	return nscale.ResourceStatus{
+		ID:                 image.Metadata.Id,
+		Name:               image.Metadata.Name,
+		ProvisioningStatus: provisioningStatus,
+		Tags:               image.Metadata.Tags,
+	}
+}
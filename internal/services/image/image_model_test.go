@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+func TestImageRoundTrip(t *testing.T) {
+	codename := "noble"
+	models := regionapi.GpuModelList{"H100"}
+	softwareVersions := regionapi.SoftwareVersions{"cuda": "12.6"}
+
+	image := &regionImage{
+		Image: regionapi.Image{
+			Metadata: coreapi.StaticResourceMetadata{Id: "image", Name: "golden", CreationTime: time.Now()},
+			Spec: regionapi.ImageSpec{
+				Architecture:   regionapi.ArchitectureX8664,
+				Virtualization: regionapi.ImageVirtualizationVirtualized,
+				Os: regionapi.ImageOS{
+					Distro:   "ubuntu",
+					Family:   "debian",
+					Kernel:   regionapi.OsKernelLinux,
+					Version:  "24.04",
+					Codename: &codename,
+				},
+				Gpu:              &regionapi.ImageGpu{Vendor: regionapi.GpuVendorNVIDIA, Driver: "570", Models: &models},
+				SoftwareVersions: &softwareVersions,
+				SizeGiB:          20,
+			},
+			Status: regionapi.ImageStatus{State: regionapi.ImageStateReady},
+		},
+		RegionID: "region",
+	}
+
+	model := NewImageModel(image)
+	if model.RegionID.ValueString() != "region" || model.State.ValueString() != "ready" {
+		t.Fatalf("region_id, state = %s, %s, want \"region\", \"ready\"", model.RegionID, model.State)
+	}
+
+	model.SourceURL = types.StringValue("https://example.com/golden.qcow2")
+
+	request, diagnostics := model.NscaleImageCreate(context.Background())
+	if diagnostics.HasError() {
+		t.Fatalf("NscaleImageCreate() error: %v", diagnostics)
+	}
+
+	want := regionapi.ImageCreateSpec{
+		Architecture:     image.Spec.Architecture,
+		Virtualization:   image.Spec.Virtualization,
+		Os:               image.Spec.Os,
+		Gpu:              image.Spec.Gpu,
+		SoftwareVersions: image.Spec.SoftwareVersions,
+		Uri:              "https://example.com/golden.qcow2",
+	}
+
+	if !reflect.DeepEqual(request.Spec, want) {
+		t.Fatalf("NscaleImageCreate().Spec = %+v, want %+v", request.Spec, want)
+	}
+
+	if request.Metadata.Name != "golden" {
+		t.Fatalf("NscaleImageCreate().Metadata.Name = %q, want \"golden\"", request.Metadata.Name)
+	}
+}
+
+func TestImageStatus(t *testing.T) {
+	testCases := []struct {
+		state regionapi.ImageState
+		want  coreapi.ResourceProvisioningStatus
+	}{
+		{regionapi.ImageStatePending, coreapi.ResourceProvisioningStatusPending},
+		{regionapi.ImageStateCreating, coreapi.ResourceProvisioningStatusProvisioning},
+		{regionapi.ImageStateReady, coreapi.ResourceProvisioningStatusProvisioned},
+		{regionapi.ImageStateFailed, coreapi.ResourceProvisioningStatusError},
+		{"", coreapi.ResourceProvisioningStatusUnknown},
+	}
+
+	for _, testCase := range testCases {
+		image := &regionImage{Image: regionapi.Image{Status: regionapi.ImageStatus{State: testCase.state}}}
+
+		if got := imageStatus(image).ProvisioningStatus; got != testCase.want {
+			t.Fatalf("imageStatus(%q) = %q, want %q", testCase.state, got, testCase.want)
+		}
+	}
+}
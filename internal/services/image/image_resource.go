@@ -0,0 +1,454 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	identityids "github.com/unikorn-cloud/identity/pkg/ids"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var (
+	_ resource.Resource                = &ImageResource{}
+	_ resource.ResourceWithConfigure   = &ImageResource{}
+	_ resource.ResourceWithImportState = &ImageResource{}
+)
+
+type ImageResourceModel struct {
+	ImageModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ImageResource embeds the generic CRUD base; only Schema, ImportState, Update
+// and the adapter wiring below are image-specific.
+type ImageResource struct {
+	*nscale.GenericResource[ImageResourceModel, regionImage]
+}
+
+func NewImageResource() resource.Resource {
+	return &ImageResource{
+		GenericResource: nscale.NewGenericResource(imageAdapter()),
+	}
+}
+
+// imageAdapter wires the image-specific SDK calls and model mapping into the
+// generic resource skeleton.
+func imageAdapter() nscale.ResourceAdapter[ImageResourceModel, regionImage] {
+	return nscale.ResourceAdapter[ImageResourceModel, regionImage]{
+		TypeNameSuffix:  "_image",
+		Title:           "Image",
+		Name:            "image",
+		RequiredFeature: nscale.RegionAPIV2,
+		Create:          imageCreate,
+		// The API cannot update images: every change to the image forces a
+		// replacement, and ImageResource.Update only records source_url.
+		Update: nil,
+		Delete: imageDelete,
+		Get: func(ctx context.Context, client *nscale.Client, id string) (*regionImage, nscale.ResourceStatus, error) {
+			image, err := getImage(ctx, client, nscale.RegionIDFromContext(ctx), id)
+			return image, imageStatus(image), err
+		},
+		ToModel: func(api *regionImage, dst *ImageResourceModel) {
+			priorDescription := dst.Description
+			priorSourceURL := dst.SourceURL
+			dst.ImageModel = NewImageModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
+			dst.SourceURL = priorSourceURL
+		},
+		IDFromModel:       func(m ImageResourceModel) string { return m.ID.ValueString() },
+		TimeoutsFromModel: func(m ImageResourceModel) timeouts.Value { return m.Timeouts },
+	}
+}
+
+// ImportState accepts the ID of an image in the provider's region, or
+// "<region_id>/<id>" for an image in another region.
+func (r *ImageResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	regionID, id, found := strings.Cut(request.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+		return
+	}
+
+	if regionID == "" || id == "" {
+		response.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be the image ID, or of the form '<region_id>/<id>'.",
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("region_id"), regionID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Update records the source_url set on an imported image, which is the only
+// in-place change, as every other argument forces a replacement and the API
+// cannot update images.
+func (r *ImageResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[ImageResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	plan, diagnostics := nscale.ReadTerraformState[ImageResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	data.SourceURL = plan.SourceURL
+	data.Timeouts = plan.Timeouts
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// sourceURLRequiresReplace replaces an image whose source_url changes, unless
+// it has none in state because it was imported, since the API does not return
+// it.
+func sourceURLRequiresReplace(
+	_ context.Context,
+	request planmodifier.StringRequest,
+	response *stringplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	response.RequiresReplace = !request.StateValue.IsNull()
+}
+
+//nolint:funlen // flat attribute declarations.
+func (r *ImageResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Image. Uploads a custom image, such as one built with Packer, to a region of the organization, so that instances and compute clusters can use it by `image_id`. The image is uploaded from a URL and cannot be updated, so any change replaces it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the image.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the image.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_url": schema.StringAttribute{
+				MarkdownDescription: "The URL to upload the image from, which the region must be able to download it from. The API does not return it, so it is not read back on refresh, and setting it on an imported image records it without replacing the image.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						sourceURLRequiresReplace,
+						"Changing source_url forces replacement, unless the image was imported.",
+						"Changing `source_url` forces replacement, unless the image was imported.",
+					),
+				},
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "The CPU architecture the image is built for. Possible values are `x86_64` and `aarch64`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(regionapi.ArchitectureX8664),
+						string(regionapi.ArchitectureAarch64),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"virtualization": schema.StringAttribute{
+				MarkdownDescription: "The kind of machine the image boots on. Possible values are `virtualized`, `baremetal` and `any`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(regionapi.ImageVirtualizationVirtualized),
+						string(regionapi.ImageVirtualizationBaremetal),
+						string(regionapi.ImageVirtualizationAny),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"os": schema.SingleNestedAttribute{
+				MarkdownDescription: "The operating system the image runs.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"distro": schema.StringAttribute{
+						MarkdownDescription: "The distribution name, such as `ubuntu`. It also determines the user of the `ssh_connection` of the machines that use the image.",
+						Required:            true,
+					},
+					"family": schema.StringAttribute{
+						MarkdownDescription: "The family of the operating system, which typically defines its package format, such as `debian`.",
+						Required:            true,
+					},
+					"kernel": schema.StringAttribute{
+						MarkdownDescription: "The kernel type. The only possible value, and the default, is `linux`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(regionapi.OsKernelLinux)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(regionapi.OsKernelLinux)),
+						},
+					},
+					"version": schema.StringAttribute{
+						MarkdownDescription: "The version of the operating system, such as `24.04`.",
+						Required:            true,
+					},
+					"codename": schema.StringAttribute{
+						MarkdownDescription: "The code name of the release, such as `noble`.",
+						Optional:            true,
+					},
+					"variant": schema.StringAttribute{
+						MarkdownDescription: "The variant of the release, such as `server`.",
+						Optional:            true,
+					},
+				},
+			},
+			"gpu": schema.SingleNestedAttribute{
+				MarkdownDescription: "The GPU driver installed in the image, if any.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"vendor": schema.StringAttribute{
+						MarkdownDescription: "The GPU vendor. Possible values are `NVIDIA` and `AMD`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(regionapi.GpuVendorNVIDIA),
+								string(regionapi.GpuVendorAMD),
+							),
+						},
+					},
+					"driver": schema.StringAttribute{
+						MarkdownDescription: "The driver version, which is vendor specific.",
+						Required:            true,
+					},
+					"models": schema.ListAttribute{
+						MarkdownDescription: "The GPU models the driver supports.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"software_versions": schema.MapAttribute{
+				MarkdownDescription: "The versions of the software preinstalled in the image, by name, such as `cuda`, which `nscale_catalog_images` filters by and compute cluster workload pools check their pinned versions against.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"size_gib": schema.Int64Attribute{
+				MarkdownDescription: "The minimum disk size needed to use the image, in gibibytes.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the image: `pending` or `creating` while it is uploaded, then `ready`, or `failed` when the upload fails. Creating the image waits for it to be `ready`.",
+				Computed:            true,
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the region to upload the image to. If not specified, this defaults to the region ID configured in the provider.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the image was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the image.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the image was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func imageCreate(
+	ctx context.Context,
+	client *nscale.Client,
+	plan ImageResourceModel,
+) (*regionImage, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	rawRegionID := nscale.RegionIDFromContext(ctx)
+	if rawRegionID == "" {
+		diagnostics.AddError(
+			"Missing Region ID",
+			"A region ID is required to upload an image. Either set `region_id` on the resource or configure `region_id` on the provider.",
+		)
+		return nil, diagnostics
+	}
+
+	regionID, ok := nscale.ParseID(rawRegionID, "Region", regionids.ParseRegionID, &diagnostics)
+	if !ok {
+		return nil, diagnostics
+	}
+
+	organizationID, ok := nscale.ParseID(
+		client.OrganizationID,
+		"Organization",
+		identityids.ParseOrganizationID,
+		&diagnostics,
+	)
+	if !ok {
+		return nil, diagnostics
+	}
+
+	params, diagnostics := plan.NscaleImageCreate(ctx)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	createResponse, err := client.Region.PostApiV1OrganizationsOrganizationIDRegionsRegionIDImages(
+		ctx,
+		organizationID,
+		regionID,
+		params,
+	)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Create, "Image", "image", err)
+		return nil, diagnostics
+	}
+	defer createResponse.Body.Close()
+
+	image, err := nscale.ReadJSONResponsePointer[regionapi.Image](createResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Create, "Image", "image", err)
+		return nil, diagnostics
+	}
+
+	return &regionImage{Image: *image, RegionID: rawRegionID}, nil
+}
+
+func imageDelete(ctx context.Context, client *nscale.Client, id string) error {
+	organizationID, err := identityids.ParseOrganizationID(client.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	regionID, err := regionids.ParseRegionID(nscale.RegionIDFromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	imageID, err := regionids.ParseImageID(id)
+	if err != nil {
+		return err
+	}
+
+	deleteResponse, err := client.Region.DeleteApiV1OrganizationsOrganizationIDRegionsRegionIDImagesImageID(
+		ctx,
+		organizationID,
+		regionID,
+		imageID,
+	)
+	if err != nil {
+		return err
+	}
+	defer deleteResponse.Body.Close()
+
+	return nscale.ReadEmptyResponse(deleteResponse)
+}
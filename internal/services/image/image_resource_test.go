@@ -0,0 +1,53 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSourceURLRequiresReplace(t *testing.T) {
+	testCases := []struct {
+		name  string
+		state types.String
+		want  bool
+	}{
+		{name: "created", state: types.StringValue("https://example.com/old.qcow2"), want: true},
+		{name: "imported", state: types.StringNull(), want: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				StateValue: testCase.state,
+				PlanValue:  types.StringValue("https://example.com/new.qcow2"),
+			}
+
+			var response stringplanmodifier.RequiresReplaceIfFuncResponse
+			sourceURLRequiresReplace(context.Background(), request, &response)
+
+			if response.RequiresReplace != testCase.want {
+				t.Fatalf("RequiresReplace = %t, want %t", response.RequiresReplace, testCase.want)
+			}
+		})
+	}
+}
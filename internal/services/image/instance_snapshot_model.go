@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+)
+
+type InstanceSnapshotModel struct {
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	InstanceID       types.String      `tfsdk:"instance_id"`
+	SizeGiB          types.Int64       `tfsdk:"size_gib"`
+	State            types.String      `tfsdk:"state"`
+	RegionID         types.String      `tfsdk:"region_id"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
+}
+
+// NewInstanceSnapshotModel returns the model of the image a snapshot created.
+// The image does not record the instance it was taken of, so the instance ID
+// is left null for the caller to keep.
+func NewInstanceSnapshotModel(source *regionImage) InstanceSnapshotModel {
+	return InstanceSnapshotModel{
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		InstanceID:       types.StringNull(),
+		SizeGiB:          types.Int64Value(int64(source.Spec.SizeGiB)),
+		State:            types.StringValue(string(source.Status.State)),
+		RegionID:         types.StringValue(source.RegionID),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
+	}
+}
+
+func (m *InstanceSnapshotModel) NscaleSnapshotCreate() computeapi.InstanceSnapshotCreate {
+	return computeapi.InstanceSnapshotCreate{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name:        m.Name.ValueString(),
+			Description: m.Description.ValueStringPointer(),
+		},
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+func TestInstanceSnapshotRoundTrip(t *testing.T) {
+	description := "before epoch 40"
+
+	image := &regionImage{
+		Image: regionapi.Image{
+			Metadata: coreapi.StaticResourceMetadata{
+				Id:           "snapshot",
+				Name:         "checkpoint",
+				Description:  &description,
+				CreationTime: time.Now(),
+			},
+			Spec:   regionapi.ImageSpec{SizeGiB: 100},
+			Status: regionapi.ImageStatus{State: regionapi.ImageStateCreating},
+		},
+		RegionID: "region",
+	}
+
+	model := NewInstanceSnapshotModel(image)
+	if model.ID.ValueString() != "snapshot" || model.SizeGiB.ValueInt64() != 100 {
+		t.Fatalf("id, size_gib = %s, %s, want \"snapshot\", 100", model.ID, model.SizeGiB)
+	}
+
+	if !model.InstanceID.IsNull() {
+		t.Fatalf("instance_id = %s, want null", model.InstanceID)
+	}
+
+	model.InstanceID = types.StringValue("instance")
+
+	request := model.NscaleSnapshotCreate()
+	if request.Metadata.Name != "checkpoint" || *request.Metadata.Description != description {
+		t.Fatalf("NscaleSnapshotCreate().Metadata = %+v, want the snapshot's name and description", request.Metadata)
+	}
+}
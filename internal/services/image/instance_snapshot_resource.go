@@ -0,0 +1,270 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var (
+	_ resource.Resource                = &InstanceSnapshotResource{}
+	_ resource.ResourceWithConfigure   = &InstanceSnapshotResource{}
+	_ resource.ResourceWithImportState = &InstanceSnapshotResource{}
+)
+
+type InstanceSnapshotResourceModel struct {
+	InstanceSnapshotModel
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// InstanceSnapshotResource embeds the generic CRUD base. A snapshot is an
+// image of the organization, so it is read and deleted as nscale_image is.
+type InstanceSnapshotResource struct {
+	*nscale.GenericResource[InstanceSnapshotResourceModel, regionImage]
+}
+
+func NewInstanceSnapshotResource() resource.Resource {
+	return &InstanceSnapshotResource{
+		GenericResource: nscale.NewGenericResource(instanceSnapshotAdapter()),
+	}
+}
+
+// instanceSnapshotAdapter wires the snapshot-specific SDK calls and model
+// mapping into the generic resource skeleton.
+func instanceSnapshotAdapter() nscale.ResourceAdapter[InstanceSnapshotResourceModel, regionImage] {
+	return nscale.ResourceAdapter[InstanceSnapshotResourceModel, regionImage]{
+		TypeNameSuffix:  "_instance_snapshot",
+		Title:           "Instance Snapshot",
+		Name:            "instance snapshot",
+		RequiredFeature: nscale.RegionAPIV2,
+		Create:          instanceSnapshotCreate,
+		// Snapshots cannot be updated: a nil Update tells the base to reject
+		// in-place updates so every change forces a replacement.
+		Update: nil,
+		Delete: imageDelete,
+		Get: func(ctx context.Context, client *nscale.Client, id string) (*regionImage, nscale.ResourceStatus, error) {
+			image, err := getImage(ctx, client, nscale.RegionIDFromContext(ctx), id)
+			return image, imageStatus(image), err
+		},
+		ToModel: func(api *regionImage, dst *InstanceSnapshotResourceModel) {
+			priorDescription := dst.Description
+			priorInstanceID := dst.InstanceID
+			dst.InstanceSnapshotModel = NewInstanceSnapshotModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
+			dst.InstanceID = priorInstanceID
+		},
+		IDFromModel:       func(m InstanceSnapshotResourceModel) string { return m.ID.ValueString() },
+		TimeoutsFromModel: func(m InstanceSnapshotResourceModel) timeouts.Value { return m.Timeouts },
+	}
+}
+
+// ImportState accepts the ID of a snapshot in the provider's region, or
+// "<region_id>/<id>" for a snapshot in another region.
+func (r *InstanceSnapshotResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	regionID, id, found := strings.Cut(request.ID, "/")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+		return
+	}
+
+	if regionID == "" || id == "" {
+		response.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be the snapshot ID, or of the form '<region_id>/<id>'.",
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("region_id"), regionID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func (r *InstanceSnapshotResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Instance Snapshot. Checkpoints the disk of an instance as an image of the organization, whose ID can be given as the `image_id` of an instance or compute cluster workload pool to restore it. Snapshots cannot be updated, so any change replaces the snapshot.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the snapshot, which is the ID of its image, for use as an `image_id` to boot from it.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the snapshot.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the snapshot.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the instance to snapshot, which must be in the snapshot's region. The API does not return it, so it is not read back on refresh, and is null after import.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size_gib": schema.Int64Attribute{
+				MarkdownDescription: "The minimum disk size needed to boot from the snapshot, in gibibytes.",
+				Computed:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the snapshot's image: `pending` or `creating` while it is taken, then `ready`, or `failed`. Creating the snapshot waits for it to be `ready`.",
+				Computed:            true,
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the region of the instance. If not specified, this defaults to the region ID configured in the provider.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the snapshot was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the snapshot.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the snapshot.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the snapshot was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func instanceSnapshotCreate(
+	ctx context.Context,
+	client *nscale.Client,
+	plan InstanceSnapshotResourceModel,
+) (*regionImage, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	rawRegionID := nscale.RegionIDFromContext(ctx)
+	if rawRegionID == "" {
+		diagnostics.AddError(
+			"Missing Region ID",
+			"A region ID is required to snapshot an instance. Either set `region_id` on the resource or configure `region_id` on the provider.",
+		)
+		return nil, diagnostics
+	}
+
+	// The snapshot is read from the images of its region, so the instance
+	// must be in it, or the snapshot would never be found.
+	instance, err := client.GetInstance(ctx, plan.InstanceID.ValueString())
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddError(
+			"Failed to Read Instance",
+			fmt.Sprintf("An error occurred while reading the instance to snapshot: %s", err),
+		)
+		return nil, diagnostics
+	}
+
+	if instance.Status.RegionId != rawRegionID {
+		diagnostics.AddAttributeError(
+			path.Root("region_id"),
+			"Instance in Another Region",
+			fmt.Sprintf(
+				"Instance %s is in region %s, not %s. Set `region_id` to the instance's `region_id`.",
+				plan.InstanceID.ValueString(),
+				instance.Status.RegionId,
+				rawRegionID,
+			),
+		)
+		return nil, diagnostics
+	}
+
+	createResponse, err := client.Compute.PostApiV2InstancesInstanceIDSnapshot(
+		ctx,
+		plan.InstanceID.ValueString(),
+		plan.NscaleSnapshotCreate(),
+	)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Create, "Instance Snapshot", "instance snapshot", err)
+		return nil, diagnostics
+	}
+	defer createResponse.Body.Close()
+
+	image, err := nscale.ReadJSONResponsePointer[regionapi.Image](createResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Create, "Instance Snapshot", "instance snapshot", err)
+		return nil, diagnostics
+	}
+
+	return &regionImage{Image: *image, RegionID: rawRegionID}, nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+)
+
+// bastionSSHPort is the only port the security group of a bastion opens.
+const bastionSSHPort = 22
+
+type BastionResourceModel struct {
+	ID                        types.String              `tfsdk:"id"`
+	Name                      types.String              `tfsdk:"name"`
+	NetworkID                 types.String              `tfsdk:"network_id"`
+	AllowedSSHCIDRBlocks      types.List                `tfsdk:"allowed_ssh_cidr_blocks"`
+	ImageID                   types.String              `tfsdk:"image_id"`
+	FlavorID                  types.String              `tfsdk:"flavor_id"`
+	SSHCertificateAuthorityID types.String              `tfsdk:"ssh_certificate_authority_id"`
+	UserData                  tftypes.Base64StringValue `tfsdk:"user_data"`
+	Tags                      types.Map                 `tfsdk:"tags"`
+	ProjectID                 types.String              `tfsdk:"project_id"`
+	RegionID                  types.String              `tfsdk:"region_id"`
+	SecurityGroupID           types.String              `tfsdk:"security_group_id"`
+	PublicIP                  types.String              `tfsdk:"public_ip"`
+	PrivateIP                 types.String              `tfsdk:"private_ip"`
+	Timeouts                  tftimeouts.Value          `tfsdk:"timeouts"`
+}
+
+// setInstance sets the attributes read from the bastion's instance. The SSH
+// CIDR blocks live in its security group and are kept as they are.
+func (m *BastionResourceModel) setInstance(source *computeapi.InstanceRead) {
+	instance := NewInstanceModel(source)
+
+	m.ID = instance.ID
+	m.Name = instance.Name
+	m.NetworkID = types.StringValue(source.Status.NetworkId)
+	m.ImageID = instance.ImageID
+	m.FlavorID = instance.FlavorID
+	m.SSHCertificateAuthorityID = instance.SSHCertificateAuthorityID
+	m.UserData = instance.UserData
+	m.Tags = instance.Tags
+	m.ProjectID = instance.ProjectID
+	m.RegionID = instance.RegionID
+	m.PublicIP = instance.PublicIP
+	m.PrivateIP = instance.PrivateIP
+
+	m.SecurityGroupID = types.StringNull()
+	if networking := source.Spec.Networking; networking != nil && networking.SecurityGroups != nil {
+		if securityGroups := *networking.SecurityGroups; len(securityGroups) > 0 {
+			m.SecurityGroupID = types.StringValue(securityGroups[0])
+		}
+	}
+}
+
+// instanceModel returns the instance the bastion is made of: one with a public
+// IP address, behind the bastion's own security group only.
+func (m *BastionResourceModel) instanceModel() InstanceResourceModel {
+	securityGroupIDs := types.ListNull(types.StringType)
+	if !m.SecurityGroupID.IsNull() && !m.SecurityGroupID.IsUnknown() {
+		securityGroupIDs = types.ListValueMust(types.StringType, []attr.Value{m.SecurityGroupID})
+	}
+
+	return InstanceResourceModel{
+		InstanceModel: InstanceModel{
+			ID:   m.ID,
+			Name: m.Name,
+			NetworkInterface: types.ObjectValueMust(
+				InstanceNetworkInterfaceModelAttributeType.AttrTypes,
+				map[string]attr.Value{
+					"network_id":               m.NetworkID,
+					"enable_public_ip":         types.BoolValue(true),
+					"security_group_ids":       securityGroupIDs,
+					"allowed_source_addresses": types.ListNull(types.StringType),
+					"allowed_destinations":     types.ListNull(types.StringType),
+				},
+			),
+			UserData:                  m.UserData,
+			ImageID:                   m.ImageID,
+			FlavorID:                  m.FlavorID,
+			SSHCertificateAuthorityID: m.SSHCertificateAuthorityID,
+			Tags:                      m.Tags,
+			ProjectID:                 m.ProjectID,
+		},
+		ExtraSpecJSON: jsontypes.NewNormalizedNull(),
+		Timeouts:      m.Timeouts,
+	}
+}
+
+// nscaleSecurityGroupRules returns the rules of the bastion's security group,
+// which let SSH in from the allowed CIDR blocks and nothing else in.
+func (m *BastionResourceModel) nscaleSecurityGroupRules() ([]regionapi.SecurityGroupRuleV2, diag.Diagnostics) {
+	var cidrBlocks []string
+	if diagnostics := m.AllowedSSHCIDRBlocks.ElementsAs(context.TODO(), &cidrBlocks, false); diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	rules := make([]regionapi.SecurityGroupRuleV2, 0, len(cidrBlocks))
+	for _, cidrBlock := range cidrBlocks {
+		port, portMax, prefix := bastionSSHPort, bastionSSHPort, cidrBlock
+
+		rules = append(rules, regionapi.SecurityGroupRuleV2{
+			Direction: regionapi.NetworkDirectionIngress,
+			Protocol:  regionapi.NetworkProtocolTcp,
+			Port:      &port,
+			PortMax:   &portMax,
+			Prefix:    &prefix,
+		})
+	}
+
+	return rules, nil
+}
+
+// nscaleSecurityGroupMetadata returns the metadata of the bastion's security
+// group, which is named and tagged after the bastion.
+func (m *BastionResourceModel) nscaleSecurityGroupMetadata() (coreapi.ResourceWriteMetadata, diag.Diagnostics) {
+	tags, diagnostics := tftypes.ValueTagListPointer(m.Tags)
+	if diagnostics.HasError() {
+		return coreapi.ResourceWriteMetadata{}, diagnostics
+	}
+
+	description := fmt.Sprintf("SSH access to the bastion %s.", m.Name.ValueString())
+
+	metadata := coreapi.ResourceWriteMetadata{
+		Description: &description,
+		Name:        m.Name.ValueString(),
+		Tags:        nscale.RemoveOperationTags(tags),
+	}
+	nscale.WriteManagedByTag(&metadata)
+
+	return metadata, nil
+}
+
+func (m *BastionResourceModel) NscaleSecurityGroupCreateParams() (regionapi.SecurityGroupV2Create, diag.Diagnostics) {
+	metadata, diagnostics := m.nscaleSecurityGroupMetadata()
+	if diagnostics.HasError() {
+		return regionapi.SecurityGroupV2Create{}, diagnostics
+	}
+
+	rules, diagnostics := m.nscaleSecurityGroupRules()
+	if diagnostics.HasError() {
+		return regionapi.SecurityGroupV2Create{}, diagnostics
+	}
+
+	networkID, ok := nscale.ParseID(m.NetworkID.ValueString(), "Network", regionids.ParseNetworkID, &diagnostics)
+	if !ok {
+		return regionapi.SecurityGroupV2Create{}, diagnostics
+	}
+
+	securityGroup := regionapi.SecurityGroupV2Create{
+		Metadata: metadata,
+		Spec: regionapi.SecurityGroupV2CreateSpec{
+			NetworkId: networkID,
+			Rules:     rules,
+		},
+	}
+
+	return securityGroup, nil
+}
+
+func (m *BastionResourceModel) NscaleSecurityGroupUpdateParams() (regionapi.SecurityGroupV2Update, diag.Diagnostics) {
+	metadata, diagnostics := m.nscaleSecurityGroupMetadata()
+	if diagnostics.HasError() {
+		return regionapi.SecurityGroupV2Update{}, diagnostics
+	}
+
+	rules, diagnostics := m.nscaleSecurityGroupRules()
+	if diagnostics.HasError() {
+		return regionapi.SecurityGroupV2Update{}, diagnostics
+	}
+
+	securityGroup := regionapi.SecurityGroupV2Update{
+		Metadata: metadata,
+		Spec: regionapi.SecurityGroupV2Spec{
+			Rules: rules,
+		},
+	}
+
+	return securityGroup, nil
+}
+
+// smallestFlavor returns the smallest x86_64 virtual machine flavor without
+// GPUs, by CPUs, then memory, then disk, or nil when there is none. Ties are
+// broken by name so the choice does not depend on the order of the list.
+func smallestFlavor(flavors []regionapi.Flavor) *regionapi.Flavor {
+	candidates := slices.DeleteFunc(slices.Clone(flavors), func(flavor regionapi.Flavor) bool {
+		spec := flavor.Spec
+		return spec.Gpu != nil ||
+			spec.Architecture != regionapi.ArchitectureX8664 ||
+			(spec.Baremetal != nil && *spec.Baremetal) ||
+			(spec.PinnedOnly != nil && *spec.PinnedOnly)
+	})
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	smallest := slices.MinFunc(candidates, func(a, b regionapi.Flavor) int {
+		if a.Spec.Cpus != b.Spec.Cpus {
+			return a.Spec.Cpus - b.Spec.Cpus
+		}
+		if a.Spec.Memory != b.Spec.Memory {
+			return a.Spec.Memory - b.Spec.Memory
+		}
+		if a.Spec.Disk != b.Spec.Disk {
+			return a.Spec.Disk - b.Spec.Disk
+		}
+		return strings.Compare(a.Metadata.Name, b.Metadata.Name)
+	})
+
+	return &smallest
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/pointer"
+)
+
+func testFlavor(name string, cpus, memory int, modify ...func(*regionapi.FlavorSpec)) regionapi.Flavor {
+	flavor := regionapi.Flavor{
+		Metadata: coreapi.StaticResourceMetadata{Id: name, Name: name},
+		Spec: regionapi.FlavorSpec{
+			Architecture: regionapi.ArchitectureX8664,
+			Cpus:         cpus,
+			Memory:       memory,
+			Disk:         20,
+		},
+	}
+
+	for _, fn := range modify {
+		fn(&flavor.Spec)
+	}
+
+	return flavor
+}
+
+func TestSmallestFlavor(t *testing.T) {
+	withGPU := func(spec *regionapi.FlavorSpec) { spec.Gpu = &regionapi.GpuSpec{} }
+	baremetal := func(spec *regionapi.FlavorSpec) { spec.Baremetal = pointer.Reference(true) }
+	arm := func(spec *regionapi.FlavorSpec) { spec.Architecture = regionapi.ArchitectureAarch64 }
+
+	testCases := []struct {
+		name    string
+		flavors []regionapi.Flavor
+		want    string
+	}{
+		{
+			name: "fewest CPUs, then least memory",
+			flavors: []regionapi.Flavor{
+				testFlavor("large", 8, 32),
+				testFlavor("medium", 2, 8),
+				testFlavor("small", 2, 4),
+			},
+			want: "small",
+		},
+		{
+			name: "ignores unsuitable flavors",
+			flavors: []regionapi.Flavor{
+				testFlavor("gpu", 1, 1, withGPU),
+				testFlavor("metal", 1, 1, baremetal),
+				testFlavor("arm", 1, 1, arm),
+				testFlavor("vm", 4, 16),
+			},
+			want: "vm",
+		},
+		{
+			name:    "ties broken by name",
+			flavors: []regionapi.Flavor{testFlavor("b", 2, 4), testFlavor("a", 2, 4)},
+			want:    "a",
+		},
+		{
+			name:    "none suitable",
+			flavors: []regionapi.Flavor{testFlavor("gpu", 1, 1, withGPU)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := smallestFlavor(testCase.flavors)
+
+			var gotName string
+			if got != nil {
+				gotName = got.Metadata.Name
+			}
+
+			if gotName != testCase.want {
+				t.Fatalf("smallestFlavor() = %q, want %q", gotName, testCase.want)
+			}
+		})
+	}
+}
+
+func TestBastionSecurityGroupAllowsOnlySSH(t *testing.T) {
+	model := BastionResourceModel{
+		Name:      types.StringValue("bastion"),
+		NetworkID: types.StringValue("network"),
+		AllowedSSHCIDRBlocks: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("203.0.113.0/24"),
+			types.StringValue("198.51.100.7/32"),
+		}),
+		Tags: types.MapNull(types.StringType),
+	}
+
+	rules, diagnostics := model.nscaleSecurityGroupRules()
+	if diagnostics.HasError() {
+		t.Fatalf("nscaleSecurityGroupRules() error: %v", diagnostics)
+	}
+
+	if len(rules) != 2 {
+		t.Fatalf("nscaleSecurityGroupRules() = %d rules, want 2", len(rules))
+	}
+
+	for i, rule := range rules {
+		if rule.Direction != regionapi.NetworkDirectionIngress || rule.Protocol != regionapi.NetworkProtocolTcp ||
+			*rule.Port != bastionSSHPort || *rule.PortMax != bastionSSHPort {
+			t.Fatalf("rule %d = %+v, want an SSH ingress rule", i, rule)
+		}
+	}
+
+	if got := *rules[1].Prefix; got != "198.51.100.7/32" {
+		t.Fatalf("rule prefix = %q, want %q", got, "198.51.100.7/32")
+	}
+}
+
+func TestBastionInstanceModel(t *testing.T) {
+	model := BastionResourceModel{
+		Name:            types.StringValue("bastion"),
+		NetworkID:       types.StringValue("network"),
+		SecurityGroupID: types.StringValue("security-group"),
+		Tags:            types.MapNull(types.StringType),
+	}
+
+	instance := model.instanceModel()
+
+	var networkInterface InstanceNetworkInterfaceModel
+	diagnostics := instance.NetworkInterface.As(context.Background(), &networkInterface, basetypes.ObjectAsOptions{})
+	if diagnostics.HasError() {
+		t.Fatalf("failed to decode network interface: %v", diagnostics)
+	}
+
+	networking, diagnostics := networkInterface.NscaleInstanceNetworking()
+	if diagnostics.HasError() {
+		t.Fatalf("NscaleInstanceNetworking() error: %v", diagnostics)
+	}
+
+	if networking.PublicIP == nil || !*networking.PublicIP {
+		t.Fatal("bastion instance has no public IP")
+	}
+	if networking.SecurityGroups == nil || len(*networking.SecurityGroups) != 1 ||
+		(*networking.SecurityGroups)[0] != "security-group" {
+		t.Fatalf("security groups = %v, want only the bastion's", networking.SecurityGroups)
+	}
+}
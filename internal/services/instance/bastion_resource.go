@@ -0,0 +1,463 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+// bastionSecurityGroupDeleteTimeout bounds the wait for the bastion's security
+// group to be released by its instance, so it can be deleted.
+const bastionSecurityGroupDeleteTimeout = 30 * time.Minute
+
+var (
+	_ resource.Resource                = &BastionResource{}
+	_ resource.ResourceWithConfigure   = &BastionResource{}
+	_ resource.ResourceWithImportState = &BastionResource{}
+	_ resource.ResourceWithModifyPlan  = &BastionResource{}
+)
+
+// BastionResource is an instance with a public IP address, reachable over SSH
+// from a set of CIDR blocks only, through a security group of its own that it
+// creates and deletes with the instance.
+type BastionResource struct {
+	*nscale.GenericResource[BastionResourceModel, computeapi.InstanceRead]
+}
+
+func NewBastionResource() resource.Resource {
+	return &BastionResource{
+		GenericResource: nscale.NewGenericResource(bastionAdapter()),
+	}
+}
+
+func bastionAdapter() nscale.ResourceAdapter[BastionResourceModel, computeapi.InstanceRead] {
+	return nscale.ResourceAdapter[BastionResourceModel, computeapi.InstanceRead]{
+		TypeNameSuffix:  "_bastion",
+		Title:           "Bastion",
+		Name:            "bastion",
+		RequiredFeature: nscale.ComputeAPIV2,
+		Create:          bastionCreate,
+		Update:          bastionUpdate,
+		Delete:          bastionDelete,
+		Get: func(
+			ctx context.Context,
+			client *nscale.Client,
+			id string,
+		) (*computeapi.InstanceRead, nscale.ResourceStatus, error) {
+			return nscale.AdaptProjectScoped(getInstance(ctx, id, client))
+		},
+		ToModel: func(api *computeapi.InstanceRead, dst *BastionResourceModel) {
+			dst.setInstance(api)
+		},
+		Settled: func(api *computeapi.InstanceRead, _ BastionResourceModel) bool {
+			return api.Status.PublicIP != nil
+		},
+		IDFromModel:       func(m BastionResourceModel) string { return m.ID.ValueString() },
+		TimeoutsFromModel: func(m BastionResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:            true,
+	}
+}
+
+func (r *BastionResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Bastion",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the bastion, which is the identifier of its instance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the bastion, which is also given to its instance and security group.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the network the bastion gives access to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_ssh_cidr_blocks": schema.ListAttribute{
+				MarkdownDescription: "The CIDR blocks SSH connections to the bastion are allowed from. No other inbound traffic is allowed. The rules are written to the bastion's security group, and changes made to it outside Terraform are not detected.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(validators.CIDRValidator{}),
+				},
+			},
+			"image_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the image used for the bastion.",
+				Required:            true,
+			},
+			"flavor_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the flavor used for the bastion. Defaults to the smallest x86_64 virtual machine flavor without GPUs in the region of the network, by CPUs, then memory, then disk.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssh_certificate_authority_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the SSH certificate authority used to bootstrap login trust when the bastion is created. Changing this value forces the bastion to be replaced.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_data": schema.StringAttribute{
+				MarkdownDescription: "The base64-encoded data to pass to the bastion at boot time.",
+				CustomType:          tftypes.Base64StringType{},
+				Optional:            true,
+				Validators: []validator.String{
+					validators.Base64Validator{},
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "A map of tags assigned to the bastion's instance and security group.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(validators.NoReservedPrefix(nscale.TerraformOperationTagPrefix)),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project where the bastion is provisioned. If not specified, this defaults to the project ID configured in the provider.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the region where the bastion is provisioned.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the security group created for the bastion.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_ip": schema.StringAttribute{
+				MarkdownDescription: "The public IP address of the bastion, to connect to over SSH.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				MarkdownDescription: "The private IP address of the bastion on its network.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// bastionCreate creates the bastion's security group, waits for it to be
+// provisioned, and then creates its instance behind it. The security group is
+// deleted again if the instance cannot be created, since nothing in the state
+// would refer to it.
+func bastionCreate(
+	ctx context.Context,
+	client *nscale.Client,
+	plan BastionResourceModel,
+) (*computeapi.InstanceRead, diag.Diagnostics) {
+	projectID, diagnostics := client.ResolveProjectID(plan.ProjectID.ValueString())
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+	plan.ProjectID = types.StringValue(projectID)
+
+	if plan.FlavorID.IsNull() || plan.FlavorID.IsUnknown() {
+		flavorID, flavorDiagnostics := defaultBastionFlavorID(ctx, client, plan.NetworkID.ValueString())
+		diagnostics.Append(flavorDiagnostics...)
+		if diagnostics.HasError() {
+			return nil, diagnostics
+		}
+		plan.FlavorID = types.StringValue(flavorID)
+	}
+
+	securityGroupID, securityGroupDiagnostics := createBastionSecurityGroup(ctx, client, plan)
+	diagnostics.Append(securityGroupDiagnostics...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+	plan.SecurityGroupID = types.StringValue(securityGroupID)
+
+	instance, instanceDiagnostics := bastionCreateInstance(ctx, client, plan)
+	diagnostics.Append(instanceDiagnostics...)
+	if diagnostics.HasError() {
+		if err := deleteBastionSecurityGroup(ctx, client, securityGroupID); err != nil {
+			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+			diagnostics.AddWarning(
+				"Failed to Clean Up Bastion Security Group",
+				fmt.Sprintf(
+					"The security group %s created for the bastion could not be deleted after its instance failed to be created, and must be deleted manually: %s",
+					securityGroupID,
+					err,
+				),
+			)
+		}
+		return nil, diagnostics
+	}
+
+	return instance, diagnostics
+}
+
+// bastionCreateInstance waits for the bastion's security group to be ready
+// and creates its instance.
+func bastionCreateInstance(
+	ctx context.Context,
+	client *nscale.Client,
+	plan BastionResourceModel,
+) (*computeapi.InstanceRead, diag.Diagnostics) {
+	dependency := nscale.Dependency{Kind: nscale.SecurityGroupDependency, ID: plan.SecurityGroupID.ValueString()}
+	if diagnostics := client.AwaitReady(ctx, plan.Timeouts, []nscale.Dependency{dependency}); diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	return instanceCreate(ctx, client, plan.instanceModel())
+}
+
+// bastionUpdate rewrites the bastion's security group, which takes the name,
+// tags and SSH CIDR blocks of the bastion, and then updates its instance.
+func bastionUpdate(
+	ctx context.Context,
+	client *nscale.Client,
+	id string,
+	_ *BastionResourceModel,
+	plan BastionResourceModel,
+) (string, diag.Diagnostics) {
+	params, diagnostics := plan.NscaleSecurityGroupUpdateParams()
+	if diagnostics.HasError() {
+		return "", diagnostics
+	}
+
+	securityGroupID, ok := nscale.ParseID(
+		plan.SecurityGroupID.ValueString(),
+		"Security Group",
+		regionids.ParseSecurityGroupID,
+		&diagnostics,
+	)
+	if !ok {
+		return "", diagnostics
+	}
+
+	updateResponse, err := client.Region.PutApiV2SecuritygroupsSecurityGroupID(ctx, securityGroupID, params)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Update, "Bastion", "bastion's security group", err)
+		return "", diagnostics
+	}
+
+	if _, readErr := nscale.ReadJSONResponsePointer[regionapi.SecurityGroupV2Read](updateResponse); readErr != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, readErr)
+		apidiag.Add(&diagnostics, apidiag.Update, "Bastion", "bastion's security group", readErr)
+		return "", diagnostics
+	}
+
+	return instanceUpdate(ctx, client, id, nil, plan.instanceModel())
+}
+
+// bastionDelete deletes the bastion's instance and then its security group,
+// which the API only allows once the instance has released it.
+func bastionDelete(ctx context.Context, client *nscale.Client, id string) error {
+	instance, _, err := getInstance(ctx, id, client)
+	if err != nil {
+		return err
+	}
+
+	var model BastionResourceModel
+	model.setInstance(instance)
+
+	if err = instanceDelete(ctx, client, id); err != nil && !nscale.IsAPIErrorNotFound(err) {
+		return err
+	}
+
+	if model.SecurityGroupID.IsNull() {
+		return nil
+	}
+
+	return deleteBastionSecurityGroup(ctx, client, model.SecurityGroupID.ValueString())
+}
+
+// defaultBastionFlavorID returns the identifier of the smallest suitable
+// flavor in the region of the network, see smallestFlavor.
+func defaultBastionFlavorID(ctx context.Context, client *nscale.Client, rawNetworkID string) (string, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	networkID, ok := nscale.ParseID(rawNetworkID, "Network", regionids.ParseNetworkID, &diagnostics)
+	if !ok {
+		return "", diagnostics
+	}
+
+	networkResponse, err := client.Region.GetApiV2NetworksNetworkID(ctx, networkID)
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to Create Bastion",
+			fmt.Sprintf("An error occurred while retrieving the network to choose a flavor for the bastion: %s", err),
+		)
+		return "", diagnostics
+	}
+	defer networkResponse.Body.Close()
+
+	network, err := nscale.ReadJSONResponsePointer[regionapi.NetworkV2Read](networkResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddError(
+			"Failed to Create Bastion",
+			fmt.Sprintf("An error occurred while retrieving the network to choose a flavor for the bastion: %s", err),
+		)
+		return "", diagnostics
+	}
+
+	regionID := network.Status.RegionId
+
+	flavorListResponse, err := client.Compute.GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavors(
+		ctx,
+		client.OrganizationID,
+		regionID,
+	)
+	if err != nil {
+		diagnostics.AddError(
+			"Failed to Create Bastion",
+			fmt.Sprintf("An error occurred while retrieving the flavors to choose one for the bastion: %s", err),
+		)
+		return "", diagnostics
+	}
+	defer flavorListResponse.Body.Close()
+
+	flavors, err := nscale.ReadJSONResponseValue[[]regionapi.Flavor](flavorListResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddError(
+			"Failed to Create Bastion",
+			fmt.Sprintf("An error occurred while retrieving the flavors to choose one for the bastion: %s", err),
+		)
+		return "", diagnostics
+	}
+
+	flavor := smallestFlavor(flavors)
+	if flavor == nil {
+		diagnostics.AddError(
+			"No Flavor Available for Bastion",
+			fmt.Sprintf(
+				"The region %s has no x86_64 virtual machine flavor without GPUs to default the bastion to. Set flavor_id explicitly.",
+				regionID,
+			),
+		)
+		return "", diagnostics
+	}
+
+	return flavor.Metadata.Id, diagnostics
+}
+
+// createBastionSecurityGroup creates the security group of the bastion and
+// returns its identifier.
+func createBastionSecurityGroup(
+	ctx context.Context,
+	client *nscale.Client,
+	plan BastionResourceModel,
+) (string, diag.Diagnostics) {
+	params, diagnostics := plan.NscaleSecurityGroupCreateParams()
+	if diagnostics.HasError() {
+		return "", diagnostics
+	}
+
+	createResponse, err := client.Region.PostApiV2Securitygroups(ctx, params)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Create, "Bastion", "bastion's security group", err)
+		return "", diagnostics
+	}
+
+	securityGroup, err := nscale.ReadJSONResponsePointer[regionapi.SecurityGroupV2Read](createResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Create, "Bastion", "bastion's security group", err)
+		return "", diagnostics
+	}
+
+	return securityGroup.Metadata.Id, diagnostics
+}
+
+// deleteBastionSecurityGroup deletes the security group of a bastion, retrying
+// while its instance is still being deleted and holds on to it.
+func deleteBastionSecurityGroup(ctx context.Context, client *nscale.Client, id string) error {
+	securityGroupID, err := regionids.ParseSecurityGroupID(id)
+	if err != nil {
+		return err
+	}
+
+	return nscale.RetryDelete(ctx, bastionSecurityGroupDeleteTimeout, func(ctx context.Context) (error, bool) {
+		deleteResponse, deleteErr := client.Region.DeleteApiV2SecuritygroupsSecurityGroupID(ctx, securityGroupID)
+		if deleteErr != nil {
+			return deleteErr, false
+		}
+		defer deleteResponse.Body.Close()
+		if readErr := nscale.ReadEmptyResponse(deleteResponse); readErr != nil {
+			return readErr, nscale.IsAPIErrorInUse(readErr)
+		}
+		return nil, false
+	})
+}
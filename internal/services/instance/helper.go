@@ -18,10 +18,15 @@ package instance
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	coreapi "github.com/nscaledev/nscale-sdk-go/common"
 	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
 
@@ -30,16 +35,68 @@ func getInstance(
 	id string,
 	client *nscale.Client,
 ) (*computeapi.InstanceRead, *coreapi.ProjectScopedResourceReadMetadata, error) {
-	instanceResponse, err := client.Compute.GetApiV2InstancesInstanceID(ctx, id)
+	instance, err := client.GetInstance(ctx, id)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer instanceResponse.Body.Close()
 
-	instance, err := nscale.ReadJSONResponsePointer[computeapi.InstanceRead](instanceResponse)
+	return instance, &instance.Metadata, nil
+}
+
+// readInstancePrivateKey reads the auto-generated SSH private key of an
+// instance, which is null when the instance has none.
+func readInstancePrivateKey(ctx context.Context, client *nscale.Client, instanceID string) (types.String, error) {
+	sshKeyResponse, err := client.Compute.GetApiV2InstancesInstanceIDSshkey(ctx, instanceID)
 	if err != nil {
-		return nil, nil, err
+		return types.StringNull(), err
 	}
+	defer sshKeyResponse.Body.Close()
 
-	return instance, &instance.Metadata, nil
+	sshKey, err := nscale.ReadJSONResponsePointer[regionapi.SshKey](sshKeyResponse)
+	if err != nil {
+		if nscale.IsAPIErrorNotFound(err) {
+			return types.StringNull(), nil
+		}
+		return types.StringNull(), err
+	}
+
+	return types.StringValue(sshKey.PrivateKey), nil
+}
+
+// readInstanceSSHKey reads the auto-generated SSH key of an instance for the
+// data source and the ephemeral resource. An instance created with an SSH
+// certificate authority has no such key, which is reported as a warning and a
+// null private key.
+func readInstanceSSHKey(
+	ctx context.Context,
+	client *nscale.Client,
+	instanceID string,
+	diagnostics *diag.Diagnostics,
+) (InstanceSSHKeyModel, bool) {
+	sshKeyResponse, err := client.Compute.GetApiV2InstancesInstanceIDSshkey(ctx, instanceID)
+	if err != nil {
+		apidiag.Add(diagnostics, apidiag.Read, "Instance SSH Key", "instance SSH key", err)
+		return InstanceSSHKeyModel{}, false
+	}
+	defer sshKeyResponse.Body.Close()
+
+	sshKey, err := nscale.ReadJSONResponsePointer[regionapi.SshKey](sshKeyResponse)
+	if err != nil {
+		if nscale.IsAPIErrorNotFound(err) {
+			diagnostics.AddWarning(
+				"Instance SSH Key Not Available",
+				fmt.Sprintf(
+					"The instance with ID %s has no auto-generated SSH key, likely because it was created with an SSH certificate authority. The private_key attribute will be null.",
+					instanceID,
+				),
+			)
+			return InstanceSSHKeyModel{InstanceID: types.StringValue(instanceID), PrivateKey: types.StringNull()}, true
+		}
+
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(diagnostics, apidiag.Read, "Instance SSH Key", "instance SSH key", err)
+		return InstanceSSHKeyModel{}, false
+	}
+
+	return NewInstanceSSHKeyModel(instanceID, sshKey), true
 }
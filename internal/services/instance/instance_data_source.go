@@ -19,12 +19,14 @@ package instance
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
 
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 )
 
 var _ datasource.DataSourceWithConfigure = &InstanceDataSource{}
@@ -39,9 +41,10 @@ func NewInstanceDataSource() datasource.DataSource {
 	return &InstanceDataSource{
 		GenericDataSource: nscale.NewGenericDataSource(
 			nscale.DataSourceAdapter[InstanceModel, computeapi.InstanceRead]{
-				TypeNameSuffix: "_instance",
-				Title:          "Instance",
-				Name:           "instance",
+				TypeNameSuffix:  "_instance",
+				Title:           "Instance",
+				Name:            "instance",
+				RequiredFeature: nscale.ComputeAPIV2,
 				Get: func(ctx context.Context, client *nscale.Client, id string) (*computeapi.InstanceRead, error) {
 					instance, _, err := getInstance(ctx, id, client)
 					return instance, err
@@ -74,7 +77,8 @@ func (s *InstanceDataSource) Schema(
 				Computed:            true,
 			},
 			"user_data": schema.StringAttribute{
-				MarkdownDescription: "The data to pass to the instance at boot time.",
+				MarkdownDescription: "The base64-encoded data to pass to the instance at boot time.",
+				CustomType:          tftypes.Base64StringType{},
 				Computed:            true,
 			},
 			"public_ip": schema.StringAttribute{
@@ -114,8 +118,26 @@ func (s *InstanceDataSource) Schema(
 				MarkdownDescription: "The identifier of the region where the instance is provisioned.",
 				Computed:            true,
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("instance"),
+				Computed:            true,
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the instance was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the instance.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the instance.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the instance was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
@@ -136,8 +158,14 @@ func (s *InstanceDataSource) Schema(
 						ElementType:         types.StringType,
 						Computed:            true,
 					},
+					"allowed_source_addresses": schema.ListAttribute{
+						MarkdownDescription: "A list of CIDR blocks the instance may send traffic from in addition to its own address. Traffic from these addresses egresses without SNAT.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
 					"allowed_destinations": schema.ListAttribute{
-						MarkdownDescription: "A list of CIDR blocks that are allowed to egress from the instance without SNAT.",
+						MarkdownDescription: "The deprecated name of `allowed_source_addresses`, which it is equivalent to.",
+						DeprecationMessage:  "Use allowed_source_addresses instead. The allowed_destinations attribute will be removed in the next major release.",
 						ElementType:         types.StringType,
 						Computed:            true,
 					},
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
 
@@ -104,6 +105,10 @@ func (s *InstanceFlavorDataSource) Schema(
 				MarkdownDescription: "The disk storage allocated to the instance flavor, in gigabytes.",
 				Computed:            true,
 			},
+			"baremetal": schema.BoolAttribute{
+				MarkdownDescription: "Whether the instance flavor provisions a dedicated bare-metal machine rather than a virtual machine. Bare-metal instances take longer to boot.",
+				Computed:            true,
+			},
 			"region_id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the region where the instance flavor is available. If not specified, this defaults to the region ID configured in the provider.",
 				Optional:            true,
@@ -165,10 +170,7 @@ func (s *InstanceFlavorDataSource) Read(
 		regionID,
 	)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Read Instance Flavor",
-			fmt.Sprintf("An error occurred while retrieving the instance flavor: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Instance Flavor", "instance flavor", err)
 		return
 	}
 	defer flavorListResponse.Body.Close()
@@ -176,10 +178,7 @@ func (s *InstanceFlavorDataSource) Read(
 	flavors, err := nscale.ReadJSONResponseValue[[]regionapi.Flavor](flavorListResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Read Instance Flavor",
-			fmt.Sprintf("An error occurred while retrieving the instance flavor: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Instance Flavor", "instance flavor", err)
 		return
 	}
 
@@ -42,6 +42,7 @@ func TestAccInstanceFlavorDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.nscale_instance_flavor.test", "cpus"),
 					resource.TestCheckResourceAttrSet("data.nscale_instance_flavor.test", "memory_size"),
 					resource.TestCheckResourceAttrSet("data.nscale_instance_flavor.test", "disk_size"),
+					resource.TestCheckResourceAttrSet("data.nscale_instance_flavor.test", "baremetal"),
 					// region_id is Optional+Computed and falls back to the
 					// provider-configured region when not set in config.
 					resource.TestCheckResourceAttr(
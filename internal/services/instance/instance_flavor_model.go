@@ -29,6 +29,7 @@ type InstanceFlavorModel struct {
 	CPUs        types.Int64  `tfsdk:"cpus"`
 	MemorySize  types.Int64  `tfsdk:"memory_size"`
 	DiskSize    types.Int64  `tfsdk:"disk_size"`
+	Baremetal   types.Bool   `tfsdk:"baremetal"`
 	GPU         types.Object `tfsdk:"gpu"`
 	RegionID    types.String `tfsdk:"region_id"`
 }
@@ -46,6 +47,7 @@ func NewInstanceFlavorModel(source *regionapi.Flavor, regionID string) InstanceF
 		CPUs:        types.Int64Value(int64(source.Spec.Cpus)),
 		MemorySize:  types.Int64Value(int64(source.Spec.Memory)),
 		DiskSize:    types.Int64Value(int64(source.Spec.Disk)),
+		Baremetal:   types.BoolValue(source.Spec.Baremetal != nil && *source.Spec.Baremetal),
 		GPU:         gpu,
 		RegionID:    types.StringValue(regionID),
 	}
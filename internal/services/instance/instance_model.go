@@ -18,8 +18,8 @@ package instance
 
 import (
 	"context"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -33,27 +33,31 @@ import (
 )
 
 type InstanceModel struct {
-	ID                        types.String `tfsdk:"id"`
-	Name                      types.String `tfsdk:"name"`
-	Description               types.String `tfsdk:"description"`
-	NetworkInterface          types.Object `tfsdk:"network_interface"`
-	UserData                  types.String `tfsdk:"user_data"`
-	PublicIP                  types.String `tfsdk:"public_ip"`
-	PrivateIP                 types.String `tfsdk:"private_ip"`
-	PowerState                types.String `tfsdk:"power_state"`
-	ImageID                   types.String `tfsdk:"image_id"`
-	FlavorID                  types.String `tfsdk:"flavor_id"`
-	SSHCertificateAuthorityID types.String `tfsdk:"ssh_certificate_authority_id"`
-	Tags                      types.Map    `tfsdk:"tags"`
-	ProjectID                 types.String `tfsdk:"project_id"`
-	RegionID                  types.String `tfsdk:"region_id"`
-	CreationTime              types.String `tfsdk:"creation_time"`
+	ID                        types.String              `tfsdk:"id"`
+	Name                      types.String              `tfsdk:"name"`
+	Description               types.String              `tfsdk:"description"`
+	NetworkInterface          types.Object              `tfsdk:"network_interface"`
+	UserData                  tftypes.Base64StringValue `tfsdk:"user_data"`
+	PublicIP                  types.String              `tfsdk:"public_ip"`
+	PrivateIP                 types.String              `tfsdk:"private_ip"`
+	PowerState                types.String              `tfsdk:"power_state"`
+	ImageID                   types.String              `tfsdk:"image_id"`
+	FlavorID                  types.String              `tfsdk:"flavor_id"`
+	SSHCertificateAuthorityID types.String              `tfsdk:"ssh_certificate_authority_id"`
+	Tags                      types.Map                 `tfsdk:"tags"`
+	ProjectID                 types.String              `tfsdk:"project_id"`
+	RegionID                  types.String              `tfsdk:"region_id"`
+	SpecFingerprint           types.String              `tfsdk:"spec_fingerprint"`
+	CreationTime              timetypes.RFC3339         `tfsdk:"creation_time"`
+	CreatedBy                 types.String              `tfsdk:"created_by"`
+	ModifiedBy                types.String              `tfsdk:"modified_by"`
+	LastModifiedTime          timetypes.RFC3339         `tfsdk:"last_modified_time"`
 }
 
 func NewInstanceModel(source *computeapi.InstanceRead) InstanceModel {
-	userData := types.StringNull()
+	userData := tftypes.NewBase64StringNull()
 	if source.Spec.UserData != nil {
-		userData = types.StringValue(string(*source.Spec.UserData))
+		userData = tftypes.NewBase64StringValue(string(*source.Spec.UserData))
 	}
 
 	powerState := types.StringNull()
@@ -78,7 +82,11 @@ func NewInstanceModel(source *computeapi.InstanceRead) InstanceModel {
 		Tags:                      tftypes.TagMapValueMust(tags),
 		ProjectID:                 types.StringValue(source.Metadata.ProjectId),
 		RegionID:                  types.StringValue(source.Status.RegionId),
-		CreationTime:              types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		SpecFingerprint:           nscale.SpecFingerprint(source.Spec),
+		CreationTime:              timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:                 types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:                types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:          timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
 
@@ -107,7 +115,7 @@ func (m *InstanceModel) NscaleInstanceCreateParams(
 	}
 
 	var userData *[]byte
-	if value := m.UserData.ValueString(); value != "" {
+	if value := m.UserData.CanonicalValueString(); value != "" {
 		temp := []byte(value)
 		userData = &temp
 	}
@@ -156,7 +164,7 @@ func (m *InstanceModel) NscaleInstanceUpdateParams() (computeapi.InstanceUpdate,
 	}
 
 	var userData *[]byte
-	if value := m.UserData.ValueString(); value != "" {
+	if value := m.UserData.CanonicalValueString(); value != "" {
 		temp := []byte(value)
 		userData = &temp
 	}
@@ -181,18 +189,20 @@ func (m *InstanceModel) NscaleInstanceUpdateParams() (computeapi.InstanceUpdate,
 
 var InstanceNetworkInterfaceModelAttributeType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
-		"network_id":           types.StringType,
-		"enable_public_ip":     types.BoolType,
-		"security_group_ids":   types.ListType{ElemType: types.StringType},
-		"allowed_destinations": types.ListType{ElemType: types.StringType},
+		"network_id":               types.StringType,
+		"enable_public_ip":         types.BoolType,
+		"security_group_ids":       types.ListType{ElemType: types.StringType},
+		"allowed_source_addresses": types.ListType{ElemType: types.StringType},
+		"allowed_destinations":     types.ListType{ElemType: types.StringType},
 	},
 }
 
 type InstanceNetworkInterfaceModel struct {
-	NetworkID           types.String `tfsdk:"network_id"`
-	EnablePublicIP      types.Bool   `tfsdk:"enable_public_ip"`
-	SecurityGroupIDs    types.List   `tfsdk:"security_group_ids"`
-	AllowedDestinations types.List   `tfsdk:"allowed_destinations"`
+	NetworkID              types.String `tfsdk:"network_id"`
+	EnablePublicIP         types.Bool   `tfsdk:"enable_public_ip"`
+	SecurityGroupIDs       types.List   `tfsdk:"security_group_ids"`
+	AllowedSourceAddresses types.List   `tfsdk:"allowed_source_addresses"`
+	AllowedDestinations    types.List   `tfsdk:"allowed_destinations"`
 }
 
 func NewInstanceNetworkInterfaceModel(spec computeapi.InstanceSpec, status computeapi.InstanceStatus) types.Object {
@@ -206,28 +216,68 @@ func NewInstanceNetworkInterfaceModel(spec computeapi.InstanceSpec, status compu
 		}
 	}
 
-	var allowedDestinations []attr.Value
-	if allowedSourceAddresses := spec.Networking.AllowedSourceAddresses; allowedSourceAddresses != nil {
-		allowedDestinations = make([]attr.Value, 0, len(*allowedSourceAddresses))
-		for _, allowedSourceAddress := range *allowedSourceAddresses {
-			allowedDestinations = append(allowedDestinations, types.StringValue(allowedSourceAddress))
+	var allowedSourceAddresses []attr.Value
+	if addresses := spec.Networking.AllowedSourceAddresses; addresses != nil {
+		allowedSourceAddresses = make([]attr.Value, 0, len(*addresses))
+		for _, address := range *addresses {
+			allowedSourceAddresses = append(allowedSourceAddresses, types.StringValue(address))
 		}
 	}
 
 	return types.ObjectValueMust(
 		InstanceNetworkInterfaceModelAttributeType.AttrTypes,
 		map[string]attr.Value{
-			"network_id":           types.StringValue(status.NetworkId),
-			"enable_public_ip":     enablePublicIP,
-			"security_group_ids":   tftypes.NullableListValueMust(types.StringType, securityGroupIDs),
-			"allowed_destinations": tftypes.NullableListValueMust(types.StringType, allowedDestinations),
+			"network_id":               types.StringValue(status.NetworkId),
+			"enable_public_ip":         enablePublicIP,
+			"security_group_ids":       tftypes.NullableListValueMust(types.StringType, securityGroupIDs),
+			"allowed_source_addresses": tftypes.NullableListValueMust(types.StringType, allowedSourceAddresses),
+			"allowed_destinations":     tftypes.NullableListValueMust(types.StringType, allowedSourceAddresses),
 		},
 	)
 }
 
+// KeepAllowedSourceAddressesAttribute returns networkInterface, read from the
+// API with the allowed source addresses under both their current and
+// deprecated attribute names, with only the name prior uses set, so neither
+// name shows a diff. allowed_source_addresses is used when prior uses neither.
+func KeepAllowedSourceAddressesAttribute(networkInterface, prior types.Object) types.Object {
+	if networkInterface.IsNull() || networkInterface.IsUnknown() {
+		return networkInterface
+	}
+
+	unused := "allowed_destinations"
+	if destinations, ok := prior.Attributes()["allowed_destinations"].(types.List); ok && !destinations.IsNull() {
+		unused = "allowed_source_addresses"
+	}
+
+	attributes := networkInterface.Attributes()
+	attributes[unused] = types.ListNull(types.StringType)
+
+	return types.ObjectValueMust(InstanceNetworkInterfaceModelAttributeType.AttrTypes, attributes)
+}
+
+// PublicIPSettled reports whether source has a public IP address exactly when
+// the model enables one. The API attaches and detaches the address some time
+// after an update is observed. An unset enable_public_ip is left to the API.
+func (m *InstanceModel) PublicIPSettled(source *computeapi.InstanceRead) bool {
+	enabled, ok := m.NetworkInterface.Attributes()["enable_public_ip"].(types.Bool)
+	if !ok || enabled.IsNull() || enabled.IsUnknown() {
+		return true
+	}
+
+	return enabled.ValueBool() == (source.Status.PublicIP != nil)
+}
+
 func (m *InstanceNetworkInterfaceModel) NscaleInstanceNetworking() (computeapi.InstanceNetworking, diag.Diagnostics) {
+	// allowed_destinations is the deprecated name of allowed_source_addresses;
+	// the schema allows at most one of them to be set.
+	allowedSourceAddressList := m.AllowedSourceAddresses
+	if allowedSourceAddressList.IsNull() {
+		allowedSourceAddressList = m.AllowedDestinations
+	}
+
 	var allowedSourceAddresses []string
-	if diagnostics := m.AllowedDestinations.ElementsAs(
+	if diagnostics := allowedSourceAddressList.ElementsAs(
 		context.TODO(),
 		&allowedSourceAddresses,
 		false,
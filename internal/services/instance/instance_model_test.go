@@ -0,0 +1,152 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/pointer"
+)
+
+func testNetworkInterface(allowedSourceAddresses, allowedDestinations types.List) types.Object {
+	return types.ObjectValueMust(InstanceNetworkInterfaceModelAttributeType.AttrTypes, map[string]attr.Value{
+		"network_id":               types.StringValue("network"),
+		"enable_public_ip":         types.BoolNull(),
+		"security_group_ids":       types.ListNull(types.StringType),
+		"allowed_source_addresses": allowedSourceAddresses,
+		"allowed_destinations":     allowedDestinations,
+	})
+}
+
+func TestKeepAllowedSourceAddressesAttribute(t *testing.T) {
+	unset := types.ListNull(types.StringType)
+	configured := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.1.0.0/16")})
+
+	read := NewInstanceNetworkInterfaceModel(
+		computeapi.InstanceSpec{
+			Networking: &computeapi.InstanceNetworking{
+				AllowedSourceAddresses: pointer.ReferenceSlice([]string{"10.0.0.0/16"}),
+			},
+		},
+		computeapi.InstanceStatus{NetworkId: "network"},
+	)
+
+	testCases := []struct {
+		name            string
+		prior           types.Object
+		wantCurrentName bool
+	}{
+		{
+			name:            "current name",
+			prior:           testNetworkInterface(configured, unset),
+			wantCurrentName: true,
+		},
+		{
+			name:  "deprecated name",
+			prior: testNetworkInterface(unset, configured),
+		},
+		{
+			name:            "neither name",
+			prior:           testNetworkInterface(unset, unset),
+			wantCurrentName: true,
+		},
+		{
+			name:            "imported",
+			prior:           types.ObjectNull(InstanceNetworkInterfaceModelAttributeType.AttrTypes),
+			wantCurrentName: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := KeepAllowedSourceAddressesAttribute(read, testCase.prior).Attributes()
+
+			set, unused := "allowed_source_addresses", "allowed_destinations"
+			if !testCase.wantCurrentName {
+				set, unused = unused, set
+			}
+
+			if !got[unused].IsNull() {
+				t.Fatalf("%s = %s, want null", unused, got[unused])
+			}
+
+			want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/16")})
+			if !got[set].Equal(want) {
+				t.Fatalf("%s = %s, want %s", set, got[set], want)
+			}
+		})
+	}
+}
+
+func TestNscaleInstanceNetworkingAllowedSourceAddresses(t *testing.T) {
+	unset := types.ListNull(types.StringType)
+	addresses := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/16")})
+
+	for _, model := range []InstanceNetworkInterfaceModel{
+		{SecurityGroupIDs: unset, AllowedSourceAddresses: addresses, AllowedDestinations: unset},
+		{SecurityGroupIDs: unset, AllowedSourceAddresses: unset, AllowedDestinations: addresses},
+	} {
+		networking, diagnostics := model.NscaleInstanceNetworking()
+		if diagnostics.HasError() {
+			t.Fatalf("NscaleInstanceNetworking() error: %v", diagnostics)
+		}
+
+		if networking.AllowedSourceAddresses == nil || len(*networking.AllowedSourceAddresses) != 1 ||
+			(*networking.AllowedSourceAddresses)[0] != "10.0.0.0/16" {
+			t.Fatalf("AllowedSourceAddresses = %v, want [10.0.0.0/16]", networking.AllowedSourceAddresses)
+		}
+	}
+}
+
+func TestPublicIPSettled(t *testing.T) {
+	address := "203.0.113.10"
+
+	testCases := []struct {
+		name     string
+		enabled  types.Bool
+		publicIP *string
+		want     bool
+	}{
+		{name: "attached", enabled: types.BoolValue(true), publicIP: &address, want: true},
+		{name: "attaching", enabled: types.BoolValue(true)},
+		{name: "detaching", enabled: types.BoolValue(false), publicIP: &address},
+		{name: "detached", enabled: types.BoolValue(false), want: true},
+		{name: "left to the API", enabled: types.BoolNull(), publicIP: &address, want: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			attributes := testNetworkInterface(types.ListNull(types.StringType), types.ListNull(types.StringType)).Attributes()
+			attributes["enable_public_ip"] = testCase.enabled
+
+			model := InstanceModel{
+				NetworkInterface: types.ObjectValueMust(InstanceNetworkInterfaceModelAttributeType.AttrTypes, attributes),
+			}
+
+			instance := &computeapi.InstanceRead{}
+			instance.Status.PublicIP = testCase.publicIP
+
+			if got := model.PublicIPSettled(instance); got != testCase.want {
+				t.Fatalf("PublicIPSettled() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -17,14 +17,21 @@ limitations under the License.
 package instance
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -33,7 +40,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	computeapi "github.com/nscaledev/nscale-sdk-go/compute"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -41,12 +50,32 @@ var (
 	_ resource.Resource                = &InstanceResource{}
 	_ resource.ResourceWithConfigure   = &InstanceResource{}
 	_ resource.ResourceWithImportState = &InstanceResource{}
+	_ resource.ResourceWithModifyPlan  = &InstanceResource{}
 )
 
 type InstanceResourceModel struct {
 	InstanceModel
 
-	Timeouts tftimeouts.Value `tfsdk:"timeouts"`
+	ExtraSpecJSON jsontypes.Normalized `tfsdk:"extra_spec_json"`
+	SSHConnection types.Object         `tfsdk:"ssh_connection"`
+	Timeouts      tftimeouts.Value     `tfsdk:"timeouts"`
+}
+
+// instanceManagedFields are the fields of the instance spec set from the
+// resource's attributes, which extra_spec_json may not override.
+//
+//nolint:gochecknoglobals // constant list.
+var instanceManagedFields = []string{
+	"flavorId",
+	"imageId",
+	"networkId",
+	"networking.allowedSourceAddresses",
+	"networking.publicIP",
+	"networking.securityGroups",
+	"organizationId",
+	"projectId",
+	"sshCertificateAuthorityId",
+	"userData",
 }
 
 // InstanceResource embeds the generic CRUD base; only Schema and the adapter
@@ -65,12 +94,13 @@ func NewInstanceResource() resource.Resource {
 // the generic resource skeleton.
 func instanceAdapter() nscale.ResourceAdapter[InstanceResourceModel, computeapi.InstanceRead] {
 	return nscale.ResourceAdapter[InstanceResourceModel, computeapi.InstanceRead]{
-		TypeNameSuffix: "_instance",
-		Title:          "Instance",
-		Name:           "instance",
-		Create:         instanceCreate,
-		Update:         instanceUpdate,
-		Delete:         instanceDelete,
+		TypeNameSuffix:  "_instance",
+		Title:           "Instance",
+		Name:            "instance",
+		RequiredFeature: nscale.ComputeAPIV2,
+		Create:          instanceCreate,
+		Update:          instanceUpdate,
+		Delete:          instanceDelete,
 		Get: func(
 			ctx context.Context,
 			client *nscale.Client,
@@ -79,10 +109,22 @@ func instanceAdapter() nscale.ResourceAdapter[InstanceResourceModel, computeapi.
 			return nscale.AdaptProjectScoped(getInstance(ctx, id, client))
 		},
 		ToModel: func(api *computeapi.InstanceRead, dst *InstanceResourceModel) {
+			prior := dst.NetworkInterface
+			priorDescription := dst.Description
 			dst.InstanceModel = NewInstanceModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
+			dst.NetworkInterface = KeepAllowedSourceAddressesAttribute(dst.NetworkInterface, prior)
+
+			user, privateKey := nscale.SSHConnectionCredentials(dst.SSHConnection)
+			dst.SSHConnection = nscale.NewSSHConnection(dst.PublicIP, dst.PrivateIP, user, privateKey)
+		},
+		Enrich: instanceEnrich,
+		Settled: func(api *computeapi.InstanceRead, plan InstanceResourceModel) bool {
+			return plan.PublicIPSettled(api)
 		},
 		IDFromModel:       func(m InstanceResourceModel) string { return m.ID.ValueString() },
 		TimeoutsFromModel: func(m InstanceResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:            true,
 	}
 }
 
@@ -113,15 +155,27 @@ func (r *InstanceResource) Schema(
 				Optional:            true,
 			},
 			"user_data": schema.StringAttribute{
-				MarkdownDescription: "The data to pass to the instance at boot time.",
+				MarkdownDescription: "The base64-encoded data to pass to the instance at boot time. Values that decode to the same data, such as ones differing only in padding or line breaks, are not treated as a change.",
+				CustomType:          tftypes.Base64StringType{},
 				Optional:            true,
 				Validators: []validator.String{
 					validators.Base64Validator{},
 				},
 			},
+			"extra_spec_json": schema.StringAttribute{
+				MarkdownDescription: "A JSON object deep-merged into the `spec` of the instance's API requests, to set fields the provider does not model yet. It may not set fields managed by other attributes. The fields it sets are not read back, so changes made outside Terraform are not detected.",
+				CustomType:          jsontypes.NormalizedType{},
+				Optional:            true,
+				Validators: []validator.String{
+					validators.ExtraSpecValidator{ManagedFields: instanceManagedFields},
+				},
+			},
 			"public_ip": schema.StringAttribute{
-				MarkdownDescription: "The public IP address assigned to the instance.",
+				MarkdownDescription: "The public IP address assigned to the instance. It is null while `network_interface.enable_public_ip` is `false`, and toggling that attribute attaches or detaches the address in place.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					publicIPPlanModifier{},
+				},
 			},
 			"private_ip": schema.StringAttribute{
 				MarkdownDescription: "The private IP address assigned to the instance.",
@@ -130,6 +184,25 @@ func (r *InstanceResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"ssh_connection": schema.SingleNestedAttribute{
+				MarkdownDescription: nscale.SSHConnectionDescription("instance") + " Reading the user and private key takes two more API requests each time the instance is read.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The address to connect to.",
+						Computed:            true,
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "The user to connect as.",
+						Computed:            true,
+					},
+					"private_key": schema.StringAttribute{
+						MarkdownDescription: "The SSH private key to authenticate with.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+				},
+			},
 			"power_state": schema.StringAttribute{
 				MarkdownDescription: "The power state of the instance.",
 				Computed:            true,
@@ -174,13 +247,44 @@ func (r *InstanceResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("instance"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					nscale.SpecFingerprintPlanModifier(
+						"network_interface",
+						"user_data",
+						"extra_spec_json",
+						"image_id",
+						"flavor_id",
+						"ssh_certificate_authority_id",
+					),
+				},
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the instance was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the instance.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the instance.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the instance was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"network_interface": schema.SingleNestedBlock{
@@ -205,8 +309,19 @@ func (r *InstanceResource) Schema(
 							listvalidator.SizeAtLeast(1),
 						},
 					},
+					"allowed_source_addresses": schema.ListAttribute{
+						MarkdownDescription: "A list of CIDR blocks the instance may send traffic from in addition to its own address, such as when it routes traffic for other networks. Traffic from these addresses egresses without SNAT.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(validators.CIDRValidator{}),
+							listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("allowed_destinations")),
+						},
+					},
 					"allowed_destinations": schema.ListAttribute{
-						MarkdownDescription: "A list of CIDR blocks that are allowed to egress from the instance without SNAT.",
+						MarkdownDescription: "The deprecated name of `allowed_source_addresses`, which it is equivalent to.",
+						DeprecationMessage:  "Use allowed_source_addresses instead. The allowed_destinations attribute will be removed in the next major release.",
 						ElementType:         types.StringType,
 						Optional:            true,
 						Validators: []validator.List{
@@ -228,6 +343,46 @@ func (r *InstanceResource) Schema(
 	}
 }
 
+// instanceEnrich sets the user and private key of the instance's SSH
+// connection, which are read from the image catalog and the instance's SSH
+// key, keeping either as it is when it cannot be read.
+func instanceEnrich(ctx context.Context, client *nscale.Client, dst *InstanceResourceModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	user, privateKey := nscale.SSHConnectionCredentials(dst.SSHConnection)
+
+	users, err := client.ImageLoginUsers(ctx, dst.RegionID.ValueString())
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		nscale.AddSSHConnectionWarning(&diagnostics, "instance", err)
+	} else {
+		user = nscale.LoginUser(users, dst.ImageID.ValueString(), user)
+	}
+
+	switch {
+	case client.DisallowSensitiveInState, !dst.SSHCertificateAuthorityID.IsNull():
+		// An instance created with an SSH certificate authority has no key.
+		privateKey = types.StringNull()
+	default:
+		if key, keyErr := readInstancePrivateKey(ctx, client, dst.ID.ValueString()); keyErr != nil {
+			nscale.TerraformDebugLogAPIResponseBody(ctx, keyErr)
+			diagnostics.AddWarning(
+				"Unable to Read Instance SSH Key",
+				fmt.Sprintf(
+					"The SSH key of the instance could not be read, so the private key in ssh_connection is unchanged: %s",
+					keyErr,
+				),
+			)
+		} else {
+			privateKey = key
+		}
+	}
+
+	dst.SSHConnection = nscale.NewSSHConnection(dst.PublicIP, dst.PrivateIP, user, privateKey)
+
+	return diagnostics
+}
+
 func instanceCreate(
 	ctx context.Context,
 	client *nscale.Client,
@@ -247,23 +402,38 @@ func instanceCreate(
 		return nil, diagnostics
 	}
 
-	createResponse, err := client.Compute.PostApiV2Instances(ctx, params)
+	body, err := instanceRequestBody(params, plan.ExtraSpecJSON)
 	if err != nil {
-		diagnostics.AddError(
+		diagnostics.AddAttributeError(
+			path.Root("extra_spec_json"),
 			"Failed to Create Instance",
-			fmt.Sprintf("An error occurred while creating the instance: %s", err),
+			fmt.Sprintf("An error occurred while merging the extra spec into the instance: %s", err),
 		)
 		return nil, diagnostics
 	}
+
+	// A retried create sends the body again.
+	payload, err := io.ReadAll(body)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Create, "Instance", "instance", err)
+		return nil, diagnostics
+	}
+
+	createResponse, err := client.RetryDependencyNotFound(ctx, plan.Timeouts,
+		func(ctx context.Context) (*http.Response, error) {
+			return client.Compute.PostApiV2InstancesWithBody(ctx, nscale.ExtraSpecContentType, bytes.NewReader(payload))
+		},
+	)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Create, "Instance", "instance", err)
+		return nil, diagnostics
+	}
 	defer createResponse.Body.Close()
 
 	instance, err := nscale.ReadJSONResponsePointer[computeapi.InstanceRead](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Create Instance",
-			fmt.Sprintf("An error occurred while creating the instance: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Instance", "instance", err)
 		return nil, diagnostics
 	}
 
@@ -274,6 +444,7 @@ func instanceUpdate(
 	ctx context.Context,
 	client *nscale.Client,
 	id string,
+	_ *InstanceResourceModel,
 	plan InstanceResourceModel,
 ) (string, diag.Diagnostics) {
 	params, diagnostics := plan.NscaleInstanceUpdateParams()
@@ -285,28 +456,52 @@ func instanceUpdate(
 	// the cache-backed API before reading back a terminal status.
 	operationTagKey := nscale.WriteOperationTag(&params.Metadata)
 
-	updateResponse, err := client.Compute.PutApiV2InstancesInstanceID(ctx, id, params)
+	body, err := instanceRequestBody(params, plan.ExtraSpecJSON)
 	if err != nil {
-		diagnostics.AddError(
+		diagnostics.AddAttributeError(
+			path.Root("extra_spec_json"),
 			"Failed to Update Instance",
-			fmt.Sprintf("An error occurred while updating the instance: %s", err),
+			fmt.Sprintf("An error occurred while merging the extra spec into the instance: %s", err),
 		)
 		return "", diagnostics
 	}
+
+	updateResponse, err := client.Compute.PutApiV2InstancesInstanceIDWithBody(ctx, id, nscale.ExtraSpecContentType, body)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Update, "Instance", "instance", err)
+		return "", diagnostics
+	}
 	defer updateResponse.Body.Close()
 
 	if _, readErr := nscale.ReadJSONResponsePointer[computeapi.InstanceRead](updateResponse); readErr != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, readErr)
-		diagnostics.AddError(
-			"Failed to Update Instance",
-			fmt.Sprintf("An error occurred while updating the instance: %s", readErr),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Instance", "instance", readErr)
 		return "", diagnostics
 	}
 
 	return operationTagKey, nil
 }
 
+// instanceRequestBody encodes a create or update request with the extra spec
+// merged into its spec.
+func instanceRequestBody(params any, extraSpec jsontypes.Normalized) (io.Reader, error) {
+	body, err := nscale.JSONObject(params)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, ok := body["spec"].(map[string]any)
+	if !ok {
+		return nil, errors.New("the request has no spec")
+	}
+
+	if err = nscale.MergeExtraSpec(spec, extraSpec.ValueString()); err != nil {
+		return nil, err
+	}
+
+	return nscale.JSONBody(body)
+}
+
 func instanceDelete(ctx context.Context, client *nscale.Client, id string) error {
 	deleteResponse, err := client.Compute.DeleteApiV2InstancesInstanceID(ctx, id)
 	if err != nil {
@@ -19,12 +19,9 @@ package instance
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
@@ -61,6 +58,8 @@ func (s *InstanceSSHKeyDataSource) Configure(
 	}
 
 	s.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.ComputeAPIV2, "Instance SSH key")...)
 }
 
 func (s *InstanceSSHKeyDataSource) Metadata(
@@ -97,47 +96,21 @@ func (s *InstanceSSHKeyDataSource) Read(
 	request datasource.ReadRequest,
 	response *datasource.ReadResponse,
 ) {
+	if s.client.DisallowSensitiveInState {
+		nscale.AddSensitiveInStateError(&response.Diagnostics, "the instance SSH key", "nscale_instance_ssh_key")
+		return
+	}
+
 	data, diagnostics := nscale.ReadTerraformState[InstanceSSHKeyModel](ctx, request.Config.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
 
-	instanceID := data.InstanceID.ValueString()
-
-	sshKeyResponse, err := s.client.Compute.GetApiV2InstancesInstanceIDSshkey(ctx, instanceID)
-	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Read Instance SSH Key",
-			fmt.Sprintf("An error occurred while retrieving the instance SSH key: %s", err),
-		)
-		return
-	}
-	defer sshKeyResponse.Body.Close()
-
-	sshKey, err := nscale.ReadJSONResponsePointer[regionapi.SshKey](sshKeyResponse)
-	if err != nil {
-		if e, ok := nscale.AsAPIError(err); ok && e.StatusCode == http.StatusNotFound {
-			response.Diagnostics.AddWarning(
-				"Instance SSH Key Not Available",
-				fmt.Sprintf(
-					"The instance with ID %s has no auto-generated SSH key, likely because it was created with an SSH certificate authority. The private_key attribute will be null.",
-					instanceID,
-				),
-			)
-			data.PrivateKey = types.StringNull()
-			response.Diagnostics.Append(response.State.Set(ctx, data)...)
-			return
-		}
-
-		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Read Instance SSH Key",
-			fmt.Sprintf("An error occurred while retrieving the instance SSH key: %s", err),
-		)
+	data, ok := readInstanceSSHKey(ctx, s.client, data.InstanceID.ValueString(), &response.Diagnostics)
+	if !ok {
 		return
 	}
 
-	data = NewInstanceSSHKeyModel(instanceID, sshKey)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &InstanceSSHKeyEphemeralResource{}
+
+// InstanceSSHKeyEphemeralResource reads the SSH key of an instance without
+// storing it in state, unlike the data source of the same name.
+type InstanceSSHKeyEphemeralResource struct {
+	client *nscale.Client
+}
+
+func NewInstanceSSHKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &InstanceSSHKeyEphemeralResource{}
+}
+
+func (e *InstanceSSHKeyEphemeralResource) Configure(
+	ctx context.Context,
+	request ephemeral.ConfigureRequest,
+	response *ephemeral.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	e.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.ComputeAPIV2, "Instance SSH key")...)
+}
+
+func (e *InstanceSSHKeyEphemeralResource) Metadata(
+	ctx context.Context,
+	request ephemeral.MetadataRequest,
+	response *ephemeral.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_instance_ssh_key"
+}
+
+func (e *InstanceSSHKeyEphemeralResource) Schema(
+	ctx context.Context,
+	request ephemeral.SchemaRequest,
+	response *ephemeral.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Instance SSH Key, read without storing it in Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the instance associated with the SSH key.",
+				Required:            true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The private SSH key for accessing the instance.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (e *InstanceSSHKeyEphemeralResource) Open(
+	ctx context.Context,
+	request ephemeral.OpenRequest,
+	response *ephemeral.OpenResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[InstanceSSHKeyModel](ctx, request.Config.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	data, ok := readInstanceSSHKey(ctx, e.client, data.InstanceID.ValueString(), &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, data)...)
+}
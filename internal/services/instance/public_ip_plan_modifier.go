@@ -0,0 +1,73 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// publicIPPlanModifier plans the public IP address as null when the instance
+// is not to have one, and as it is in state while enable_public_ip does not
+// change, so only toggling it plans a new address.
+type publicIPPlanModifier struct{}
+
+func (m publicIPPlanModifier) Description(_ context.Context) string {
+	return "Plans the public IP address from whether the instance is to have one."
+}
+
+func (m publicIPPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m publicIPPlanModifier) PlanModifyString(
+	ctx context.Context,
+	request planmodifier.StringRequest,
+	response *planmodifier.StringResponse,
+) {
+	if !request.PlanValue.IsUnknown() {
+		return
+	}
+
+	enabledPath := path.Root("network_interface").AtName("enable_public_ip")
+
+	var planned types.Bool
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, enabledPath, &planned)...)
+	if response.Diagnostics.HasError() || planned.IsUnknown() {
+		return
+	}
+
+	if !planned.IsNull() && !planned.ValueBool() {
+		response.PlanValue = types.StringNull()
+		return
+	}
+
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var stored types.Bool
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, enabledPath, &stored)...)
+	if response.Diagnostics.HasError() || !planned.Equal(stored) {
+		return
+	}
+
+	response.PlanValue = request.StateValue
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testPublicIPModel struct {
+	PublicIP         types.String `tfsdk:"public_ip"`
+	NetworkInterface struct {
+		EnablePublicIP types.Bool `tfsdk:"enable_public_ip"`
+	} `tfsdk:"network_interface"`
+}
+
+func TestPublicIPPlanModifier(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"public_ip": schema.StringAttribute{Computed: true},
+			"network_interface": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"enable_public_ip": schema.BoolAttribute{Optional: true},
+				},
+			},
+		},
+	}
+
+	address := types.StringValue("203.0.113.10")
+
+	testCases := []struct {
+		name    string
+		create  bool
+		stored  types.Bool
+		planned types.Bool
+		want    types.String
+	}{
+		{name: "create with a public IP", create: true, planned: types.BoolValue(true), want: types.StringUnknown()},
+		{name: "create without a public IP", create: true, planned: types.BoolValue(false), want: types.StringNull()},
+		{name: "unchanged", stored: types.BoolValue(true), planned: types.BoolValue(true), want: address},
+		{name: "unset and unchanged", stored: types.BoolNull(), planned: types.BoolNull(), want: address},
+		{name: "attach", stored: types.BoolValue(false), planned: types.BoolValue(true), want: types.StringUnknown()},
+		{name: "detach", stored: types.BoolValue(true), planned: types.BoolValue(false), want: types.StringNull()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			terraformType := testSchema.Type().TerraformType(ctx)
+
+			state := tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(terraformType, nil)}
+			if !testCase.create {
+				var stored testPublicIPModel
+				stored.PublicIP = address
+				stored.NetworkInterface.EnablePublicIP = testCase.stored
+				if diagnostics := state.Set(ctx, stored); diagnostics.HasError() {
+					t.Fatalf("failed to set state: %v", diagnostics)
+				}
+			}
+
+			var planned testPublicIPModel
+			planned.PublicIP = types.StringUnknown()
+			planned.NetworkInterface.EnablePublicIP = testCase.planned
+			plan := tfsdk.Plan{Schema: testSchema, Raw: tftypes.NewValue(terraformType, nil)}
+			if diagnostics := plan.Set(ctx, planned); diagnostics.HasError() {
+				t.Fatalf("failed to set plan: %v", diagnostics)
+			}
+
+			stateValue := types.StringNull()
+			if !testCase.create {
+				stateValue = address
+			}
+
+			request := planmodifier.StringRequest{
+				Plan:       plan,
+				State:      state,
+				PlanValue:  types.StringUnknown(),
+				StateValue: stateValue,
+			}
+			response := planmodifier.StringResponse{PlanValue: request.PlanValue}
+
+			publicIPPlanModifier{}.PlanModifyString(ctx, request, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("PlanModifyString() error: %v", response.Diagnostics)
+			}
+
+			if !response.PlanValue.Equal(testCase.want) {
+				t.Fatalf("planned public_ip = %v, want %v", response.PlanValue, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	legacycomputeapi "github.com/unikorn-cloud/compute/pkg/openapi"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+var _ datasource.DataSourceWithConfigure = &KeypairDataSource{}
+
+// KeypairDataSource exposes the public half of the SSH key generated for a
+// compute cluster or an instance, so it can be distributed, for example to a
+// bastion, without storing the private key in the state.
+type KeypairDataSource struct {
+	client *nscale.Client
+}
+
+func NewKeypairDataSource() datasource.DataSource {
+	return &KeypairDataSource{}
+}
+
+func (s *KeypairDataSource) Configure(
+	ctx context.Context,
+	request datasource.ConfigureRequest,
+	response *datasource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	s.client = client
+}
+
+func (s *KeypairDataSource) Metadata(
+	ctx context.Context,
+	request datasource.MetadataRequest,
+	response *datasource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_keypair"
+}
+
+func (s *KeypairDataSource) Schema(
+	ctx context.Context,
+	request datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Keypair",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the compute cluster whose SSH key to read. Exactly one of `cluster_id` and `instance_id` must be set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("instance_id")),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the instance whose SSH key to read.",
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project the compute cluster belongs to. Defaults to the provider's `project_id`. Ignored for instances.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The public SSH key, in the OpenSSH `authorized_keys` format. It is derived from the generated private key by the provider, and the private key is not stored in the state. Null until a compute cluster has provisioned its key, and for instances created with an SSH certificate authority, which have no generated key.",
+				Computed:            true,
+			},
+			"key_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the SSH key, such as `ssh-ed25519` or `ssh-rsa`.",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 fingerprint of the public key, as shown by `ssh-keygen -l`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (s *KeypairDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[KeypairModel](ctx, request.Config.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	var privateKey types.String
+	if !data.ClusterID.IsNull() {
+		privateKey, diagnostics = s.readClusterPrivateKey(ctx, &data)
+	} else {
+		data.ProjectID = types.StringNull()
+		privateKey, diagnostics = s.readInstancePrivateKey(ctx, data.InstanceID.ValueString())
+	}
+
+	response.Diagnostics.Append(diagnostics...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.setPublicKey(privateKey); err != nil {
+		response.Diagnostics.AddError(
+			"Failed to Read Keypair",
+			fmt.Sprintf("The generated SSH private key could not be parsed: %s", err),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// readClusterPrivateKey reads the SSH private key of the compute cluster in
+// the model's project, defaulting the project to the provider's.
+func (s *KeypairDataSource) readClusterPrivateKey(
+	ctx context.Context,
+	data *KeypairModel,
+) (types.String, diag.Diagnostics) {
+	diagnostics := s.client.RequireFeature(ctx, nscale.ComputeClusterAPIV1, "Compute cluster keypair")
+	if diagnostics.HasError() {
+		return types.StringNull(), diagnostics
+	}
+
+	projectID, diagnostics := s.client.ResolveProjectID(data.ProjectID.ValueString())
+	if diagnostics.HasError() {
+		return types.StringNull(), diagnostics
+	}
+	data.ProjectID = types.StringValue(projectID)
+
+	ctx = s.client.WithProjectID(ctx, projectID)
+
+	clusterResponse, err := s.client.LegacyCompute.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(
+		ctx,
+		s.client.OrganizationID,
+		projectID,
+		data.ClusterID.ValueString(),
+	)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Read, "Keypair", "compute cluster", err)
+		return types.StringNull(), diagnostics
+	}
+	defer clusterResponse.Body.Close()
+
+	cluster, err := nscale.ReadJSONResponsePointer[legacycomputeapi.ComputeClusterRead](clusterResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Read, "Keypair", "compute cluster", err)
+		return types.StringNull(), diagnostics
+	}
+
+	if cluster.Status == nil {
+		return types.StringNull(), diagnostics
+	}
+
+	return types.StringPointerValue(cluster.Status.SshPrivateKey), diagnostics
+}
+
+// readInstancePrivateKey reads the SSH private key generated for an instance.
+// An instance created with an SSH certificate authority has none, which is
+// reported as a warning and a null key.
+func (s *KeypairDataSource) readInstancePrivateKey(
+	ctx context.Context,
+	instanceID string,
+) (types.String, diag.Diagnostics) {
+	diagnostics := s.client.RequireFeature(ctx, nscale.ComputeAPIV2, "Instance keypair")
+	if diagnostics.HasError() {
+		return types.StringNull(), diagnostics
+	}
+
+	ctx = s.client.WithInstanceProjectID(ctx, instanceID)
+
+	sshKeyResponse, err := s.client.Compute.GetApiV2InstancesInstanceIDSshkey(ctx, instanceID)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Read, "Keypair", "instance SSH key", err)
+		return types.StringNull(), diagnostics
+	}
+	defer sshKeyResponse.Body.Close()
+
+	sshKey, err := nscale.ReadJSONResponsePointer[regionapi.SshKey](sshKeyResponse)
+	if err != nil {
+		if nscale.IsAPIErrorNotFound(err) {
+			diagnostics.AddWarning(
+				"Instance SSH Key Not Available",
+				fmt.Sprintf(
+					"The instance with ID %s has no auto-generated SSH key, likely because it was created with an SSH certificate authority. The public_key attribute will be null.",
+					instanceID,
+				),
+			)
+			return types.StringNull(), diagnostics
+		}
+
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Read, "Keypair", "instance SSH key", err)
+		return types.StringNull(), diagnostics
+	}
+
+	return types.StringValue(sshKey.PrivateKey), diagnostics
+}
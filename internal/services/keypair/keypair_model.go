@@ -0,0 +1,58 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+type KeypairModel struct {
+	ClusterID   types.String `tfsdk:"cluster_id"`
+	InstanceID  types.String `tfsdk:"instance_id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	KeyType     types.String `tfsdk:"key_type"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+// setPublicKey sets the public half of privateKey, which is derived locally
+// so the private key itself never reaches the state. A null private key, as
+// before a cluster has provisioned its key, leaves the public key null.
+func (m *KeypairModel) setPublicKey(privateKey types.String) error {
+	m.PublicKey = types.StringNull()
+	m.KeyType = types.StringNull()
+	m.Fingerprint = types.StringNull()
+
+	if privateKey.IsNull() || privateKey.ValueString() == "" {
+		return nil
+	}
+
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	publicKey := signer.PublicKey()
+	m.PublicKey = types.StringValue(strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey))))
+	m.KeyType = types.StringValue(publicKey.Type())
+	m.Fingerprint = types.StringValue(ssh.FingerprintSHA256(publicKey))
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSetPublicKey(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	if err != nil {
+		t.Fatalf("failed to convert public key: %v", err)
+	}
+
+	var model KeypairModel
+	if err := model.setPublicKey(types.StringValue(string(pem.EncodeToMemory(block)))); err != nil {
+		t.Fatalf("setPublicKey() error: %v", err)
+	}
+
+	if want := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublicKey))); model.PublicKey.ValueString() != want {
+		t.Fatalf("public_key = %q, want %q", model.PublicKey.ValueString(), want)
+	}
+	if got := model.KeyType.ValueString(); got != ssh.KeyAlgoED25519 {
+		t.Fatalf("key_type = %q, want %q", got, ssh.KeyAlgoED25519)
+	}
+	if got, want := model.Fingerprint.ValueString(), ssh.FingerprintSHA256(sshPublicKey); got != want {
+		t.Fatalf("fingerprint = %q, want %q", got, want)
+	}
+
+	if err := model.setPublicKey(types.StringNull()); err != nil || !model.PublicKey.IsNull() {
+		t.Fatalf("setPublicKey(null) = %v, public_key %v, want a null public key", err, model.PublicKey)
+	}
+
+	if err := model.setPublicKey(types.StringValue("not a key")); err == nil {
+		t.Fatal("setPublicKey() succeeded on an invalid private key")
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	common "github.com/nscaledev/nscale-sdk-go/common"
+	kubernetesapi "github.com/nscaledev/nscale-sdk-go/kubernetes"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+// getKubernetesCluster reads a Kubernetes cluster. The API cannot read a single
+// cluster, so it is found in the list of the organization's clusters.
+func getKubernetesCluster(
+	ctx context.Context,
+	client *nscale.Client,
+	id string,
+) (*kubernetesapi.KubernetesClusterRead, *common.ProjectScopedResourceReadMetadata, error) {
+	clusterListResponse, err := client.Kubernetes.GetApiV1OrganizationsOrganizationIDClusters(
+		ctx,
+		client.OrganizationID,
+		nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer clusterListResponse.Body.Close()
+
+	clusters, err := nscale.ReadJSONResponseValue[kubernetesapi.KubernetesClusters](clusterListResponse)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, cluster := range clusters {
+		if cluster.Metadata.Id == id {
+			return &cluster, &cluster.Metadata, nil
+		}
+	}
+
+	err = &nscale.APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("failed to find kubernetes cluster '%s' in the list response", id),
+	}
+
+	return nil, nil, err
+}
+
+// readKubeconfig reads the kubeconfig of a Kubernetes cluster in a project.
+func readKubeconfig(ctx context.Context, client *nscale.Client, projectID, id string) (string, error) {
+	kubeconfigResponse, err := client.Kubernetes.GetApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterIDKubeconfig(
+		ctx,
+		client.OrganizationID,
+		projectID,
+		id,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer kubeconfigResponse.Body.Close()
+
+	kubeconfig, err := nscale.ReadResponseBody(kubeconfigResponse)
+	if err != nil {
+		return "", err
+	}
+
+	return string(kubeconfig), nil
+}
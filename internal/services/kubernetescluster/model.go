@@ -0,0 +1,286 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescluster
+
+import (
+	"context"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	kubernetesapi "github.com/nscaledev/nscale-sdk-go/kubernetes"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
+)
+
+type KubernetesClusterResourceModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Description        types.String      `tfsdk:"description"`
+	Version            types.String      `tfsdk:"version"`
+	ClusterManagerID   types.String      `tfsdk:"cluster_manager_id"`
+	HardwareEnablement types.Bool        `tfsdk:"hardware_enablement"`
+	ControlPlane       types.Object      `tfsdk:"control_plane"`
+	WorkloadPools      types.List        `tfsdk:"workload_pools"`
+	Kubeconfig         types.String      `tfsdk:"kubeconfig"`
+	Tags               types.Map         `tfsdk:"tags"`
+	ProjectID          types.String      `tfsdk:"project_id"`
+	RegionID           types.String      `tfsdk:"region_id"`
+	ProvisioningStatus types.String      `tfsdk:"provisioning_status"`
+	SpecFingerprint    types.String      `tfsdk:"spec_fingerprint"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy          types.String      `tfsdk:"created_by"`
+	ModifiedBy         types.String      `tfsdk:"modified_by"`
+	LastModifiedTime   timetypes.RFC3339 `tfsdk:"last_modified_time"`
+	Timeouts           tftimeouts.Value  `tfsdk:"timeouts"`
+}
+
+//nolint:gochecknoglobals // constant attribute type.
+var ControlPlaneModelAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"flavor_id": types.StringType,
+		"replicas":  types.Int64Type,
+	},
+}
+
+type ControlPlaneModel struct {
+	FlavorID types.String `tfsdk:"flavor_id"`
+	Replicas types.Int64  `tfsdk:"replicas"`
+}
+
+//nolint:gochecknoglobals // constant attribute type.
+var WorkloadPoolModelAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":         types.StringType,
+		"flavor_id":    types.StringType,
+		"replicas":     types.Int64Type,
+		"min_replicas": types.Int64Type,
+		"disk_size":    types.Int64Type,
+		"labels":       types.MapType{ElemType: types.StringType},
+	},
+}
+
+type WorkloadPoolModel struct {
+	Name        types.String `tfsdk:"name"`
+	FlavorID    types.String `tfsdk:"flavor_id"`
+	Replicas    types.Int64  `tfsdk:"replicas"`
+	MinReplicas types.Int64  `tfsdk:"min_replicas"`
+	DiskSize    types.Int64  `tfsdk:"disk_size"`
+	Labels      types.Map    `tfsdk:"labels"`
+}
+
+// setKubernetesCluster sets the attributes read from the cluster. The
+// kubeconfig is read from its own endpoint and is kept as it is.
+func (m *KubernetesClusterResourceModel) setKubernetesCluster(source *kubernetesapi.KubernetesClusterRead) {
+	spec := source.Spec
+
+	m.ID = types.StringValue(source.Metadata.Id)
+	m.Name = types.StringValue(source.Metadata.Name)
+	m.Description = tftypes.StringWithPriorEmpty(types.StringPointerValue(source.Metadata.Description), m.Description)
+	m.Version = types.StringValue(spec.Version)
+	m.ClusterManagerID = types.StringPointerValue(spec.ClusterManagerId)
+	m.ControlPlane = newControlPlaneModel(spec.ControlPlane)
+	m.WorkloadPools = newWorkloadPoolModels(spec.WorkloadPools)
+	m.Tags = tftypes.TagMapValueMust(nscale.RemoveOperationTags(source.Metadata.Tags))
+	m.ProjectID = types.StringValue(source.Metadata.ProjectId)
+	m.RegionID = types.StringValue(spec.RegionId)
+	m.ProvisioningStatus = types.StringValue(string(source.Metadata.ProvisioningStatus))
+	m.SpecFingerprint = nscale.SpecFingerprint(spec)
+	m.CreationTime = timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime)
+	m.CreatedBy = types.StringPointerValue(source.Metadata.CreatedBy)
+	m.ModifiedBy = types.StringPointerValue(source.Metadata.ModifiedBy)
+	m.LastModifiedTime = timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime)
+
+	m.HardwareEnablement = types.BoolNull()
+	if spec.Features != nil {
+		m.HardwareEnablement = types.BoolValue(spec.Features.HardwareEnablement)
+	}
+
+	if m.Kubeconfig.IsUnknown() {
+		m.Kubeconfig = types.StringNull()
+	}
+}
+
+func newControlPlaneModel(source *kubernetesapi.KubernetesClusterControlPlane) types.Object {
+	if source == nil {
+		return types.ObjectNull(ControlPlaneModelAttributeType.AttrTypes)
+	}
+
+	return types.ObjectValueMust(ControlPlaneModelAttributeType.AttrTypes, map[string]attr.Value{
+		"flavor_id": types.StringValue(source.FlavorId),
+		"replicas":  int64PointerValue(source.Replicas),
+	})
+}
+
+func newWorkloadPoolModels(source kubernetesapi.KubernetesClusterWorkloadPools) types.List {
+	pools := make([]attr.Value, 0, len(source))
+
+	for _, pool := range source {
+		minReplicas := types.Int64Null()
+		if pool.Autoscaling != nil {
+			minReplicas = types.Int64Value(int64(pool.Autoscaling.MinimumReplicas))
+		}
+
+		diskSize := types.Int64Null()
+		if pool.Machine.Disk != nil {
+			diskSize = types.Int64Value(int64(pool.Machine.Disk.Size))
+		}
+
+		labels := types.MapNull(types.StringType)
+		if pool.Labels != nil {
+			elements := make(map[string]attr.Value, len(*pool.Labels))
+			for key, value := range *pool.Labels {
+				elements[key] = types.StringValue(value)
+			}
+			labels = types.MapValueMust(types.StringType, elements)
+		}
+
+		pools = append(pools, types.ObjectValueMust(WorkloadPoolModelAttributeType.AttrTypes, map[string]attr.Value{
+			"name":         types.StringValue(pool.Name),
+			"flavor_id":    types.StringPointerValue(pool.Machine.FlavorId),
+			"replicas":     int64PointerValue(pool.Machine.Replicas),
+			"min_replicas": minReplicas,
+			"disk_size":    diskSize,
+			"labels":       labels,
+		}))
+	}
+
+	return types.ListValueMust(WorkloadPoolModelAttributeType, pools)
+}
+
+func int64PointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*value))
+}
+
+func intPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	converted := int(value.ValueInt64())
+	return &converted
+}
+
+// NscaleKubernetesCluster returns the cluster to create or update, with
+// operation tags removed from its tags. Attributes left to the API's defaults
+// are not sent.
+func (m *KubernetesClusterResourceModel) NscaleKubernetesCluster() (
+	kubernetesapi.KubernetesClusterWrite,
+	diag.Diagnostics,
+) {
+	tags, diagnostics := tftypes.ValueTagListPointer(m.Tags)
+	if diagnostics.HasError() {
+		return kubernetesapi.KubernetesClusterWrite{}, diagnostics
+	}
+
+	pools, diagnostics := m.nscaleWorkloadPools()
+	if diagnostics.HasError() {
+		return kubernetesapi.KubernetesClusterWrite{}, diagnostics
+	}
+
+	spec := kubernetesapi.KubernetesClusterSpec{
+		RegionId:      m.RegionID.ValueString(),
+		Version:       m.Version.ValueString(),
+		WorkloadPools: pools,
+	}
+
+	// Without a cluster manager, the API creates one for the cluster.
+	if !m.ClusterManagerID.IsNull() && !m.ClusterManagerID.IsUnknown() {
+		spec.ClusterManagerId = m.ClusterManagerID.ValueStringPointer()
+	}
+
+	if !m.HardwareEnablement.IsNull() && !m.HardwareEnablement.IsUnknown() {
+		spec.Features = &kubernetesapi.KubernetesClusterFeatures{
+			HardwareEnablement: m.HardwareEnablement.ValueBool(),
+		}
+	}
+
+	if !m.ControlPlane.IsNull() && !m.ControlPlane.IsUnknown() {
+		var controlPlane ControlPlaneModel
+		diagnostics = m.ControlPlane.As(context.TODO(), &controlPlane, basetypes.ObjectAsOptions{})
+		if diagnostics.HasError() {
+			return kubernetesapi.KubernetesClusterWrite{}, diagnostics
+		}
+
+		spec.ControlPlane = &kubernetesapi.KubernetesClusterControlPlane{
+			FlavorId: controlPlane.FlavorID.ValueString(),
+			Replicas: intPointer(controlPlane.Replicas),
+		}
+	}
+
+	cluster := kubernetesapi.KubernetesClusterWrite{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Description: m.Description.ValueStringPointer(),
+			Name:        m.Name.ValueString(),
+			Tags:        nscale.RemoveOperationTags(tags),
+		},
+		Spec: spec,
+	}
+
+	return cluster, nil
+}
+
+func (m *KubernetesClusterResourceModel) nscaleWorkloadPools() (
+	kubernetesapi.KubernetesClusterWorkloadPools,
+	diag.Diagnostics,
+) {
+	var models []WorkloadPoolModel
+	if diagnostics := m.WorkloadPools.ElementsAs(context.TODO(), &models, false); diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	pools := make(kubernetesapi.KubernetesClusterWorkloadPools, 0, len(models))
+
+	for _, model := range models {
+		pool := kubernetesapi.KubernetesClusterWorkloadPool{
+			Name: model.Name.ValueString(),
+			Machine: kubernetesapi.MachinePool{
+				FlavorId: model.FlavorID.ValueStringPointer(),
+				Replicas: intPointer(model.Replicas),
+			},
+		}
+
+		if minReplicas := intPointer(model.MinReplicas); minReplicas != nil {
+			pool.Autoscaling = &kubernetesapi.KubernetesClusterAutoscaling{MinimumReplicas: *minReplicas}
+		}
+
+		if diskSize := intPointer(model.DiskSize); diskSize != nil {
+			pool.Machine.Disk = &kubernetesapi.Volume{Size: *diskSize}
+		}
+
+		if !model.Labels.IsNull() && !model.Labels.IsUnknown() {
+			labels := map[string]string{}
+			if diagnostics := model.Labels.ElementsAs(context.TODO(), &labels, false); diagnostics.HasError() {
+				return nil, diagnostics
+			}
+			pool.Labels = &labels
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescluster
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	kubernetesapi "github.com/nscaledev/nscale-sdk-go/kubernetes"
+)
+
+func TestKubernetesClusterRoundTrip(t *testing.T) {
+	model := KubernetesClusterResourceModel{
+		Name:               types.StringValue("example"),
+		Description:        types.StringNull(),
+		Version:            types.StringValue("v1.32.4"),
+		ClusterManagerID:   types.StringUnknown(),
+		HardwareEnablement: types.BoolValue(true),
+		ControlPlane:       types.ObjectUnknown(ControlPlaneModelAttributeType.AttrTypes),
+		WorkloadPools: types.ListValueMust(WorkloadPoolModelAttributeType, []attr.Value{
+			types.ObjectValueMust(WorkloadPoolModelAttributeType.AttrTypes, map[string]attr.Value{
+				"name":         types.StringValue("default"),
+				"flavor_id":    types.StringValue("flavor"),
+				"replicas":     types.Int64Value(3),
+				"min_replicas": types.Int64Value(1),
+				"disk_size":    types.Int64Unknown(),
+				"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"role": types.StringValue("worker"),
+				}),
+			}),
+		}),
+		Kubeconfig: types.StringUnknown(),
+		Tags:       types.MapNull(types.StringType),
+		RegionID:   types.StringValue("region"),
+	}
+
+	write, diagnostics := model.NscaleKubernetesCluster()
+	if diagnostics.HasError() {
+		t.Fatalf("NscaleKubernetesCluster() error: %v", diagnostics)
+	}
+
+	if write.Spec.ClusterManagerId != nil || write.Spec.ControlPlane != nil {
+		t.Fatalf("unknown cluster manager or control plane sent: %+v", write.Spec)
+	}
+
+	pool := write.Spec.WorkloadPools[0]
+	if pool.Machine.Disk != nil {
+		t.Fatalf("unknown disk size sent: %+v", pool.Machine.Disk)
+	}
+	if pool.Autoscaling == nil || pool.Autoscaling.MinimumReplicas != 1 || *pool.Machine.Replicas != 3 {
+		t.Fatalf("pool = %+v, want 1 to 3 replicas", pool)
+	}
+
+	var got KubernetesClusterResourceModel
+	got.Kubeconfig = types.StringUnknown()
+	got.setKubernetesCluster(&kubernetesapi.KubernetesClusterRead{
+		Metadata: coreapi.ProjectScopedResourceReadMetadata{
+			Id:                 "cluster",
+			Name:               write.Metadata.Name,
+			ProjectId:          "project",
+			ProvisioningStatus: coreapi.ResourceProvisioningStatusProvisioned,
+		},
+		Spec: write.Spec,
+	})
+
+	if !got.WorkloadPools.Equal(newWorkloadPoolModels(write.Spec.WorkloadPools)) {
+		t.Fatalf("workload pools = %v", got.WorkloadPools)
+	}
+	if !got.HardwareEnablement.Equal(types.BoolValue(true)) || !got.ControlPlane.IsNull() {
+		t.Fatalf("features = %v, control plane = %v", got.HardwareEnablement, got.ControlPlane)
+	}
+	if !got.Kubeconfig.IsNull() {
+		t.Fatalf("kubeconfig = %v, want null until it is read", got.Kubeconfig)
+	}
+
+	pools := got.WorkloadPools.Elements()[0].(types.Object).Attributes()
+	if !pools["min_replicas"].Equal(types.Int64Value(1)) || !pools["disk_size"].IsNull() {
+		t.Fatalf("pool = %v", pools)
+	}
+}
@@ -0,0 +1,445 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	kubernetesapi "github.com/nscaledev/nscale-sdk-go/kubernetes"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var (
+	_ resource.Resource                = &KubernetesClusterResource{}
+	_ resource.ResourceWithConfigure   = &KubernetesClusterResource{}
+	_ resource.ResourceWithImportState = &KubernetesClusterResource{}
+)
+
+// KubernetesClusterResource embeds the generic CRUD base; only Schema and the
+// adapter wiring below are Kubernetes-cluster-specific.
+type KubernetesClusterResource struct {
+	*nscale.GenericResource[KubernetesClusterResourceModel, kubernetesapi.KubernetesClusterRead]
+}
+
+func NewKubernetesClusterResource() resource.Resource {
+	return &KubernetesClusterResource{
+		GenericResource: nscale.NewGenericResource(kubernetesClusterAdapter()),
+	}
+}
+
+// kubernetesClusterAdapter wires the Kubernetes-cluster-specific SDK calls and
+// model mapping into the generic resource skeleton.
+func kubernetesClusterAdapter() nscale.ResourceAdapter[
+	KubernetesClusterResourceModel,
+	kubernetesapi.KubernetesClusterRead,
+] {
+	return nscale.ResourceAdapter[KubernetesClusterResourceModel, kubernetesapi.KubernetesClusterRead]{
+		TypeNameSuffix:  "_kubernetes_cluster",
+		Title:           "Kubernetes Cluster",
+		Name:            "kubernetes cluster",
+		RequiredFeature: nscale.KubernetesAPIV1,
+		Create:          kubernetesClusterCreate,
+		Update:          kubernetesClusterUpdate,
+		Delete:          kubernetesClusterDelete,
+		Get: func(
+			ctx context.Context,
+			client *nscale.Client,
+			id string,
+		) (*kubernetesapi.KubernetesClusterRead, nscale.ResourceStatus, error) {
+			return nscale.AdaptProjectScoped(getKubernetesCluster(ctx, client, id))
+		},
+		ToModel: func(api *kubernetesapi.KubernetesClusterRead, dst *KubernetesClusterResourceModel) {
+			dst.setKubernetesCluster(api)
+		},
+		Enrich:              kubernetesClusterEnrich,
+		IDFromModel:         func(m KubernetesClusterResourceModel) string { return m.ID.ValueString() },
+		TimeoutsFromModel:   func(m KubernetesClusterResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:              true,
+		SensitiveAttributes: []path.Path{path.Root("kubeconfig")},
+	}
+}
+
+//nolint:funlen // flat attribute declarations.
+func (r *KubernetesClusterResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Kubernetes Cluster",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the Kubernetes cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Kubernetes cluster.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Kubernetes cluster.",
+				Optional:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The Kubernetes version of the cluster, such as `v1.32.4`. Changing it upgrades the cluster in place; the API does not support downgrades.",
+				Required:            true,
+			},
+			"cluster_manager_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the cluster manager that manages the Kubernetes cluster. If not specified, one is created for the cluster's project.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hardware_enablement": schema.BoolAttribute{
+				MarkdownDescription: "Whether to install the drivers and operators for the GPUs and network adapters of the workload pools' machines. If not specified, the API's default applies.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"control_plane": schema.SingleNestedAttribute{
+				MarkdownDescription: "The control plane of the Kubernetes cluster. If not specified, the API's default applies.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"flavor_id": schema.StringAttribute{
+						MarkdownDescription: "The identifier of the flavor (machine type) used for the control plane nodes.",
+						Required:            true,
+					},
+					"replicas": schema.Int64Attribute{
+						MarkdownDescription: "The number of control plane nodes. If not specified, the API's default applies.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"workload_pools": schema.ListNestedAttribute{
+				MarkdownDescription: "A list of pools of worker nodes in the Kubernetes cluster.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the workload pool.",
+							Required:            true,
+							Validators: []validator.String{
+								validators.NameValidator(),
+							},
+						},
+						"flavor_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the flavor (machine type) used for the workload pool nodes.",
+							Required:            true,
+						},
+						"replicas": schema.Int64Attribute{
+							MarkdownDescription: "The number of nodes in this workload pool, or the largest number when `min_replicas` is set.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"min_replicas": schema.Int64Attribute{
+							MarkdownDescription: "The smallest number of nodes in this workload pool. When set, the pool is autoscaled between `min_replicas` and `replicas` nodes.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+								int64validator.AtMostSumOf(path.MatchRelative().AtParent().AtName("replicas")),
+							},
+						},
+						"disk_size": schema.Int64Attribute{
+							MarkdownDescription: "The size of the boot disk of each node in the workload pool, in GiB. If not specified, the API's default applies.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"labels": schema.MapAttribute{
+							MarkdownDescription: "A map of Kubernetes labels set on the nodes of the workload pool.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"kubeconfig": schema.StringAttribute{
+				MarkdownDescription: "The kubeconfig to access the Kubernetes cluster with. Null when the provider is configured with `disallow_sensitive_in_state`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "A map of tags assigned to the Kubernetes cluster.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(validators.NoReservedPrefix(nscale.TerraformOperationTagPrefix)),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the project where the Kubernetes cluster is provisioned. If not specified, this defaults to the project ID configured in the provider.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the region where the Kubernetes cluster is provisioned. If not specified, this defaults to the region ID configured in the provider.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"provisioning_status": schema.StringAttribute{
+				MarkdownDescription: "The provisioning status of the Kubernetes cluster.",
+				Computed:            true,
+			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("Kubernetes cluster"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					nscale.SpecFingerprintPlanModifier(
+						"version",
+						"cluster_manager_id",
+						"hardware_enablement",
+						"control_plane",
+						"workload_pools",
+						"region_id",
+					),
+				},
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the Kubernetes cluster was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the Kubernetes cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the Kubernetes cluster.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the Kubernetes cluster was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// kubernetesClusterEnrich sets the kubeconfig of the cluster, which is read
+// from its own endpoint, keeping it as it is when it cannot be read.
+func kubernetesClusterEnrich(
+	ctx context.Context,
+	client *nscale.Client,
+	dst *KubernetesClusterResourceModel,
+) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if client.DisallowSensitiveInState {
+		dst.Kubeconfig = types.StringNull()
+		return diagnostics
+	}
+
+	kubeconfig, err := readKubeconfig(ctx, client, dst.ProjectID.ValueString(), dst.ID.ValueString())
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddWarning(
+			"Unable to Read Kubeconfig",
+			fmt.Sprintf("The kubeconfig of the Kubernetes cluster could not be read, so it is unchanged: %s", err),
+		)
+		return diagnostics
+	}
+
+	dst.Kubeconfig = types.StringValue(kubeconfig)
+
+	return diagnostics
+}
+
+func kubernetesClusterCreate(
+	ctx context.Context,
+	client *nscale.Client,
+	plan KubernetesClusterResourceModel,
+) (*kubernetesapi.KubernetesClusterRead, diag.Diagnostics) {
+	// Resolve the project ID from the resource or the provider default, erroring
+	// when neither is set. This is only meaningful at create time.
+	projectID, diagnostics := client.ResolveProjectID(plan.ProjectID.ValueString())
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	// Default the region ID from the provider configuration when the plan
+	// leaves it empty. This is only meaningful at create time.
+	if plan.RegionID.ValueString() == "" {
+		plan.RegionID = types.StringValue(client.RegionID)
+	}
+
+	requestData, paramDiagnostics := plan.NscaleKubernetesCluster()
+	diagnostics.Append(paramDiagnostics...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	createResponse, err := client.Kubernetes.PostApiV1OrganizationsOrganizationIDProjectsProjectIDClusters(
+		ctx,
+		client.OrganizationID,
+		projectID,
+		requestData,
+	)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Create, "Kubernetes Cluster", "kubernetes cluster", err)
+		return nil, diagnostics
+	}
+	defer createResponse.Body.Close()
+
+	cluster, err := nscale.ReadJSONResponsePointer[kubernetesapi.KubernetesClusterRead](createResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Create, "Kubernetes Cluster", "kubernetes cluster", err)
+		return nil, diagnostics
+	}
+
+	return cluster, nil
+}
+
+// kubernetesClusterUpdate replaces the whole cluster, as the API has no
+// partial update, tagging it so the watcher can tell when the update has
+// propagated.
+func kubernetesClusterUpdate(
+	ctx context.Context,
+	client *nscale.Client,
+	id string,
+	_ *KubernetesClusterResourceModel,
+	plan KubernetesClusterResourceModel,
+) (string, diag.Diagnostics) {
+	projectID, diagnostics := client.ResolveProjectID(plan.ProjectID.ValueString())
+	if diagnostics.HasError() {
+		return "", diagnostics
+	}
+
+	requestData, paramDiagnostics := plan.NscaleKubernetesCluster()
+	diagnostics.Append(paramDiagnostics...)
+	if diagnostics.HasError() {
+		return "", diagnostics
+	}
+
+	operationTagKey := nscale.WriteOperationTag(&requestData.Metadata)
+
+	updateResponse, err := client.Kubernetes.PutApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(
+		ctx,
+		client.OrganizationID,
+		projectID,
+		id,
+		requestData,
+	)
+	if err != nil {
+		apidiag.Add(&diagnostics, apidiag.Update, "Kubernetes Cluster", "kubernetes cluster", err)
+		return "", diagnostics
+	}
+	defer updateResponse.Body.Close()
+
+	if err = nscale.ReadEmptyResponse(updateResponse); err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Update, "Kubernetes Cluster", "kubernetes cluster", err)
+		return "", diagnostics
+	}
+
+	return operationTagKey, nil
+}
+
+func kubernetesClusterDelete(ctx context.Context, client *nscale.Client, id string) error {
+	projectID := nscale.ProjectIDFromContext(ctx)
+	if projectID == "" {
+		return errors.New(
+			"a project ID is required to delete a kubernetes cluster; set project_id on the resource or a " +
+				"default project_id on the provider (or the NSCALE_PROJECT_ID environment variable)",
+		)
+	}
+
+	deleteResponse, err := client.Kubernetes.DeleteApiV1OrganizationsOrganizationIDProjectsProjectIDClustersClusterID(
+		ctx,
+		client.OrganizationID,
+		projectID,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+	defer deleteResponse.Body.Close()
+
+	return nscale.ReadEmptyResponse(deleteResponse)
+}
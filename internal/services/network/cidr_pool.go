@@ -0,0 +1,195 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"sync"
+
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+// The Nscale API has no address management of its own, so CIDR pools are
+// implemented by the provider: a block is allocated from a pool by listing the
+// organization's networks in the region and taking the first block that none
+// of them overlaps.
+
+// cidrAllocator serializes allocations within the provider process, and holds
+// on to the blocks it hands out, so that networks allocated from one pool in
+// the same apply do not both take a block before either network is listed.
+type cidrAllocator struct {
+	mutex   sync.Mutex
+	claimed []netip.Prefix
+}
+
+//nolint:gochecknoglobals // shared by every network resource in the provider process.
+var poolAllocator = &cidrAllocator{}
+
+// allocate claims the first free block of prefixLength bits in pool, in the
+// region's view of the organization's networks.
+func (a *cidrAllocator) allocate(
+	ctx context.Context,
+	client *nscale.Client,
+	regionID string,
+	pool netip.Prefix,
+	prefixLength int,
+) (netip.Prefix, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	networks, err := listOrganizationNetworks(ctx, client, regionID)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	used := slices.Clone(a.claimed)
+	for _, network := range networks {
+		if prefix, parseErr := netip.ParsePrefix(network.Status.Prefix); parseErr == nil {
+			used = append(used, prefix)
+		}
+	}
+
+	block, err := nextFreeCIDRBlock(pool, prefixLength, used)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	a.claimed = append(a.claimed, block)
+
+	return block, nil
+}
+
+// release returns a block whose network was not created.
+func (a *cidrAllocator) release(block netip.Prefix) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.claimed = slices.DeleteFunc(a.claimed, func(claimed netip.Prefix) bool { return claimed == block })
+}
+
+// listOrganizationNetworks lists the networks of every project of the
+// organization in the region. The list is made with the token of each project
+// the provider can reach, as a project token may only see its own project, and
+// the results are merged.
+func listOrganizationNetworks(
+	ctx context.Context,
+	client *nscale.Client,
+	regionID string,
+) (regionapi.NetworksV2Read, error) {
+	params := &regionapi.GetApiV2NetworksParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{client.OrganizationID},
+		RegionID:       &regionapi.RegionIDQueryParameter{regionID},
+	}
+
+	var networks regionapi.NetworksV2Read
+
+	seen := map[string]bool{}
+
+	for _, scope := range client.ProjectScopes(ctx) {
+		networkListResponse, err := client.Region.GetApiV2Networks(scope, params)
+		if err != nil {
+			return nil, err
+		}
+
+		scopeNetworks, err := nscale.ReadJSONResponseValue[regionapi.NetworksV2Read](networkListResponse)
+		networkListResponse.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, network := range scopeNetworks {
+			if !seen[network.Metadata.Id] {
+				seen[network.Metadata.Id] = true
+				networks = append(networks, network)
+			}
+		}
+	}
+
+	return networks, nil
+}
+
+// nextFreeCIDRBlock returns the lowest block of prefixLength bits in pool that
+// overlaps none of the used prefixes.
+func nextFreeCIDRBlock(pool netip.Prefix, prefixLength int, used []netip.Prefix) (netip.Prefix, error) {
+	pool = pool.Masked()
+
+	if !pool.Addr().Is4() {
+		return netip.Prefix{}, errors.New("only IPv4 pools are supported")
+	}
+
+	if prefixLength < pool.Bits() || prefixLength > 32 {
+		return netip.Prefix{}, fmt.Errorf("a /%d block does not fit in the pool %s", prefixLength, pool)
+	}
+
+	size := blockSize(prefixLength)
+	end := addressValue(pool.Addr()) + blockSize(pool.Bits())
+
+	for candidate := addressValue(pool.Addr()); candidate+size <= end; {
+		block := netip.PrefixFrom(addressFromValue(candidate), prefixLength)
+		next := candidate + size
+		free := true
+
+		for _, prefix := range used {
+			if !prefix.Addr().Is4() || !prefix.Overlaps(block) {
+				continue
+			}
+
+			free = false
+
+			// Skip past the used prefix, to the next block boundary after it.
+			if usedEnd := addressValue(prefix.Masked().Addr()) + blockSize(prefix.Bits()); usedEnd > next {
+				next = (usedEnd + size - 1) / size * size
+			}
+		}
+
+		if free {
+			return block, nil
+		}
+
+		candidate = next
+	}
+
+	return netip.Prefix{}, fmt.Errorf("the pool %s has no free /%d block", pool, prefixLength)
+}
+
+// cidrBlockInPool reports whether block is a block of prefixLength bits in pool.
+func cidrBlockInPool(block netip.Prefix, pool netip.Prefix, prefixLength int) bool {
+	return block.Bits() == prefixLength && pool.Masked().Contains(block.Addr())
+}
+
+func blockSize(bits int) uint64 {
+	return uint64(1) << (32 - bits)
+}
+
+func addressValue(address netip.Addr) uint64 {
+	octets := address.As4()
+	return uint64(binary.BigEndian.Uint32(octets[:]))
+}
+
+func addressFromValue(value uint64) netip.Addr {
+	var octets [4]byte
+	binary.BigEndian.PutUint32(octets[:], uint32(value)) //nolint:gosec // addresses are below 2^32.
+	return netip.AddrFrom4(octets)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestNextFreeCIDRBlock(t *testing.T) {
+	testCases := []struct {
+		name         string
+		pool         string
+		prefixLength int
+		used         []string
+		want         string
+		wantError    bool
+	}{
+		{
+			name:         "empty pool",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			want:         "10.0.0.0/24",
+		},
+		{
+			name:         "skips used blocks",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			used:         []string{"10.0.0.0/24", "10.0.1.0/24"},
+			want:         "10.0.2.0/24",
+		},
+		{
+			name:         "skips past a larger overlapping network",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			used:         []string{"10.0.0.128/25", "10.0.0.0/22"},
+			want:         "10.0.4.0/24",
+		},
+		{
+			name:         "fills a gap",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			used:         []string{"10.0.0.0/24", "10.0.2.0/24"},
+			want:         "10.0.1.0/24",
+		},
+		{
+			name:         "ignores networks outside the pool",
+			pool:         "10.0.0.0/16",
+			prefixLength: 24,
+			used:         []string{"192.168.0.0/24", "10.1.0.0/24"},
+			want:         "10.0.0.0/24",
+		},
+		{
+			name:         "unmasked pool",
+			pool:         "10.0.3.7/22",
+			prefixLength: 23,
+			used:         []string{"10.0.0.0/24"},
+			want:         "10.0.2.0/23",
+		},
+		{
+			name:         "full pool",
+			pool:         "10.0.0.0/23",
+			prefixLength: 24,
+			used:         []string{"10.0.0.0/24", "10.0.1.0/24"},
+			wantError:    true,
+		},
+		{
+			name:         "covered by a larger network",
+			pool:         "10.0.0.0/24",
+			prefixLength: 26,
+			used:         []string{"10.0.0.0/16"},
+			wantError:    true,
+		},
+		{
+			name:         "block larger than the pool",
+			pool:         "10.0.0.0/24",
+			prefixLength: 16,
+			wantError:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			used := make([]netip.Prefix, 0, len(testCase.used))
+			for _, prefix := range testCase.used {
+				used = append(used, netip.MustParsePrefix(prefix))
+			}
+
+			got, err := nextFreeCIDRBlock(netip.MustParsePrefix(testCase.pool), testCase.prefixLength, used)
+			if (err != nil) != testCase.wantError {
+				t.Fatalf("nextFreeCIDRBlock() error = %v, want error %v", err, testCase.wantError)
+			}
+
+			if !testCase.wantError && got.String() != testCase.want {
+				t.Fatalf("nextFreeCIDRBlock() = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package network
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,9 +40,10 @@ func NewNetworkDataSource() datasource.DataSource {
 	return &NetworkDataSource{
 		GenericDataSource: nscale.NewGenericDataSource(
 			nscale.DataSourceAdapter[NetworkModel, regionapi.NetworkV2Read]{
-				TypeNameSuffix: "_network",
-				Title:          "Network",
-				Name:           "network",
+				TypeNameSuffix:  "_network",
+				Title:           "Network",
+				Name:            "network",
+				RequiredFeature: nscale.RegionAPIV2,
 				Get: func(ctx context.Context, client *nscale.Client, id string) (*regionapi.NetworkV2Read, error) {
 					network, _, err := getNetwork(ctx, id, client)
 					return network, err
@@ -111,8 +113,26 @@ func (s *NetworkDataSource) Schema(
 				MarkdownDescription: "The identifier of the region where the network is provisioned.",
 				Computed:            true,
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("network"),
+				Computed:            true,
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the network was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the network.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the network.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the network was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
@@ -49,3 +49,37 @@ func getNetwork(
 
 	return network, &network.Metadata, nil
 }
+
+// findNetworkByName returns the network called name in the given project and
+// region, or nil when there is none. Names are unique within a project, so the
+// first match is the only one.
+func findNetworkByName(
+	ctx context.Context,
+	client *nscale.Client,
+	projectID, regionID, name string,
+) (*regionapi.NetworkV2Read, error) {
+	params := &regionapi.GetApiV2NetworksParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{client.OrganizationID},
+		ProjectID:      &regionapi.ProjectIDQueryParameter{projectID},
+		RegionID:       &regionapi.RegionIDQueryParameter{regionID},
+	}
+
+	networkListResponse, err := client.Region.GetApiV2Networks(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	defer networkListResponse.Body.Close()
+
+	networks, err := nscale.ReadJSONResponseValue[regionapi.NetworksV2Read](networkListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range networks {
+		if networks[i].Metadata.Name == name {
+			return &networks[i], nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil // no match is an expected outcome, not an error
+}
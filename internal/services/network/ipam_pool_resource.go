@@ -0,0 +1,294 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var _ resource.ResourceWithConfigure = &IPAMPoolResource{}
+
+var IPAMPoolAllocationModelAttributeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"network_id": types.StringType,
+		"name":       types.StringType,
+		"project_id": types.StringType,
+		"cidr_block": types.StringType,
+	},
+}
+
+type IPAMPoolResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CIDR        types.String `tfsdk:"cidr"`
+	RegionID    types.String `tfsdk:"region_id"`
+	Allocations types.List   `tfsdk:"allocations"`
+}
+
+// setAllocations records the organization's networks in the pool's region that
+// overlap the pool.
+func (m *IPAMPoolResourceModel) setAllocations(ctx context.Context, client *nscale.Client) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	pool, err := netip.ParsePrefix(m.CIDR.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("cidr"),
+			"Invalid Pool CIDR",
+			fmt.Sprintf("The pool CIDR %q could not be parsed: %s", m.CIDR.ValueString(), err),
+		)
+		return diagnostics
+	}
+
+	networks, err := listOrganizationNetworks(ctx, client, m.RegionID.ValueString())
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddError(
+			"Failed to Read IPAM Pool Allocations",
+			fmt.Sprintf("An error occurred while listing the networks in the pool's region: %s", err),
+		)
+		return diagnostics
+	}
+
+	allocations := []attr.Value{}
+	for _, network := range networks {
+		prefix, parseErr := netip.ParsePrefix(network.Status.Prefix)
+		if parseErr != nil || !prefix.Overlaps(pool.Masked()) {
+			continue
+		}
+
+		allocations = append(allocations, types.ObjectValueMust(
+			IPAMPoolAllocationModelAttributeType.AttrTypes,
+			map[string]attr.Value{
+				"network_id": types.StringValue(network.Metadata.Id),
+				"name":       types.StringValue(network.Metadata.Name),
+				"project_id": types.StringValue(network.Metadata.ProjectId),
+				"cidr_block": types.StringValue(network.Status.Prefix),
+			},
+		))
+	}
+
+	m.Allocations = types.ListValueMust(IPAMPoolAllocationModelAttributeType, allocations)
+
+	return diagnostics
+}
+
+// IPAMPoolResource is a CIDR range that networks are allocated from with
+// cidr_from_pool. The Nscale API has no address management of its own, so the
+// pool only exists in Terraform state, and its allocations are the networks
+// that currently occupy it, whichever way their CIDR blocks were chosen.
+type IPAMPoolResource struct {
+	client *nscale.Client
+}
+
+func NewIPAMPoolResource() resource.Resource {
+	return &IPAMPoolResource{}
+}
+
+func (r *IPAMPoolResource) Configure(
+	ctx context.Context,
+	request resource.ConfigureRequest,
+	response *resource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.RegionAPIV2, "IPAM pool")...)
+}
+
+func (r *IPAMPoolResource) Metadata(
+	ctx context.Context,
+	request resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_ipam_pool"
+}
+
+func (r *IPAMPoolResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale IPAM Pool. A CIDR range that networks allocate their CIDR blocks from with `cidr_from_pool`, so that teams sharing the range do not pick overlapping blocks. The Nscale API has no address management of its own: the pool exists only in Terraform state, and allocation avoids every network of the organization in the region, whether it was allocated from a pool or not.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the pool.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the pool.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the pool.",
+				Optional:            true,
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "The CIDR range networks are allocated from.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.CIDRValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the region whose networks the pool is allocated among. If not specified, this defaults to the region ID configured in the provider.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"allocations": schema.ListNestedAttribute{
+				MarkdownDescription: "The organization's networks in the region whose CIDR blocks overlap the pool, as of the last refresh.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"network_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the network.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the network.",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the project the network belongs to.",
+							Computed:            true,
+						},
+						"cidr_block": schema.StringAttribute{
+							MarkdownDescription: "The CIDR block of the network.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *IPAMPoolResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[IPAMPoolResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	data.ID = types.StringValue(uuid.NewString())
+	if data.RegionID.ValueString() == "" {
+		data.RegionID = types.StringValue(r.client.RegionID)
+	}
+
+	if diagnostics = data.setAllocations(ctx, r.client); diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *IPAMPoolResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[IPAMPoolResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	if diagnostics = data.setAllocations(ctx, r.client); diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *IPAMPoolResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[IPAMPoolResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	if diagnostics = data.setAllocations(ctx, r.client); diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// Delete only forgets the pool; the networks allocated from it are untouched.
+func (r *IPAMPoolResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+}
@@ -18,8 +18,8 @@ package network
 
 import (
 	"context"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -32,16 +32,20 @@ import (
 )
 
 type NetworkModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	DNSNameservers types.List   `tfsdk:"dns_nameservers"`
-	Routes         types.List   `tfsdk:"routes"`
-	CIDRBlock      types.String `tfsdk:"cidr_block"`
-	Tags           types.Map    `tfsdk:"tags"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	RegionID       types.String `tfsdk:"region_id"`
-	CreationTime   types.String `tfsdk:"creation_time"`
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	DNSNameservers   types.List        `tfsdk:"dns_nameservers"`
+	Routes           types.List        `tfsdk:"routes"`
+	CIDRBlock        types.String      `tfsdk:"cidr_block"`
+	Tags             types.Map         `tfsdk:"tags"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	RegionID         types.String      `tfsdk:"region_id"`
+	SpecFingerprint  types.String      `tfsdk:"spec_fingerprint"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 func NewNetworkModel(source *regionapi.NetworkV2Read) NetworkModel {
@@ -58,16 +62,20 @@ func NewNetworkModel(source *regionapi.NetworkV2Read) NetworkModel {
 	tags := nscale.RemoveOperationTags(source.Metadata.Tags)
 
 	return NetworkModel{
-		ID:             types.StringValue(source.Metadata.Id),
-		Name:           types.StringValue(source.Metadata.Name),
-		Description:    types.StringPointerValue(source.Metadata.Description),
-		DNSNameservers: tftypes.NullableListValueMust(types.StringType, dnsNameservers),
-		Routes:         routes,
-		CIDRBlock:      types.StringValue(source.Status.Prefix),
-		Tags:           tftypes.TagMapValueMust(tags),
-		ProjectID:      types.StringValue(source.Metadata.ProjectId),
-		RegionID:       types.StringValue(source.Status.RegionId),
-		CreationTime:   types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		DNSNameservers:   tftypes.NullableListValueMust(types.StringType, dnsNameservers),
+		Routes:           routes,
+		CIDRBlock:        types.StringValue(source.Status.Prefix),
+		Tags:             tftypes.TagMapValueMust(tags),
+		ProjectID:        types.StringValue(source.Metadata.ProjectId),
+		RegionID:         types.StringValue(source.Status.RegionId),
+		SpecFingerprint:  nscale.SpecFingerprint(source.Spec),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
 
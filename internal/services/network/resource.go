@@ -3,21 +3,29 @@ package network
 import (
 	"context"
 	"fmt"
+	"net/netip"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 	regionids "github.com/unikorn-cloud/region/pkg/ids"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -25,12 +33,49 @@ var (
 	_ resource.Resource                = &NetworkResource{}
 	_ resource.ResourceWithConfigure   = &NetworkResource{}
 	_ resource.ResourceWithImportState = &NetworkResource{}
+	_ resource.ResourceWithModifyPlan  = &NetworkResource{}
 )
 
 type NetworkResourceModel struct {
 	NetworkModel
 
-	Timeouts tftimeouts.Value `tfsdk:"timeouts"`
+	CIDRFromPool  types.Object     `tfsdk:"cidr_from_pool"`
+	AdoptExisting types.Bool       `tfsdk:"adopt_existing"`
+	Timeouts      tftimeouts.Value `tfsdk:"timeouts"`
+}
+
+// CIDRFromPoolModel asks for the network's CIDR block to be allocated from a
+// pool instead of configured.
+type CIDRFromPoolModel struct {
+	PoolCIDR     types.String `tfsdk:"pool_cidr"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+}
+
+// cidrFromPool returns the pool and block size cidr_from_pool asks for, and
+// false when the CIDR block is configured instead.
+func (m *NetworkResourceModel) cidrFromPool(ctx context.Context) (netip.Prefix, int, bool, diag.Diagnostics) {
+	if m.CIDRFromPool.IsNull() || m.CIDRFromPool.IsUnknown() {
+		return netip.Prefix{}, 0, false, nil
+	}
+
+	var data CIDRFromPoolModel
+	if diagnostics := m.CIDRFromPool.As(ctx, &data, basetypes.ObjectAsOptions{}); diagnostics.HasError() {
+		return netip.Prefix{}, 0, false, diagnostics
+	}
+
+	var diagnostics diag.Diagnostics
+
+	pool, err := netip.ParsePrefix(data.PoolCIDR.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("cidr_from_pool").AtName("pool_cidr"),
+			"Invalid Pool CIDR",
+			fmt.Sprintf("The pool CIDR %q could not be parsed: %s", data.PoolCIDR.ValueString(), err),
+		)
+		return netip.Prefix{}, 0, false, diagnostics
+	}
+
+	return pool, int(data.PrefixLength.ValueInt64()), true, diagnostics
 }
 
 // NetworkResource embeds the generic CRUD base; only Schema and the adapter
@@ -49,12 +94,14 @@ func NewNetworkResource() resource.Resource {
 // generic resource skeleton.
 func networkAdapter() nscale.ResourceAdapter[NetworkResourceModel, regionapi.NetworkV2Read] {
 	return nscale.ResourceAdapter[NetworkResourceModel, regionapi.NetworkV2Read]{
-		TypeNameSuffix: "_network",
-		Title:          "Network",
-		Name:           "network",
-		Create:         networkCreate,
-		Update:         networkUpdate,
-		Delete:         networkDelete,
+		TypeNameSuffix:  "_network",
+		Title:           "Network",
+		Name:            "network",
+		RequiredFeature: nscale.RegionAPIV2,
+		Create:          networkCreate,
+		Adopt:           networkAdopt,
+		Update:          networkUpdate,
+		Delete:          networkDelete,
 		Get: func(
 			ctx context.Context,
 			client *nscale.Client,
@@ -63,10 +110,14 @@ func networkAdapter() nscale.ResourceAdapter[NetworkResourceModel, regionapi.Net
 			return nscale.AdaptProjectScoped(getNetwork(ctx, id, client))
 		},
 		ToModel: func(api *regionapi.NetworkV2Read, dst *NetworkResourceModel) {
+			priorDescription := dst.Description
 			dst.NetworkModel = NewNetworkModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
 		},
-		IDFromModel:       func(m NetworkResourceModel) string { return m.ID.ValueString() },
-		TimeoutsFromModel: func(m NetworkResourceModel) tftimeouts.Value { return m.Timeouts },
+		IDFromModel:          func(m NetworkResourceModel) string { return m.ID.ValueString() },
+		TimeoutsFromModel:    func(m NetworkResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:               true,
+		CreateOnlyAttributes: []string{"adopt_existing"},
 	}
 }
 
@@ -106,7 +157,7 @@ func (r *NetworkResource) Schema(
 				},
 			},
 			"routes": schema.ListNestedAttribute{
-				MarkdownDescription: "A list of routes for the network.",
+				MarkdownDescription: "A list of routes for the network. Each route must have a different destination.",
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -128,18 +179,42 @@ func (r *NetworkResource) Schema(
 				},
 				Validators: []validator.List{
 					listvalidator.SizeAtLeast(1),
+					validators.UniqueCIDRsValidator{Attribute: "destination"},
 				},
 			},
 			"cidr_block": schema.StringAttribute{
-				MarkdownDescription: "The CIDR block assigned to the network.",
-				Required:            true,
+				MarkdownDescription: "The CIDR block assigned to the network. Exactly one of `cidr_block` and `cidr_from_pool` must be set.",
+				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					validators.CIDRValidator{},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("cidr_from_pool")),
 				},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"cidr_from_pool": schema.SingleNestedAttribute{
+				MarkdownDescription: "Allocates the network's CIDR block from a pool, such as the `cidr` of an `nscale_ipam_pool`, instead of configuring it. The lowest block of the given size that overlaps none of the organization's networks in the region is taken. Only consulted at create time: the allocated block is kept in `cidr_block`, and changing the pool later does not move the network.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"pool_cidr": schema.StringAttribute{
+						MarkdownDescription: "The CIDR range to allocate the block from.",
+						Required:            true,
+						Validators: []validator.String{
+							validators.CIDRValidator{},
+						},
+					},
+					"prefix_length": schema.Int64Attribute{
+						MarkdownDescription: "The prefix length of the block to allocate, for example `24` for a /24.",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 32),
+						},
+					},
+				},
+			},
 			"tags": schema.MapAttribute{
 				MarkdownDescription: "A map of tags assigned to the network.",
 				ElementType:         types.StringType,
@@ -167,13 +242,41 @@ func (r *NetworkResource) Schema(
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt an existing network with the same name in the project and region instead of creating a new one. The adopted network is updated to match this configuration and, like any managed network, is deleted on destroy. Only consulted at create time, so changing it later does not update the network; its `cidr_block` must match.",
+				Optional:            true,
+			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("network"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					nscale.SpecFingerprintPlanModifier("dns_nameservers", "routes", "cidr_block", "cidr_from_pool"),
+				},
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the network was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the network.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the network.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the network was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
@@ -211,6 +314,43 @@ func networkCreate(
 		return nil, diagnostics
 	}
 
+	pool, prefixLength, fromPool, diagnostics := plan.cidrFromPool(ctx)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	if !fromPool {
+		return postNetwork(ctx, client, plan)
+	}
+
+	block, err := poolAllocator.allocate(ctx, client, plan.RegionID.ValueString(), pool, prefixLength)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		diagnostics.AddAttributeError(
+			path.Root("cidr_from_pool"),
+			"Failed to Allocate Network CIDR Block",
+			fmt.Sprintf("An error occurred while allocating a CIDR block from the pool: %s", err),
+		)
+		return nil, diagnostics
+	}
+
+	plan.CIDRBlock = types.StringValue(block.String())
+
+	network, diagnostics := postNetwork(ctx, client, plan)
+	if diagnostics.HasError() {
+		poolAllocator.release(block)
+	}
+
+	return network, diagnostics
+}
+
+// postNetwork issues the create request for the plan, whose CIDR block is
+// known by now.
+func postNetwork(
+	ctx context.Context,
+	client *nscale.Client,
+	plan NetworkResourceModel,
+) (*regionapi.NetworkV2Read, diag.Diagnostics) {
 	params, diagnostics := plan.NscaleNetworkCreateParams(client.OrganizationID)
 	if diagnostics.HasError() {
 		return nil, diagnostics
@@ -218,20 +358,87 @@ func networkCreate(
 
 	createResponse, err := client.Region.PostApiV2Networks(ctx, params)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Create Network",
-			fmt.Sprintf("An error occurred while creating the network: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Network", "network", err)
 		return nil, diagnostics
 	}
 	defer createResponse.Body.Close()
 
 	network, err := nscale.ReadJSONResponsePointer[regionapi.NetworkV2Read](createResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&diagnostics, apidiag.Create, "Network", "network", err)
+		return nil, diagnostics
+	}
+
+	return network, nil
+}
+
+// networkAdopt finds the network an adopt_existing create should take over. The
+// CIDR block cannot be changed in place, so a same-named network with a
+// different one is an error rather than a silent replacement target.
+func networkAdopt(
+	ctx context.Context,
+	client *nscale.Client,
+	plan NetworkResourceModel,
+) (*regionapi.NetworkV2Read, diag.Diagnostics) {
+	if !plan.AdoptExisting.ValueBool() {
+		return nil, nil
+	}
+
+	if diagnostics := setDefaultIDs(client, &plan); diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	var diagnostics diag.Diagnostics
+
+	network, err := findNetworkByName(
+		ctx,
+		client,
+		plan.ProjectID.ValueString(),
+		plan.RegionID.ValueString(),
+		plan.Name.ValueString(),
+	)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
 		diagnostics.AddError(
-			"Failed to Create Network",
-			fmt.Sprintf("An error occurred while creating the network: %s", err),
+			"Failed to Adopt Network",
+			fmt.Sprintf("An error occurred while looking up an existing network to adopt: %s", err),
+		)
+		return nil, diagnostics
+	}
+
+	if network == nil {
+		return nil, diagnostics
+	}
+
+	// A network adopted with cidr_from_pool keeps its block if it is one the
+	// pool could have allocated.
+	wanted, matches := plan.CIDRBlock.ValueString(), network.Status.Prefix == plan.CIDRBlock.ValueString()
+
+	pool, prefixLength, fromPool, poolDiagnostics := plan.cidrFromPool(ctx)
+	diagnostics.Append(poolDiagnostics...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	if fromPool {
+		block, parseErr := netip.ParsePrefix(network.Status.Prefix)
+		wanted = fmt.Sprintf("a /%d block of the pool %s", prefixLength, pool)
+		matches = parseErr == nil && cidrBlockInPool(block, pool, prefixLength)
+	}
+
+	if !matches {
+		diagnostics.AddAttributeError(
+			path.Root("cidr_block"),
+			"Failed to Adopt Network",
+			fmt.Sprintf(
+				"The existing network %q (%s) has CIDR block %s, which differs from the configured %s. "+
+					"The CIDR block cannot be changed in place.",
+				network.Metadata.Name,
+				network.Metadata.Id,
+				network.Status.Prefix,
+				wanted,
+			),
 		)
 		return nil, diagnostics
 	}
@@ -243,6 +450,7 @@ func networkUpdate(
 	ctx context.Context,
 	client *nscale.Client,
 	id string,
+	_ *NetworkResourceModel,
 	plan NetworkResourceModel,
 ) (string, diag.Diagnostics) {
 	params, diagnostics := plan.NscaleNetworkUpdateParams()
@@ -261,20 +469,14 @@ func networkUpdate(
 
 	updateResponse, err := client.Region.PutApiV2NetworksNetworkID(ctx, networkID, params)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Update Network",
-			fmt.Sprintf("An error occurred while updating the network: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Network", "network", err)
 		return "", diagnostics
 	}
 	defer updateResponse.Body.Close()
 
 	if _, readErr := nscale.ReadJSONResponsePointer[regionapi.NetworkV2Read](updateResponse); readErr != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, readErr)
-		diagnostics.AddError(
-			"Failed to Update Network",
-			fmt.Sprintf("An error occurred while updating the network: %s", readErr),
-		)
+		apidiag.Add(&diagnostics, apidiag.Update, "Network", "network", readErr)
 		return "", diagnostics
 	}
 
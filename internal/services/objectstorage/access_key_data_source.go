@@ -20,10 +20,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
 
@@ -59,6 +61,8 @@ func (s *ObjectStorageAccessKeyDataSource) Configure(
 	}
 
 	s.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.StorageAPIV1, "Object storage access key")...)
 }
 
 func (s *ObjectStorageAccessKeyDataSource) Metadata(
@@ -107,6 +111,20 @@ func (s *ObjectStorageAccessKeyDataSource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the access key was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the access key.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the access key.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the access key was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
@@ -118,14 +136,17 @@ func (s *ObjectStorageAccessKeyDataSource) Schema(
 // a separate type means the schema and config struct are tightly coupled
 // (any drift produces a compile error).
 type dataSourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	EndpointID     types.String `tfsdk:"endpoint_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	IdentityPolicy types.String `tfsdk:"identity_policy"`
-	AccessKeyID    types.String `tfsdk:"access_key_id"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	CreationTime   types.String `tfsdk:"creation_time"`
+	ID               types.String      `tfsdk:"id"`
+	EndpointID       types.String      `tfsdk:"endpoint_id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	IdentityPolicy   types.String      `tfsdk:"identity_policy"`
+	AccessKeyID      types.String      `tfsdk:"access_key_id"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 func (s *ObjectStorageAccessKeyDataSource) Read(
@@ -133,6 +154,8 @@ func (s *ObjectStorageAccessKeyDataSource) Read(
 	request datasource.ReadRequest,
 	response *datasource.ReadResponse,
 ) {
+	ctx = s.client.WithProjectIDFrom(ctx, request.Config.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[dataSourceModel](ctx, request.Config.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -142,23 +165,23 @@ func (s *ObjectStorageAccessKeyDataSource) Read(
 	accessKey, _, err := getObjectStorageAccessKey(ctx, data.EndpointID.ValueString(), data.ID.ValueString(), s.client)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Read Object Storage Access Key",
-			fmt.Sprintf("An error occurred while retrieving the access key: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Object Storage Access Key", "access key", err)
 		return
 	}
 
 	model := NewObjectStorageAccessKeyModel(accessKey)
 	out := dataSourceModel{
-		ID:             model.ID,
-		EndpointID:     data.EndpointID,
-		Name:           model.Name,
-		Description:    model.Description,
-		IdentityPolicy: model.IdentityPolicy,
-		AccessKeyID:    model.AccessKeyID,
-		ProjectID:      model.ProjectID,
-		CreationTime:   model.CreationTime,
+		ID:               model.ID,
+		EndpointID:       data.EndpointID,
+		Name:             model.Name,
+		Description:      model.Description,
+		IdentityPolicy:   model.IdentityPolicy,
+		AccessKeyID:      model.AccessKeyID,
+		ProjectID:        model.ProjectID,
+		CreationTime:     model.CreationTime,
+		CreatedBy:        model.CreatedBy,
+		ModifiedBy:       model.ModifiedBy,
+		LastModifiedTime: model.LastModifiedTime,
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &out)...)
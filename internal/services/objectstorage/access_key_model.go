@@ -17,8 +17,7 @@ limitations under the License.
 package objectstorage
 
 import (
-	"time"
-
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	coreapi "github.com/nscaledev/nscale-sdk-go/common"
 	storageapi "github.com/nscaledev/nscale-sdk-go/storage"
@@ -34,15 +33,18 @@ import (
 // create response and re-attaches it to state on every Read; the model
 // converter intentionally leaves it as the zero value.
 type ObjectStorageAccessKeyModel struct {
-	ID             types.String `tfsdk:"id"`
-	EndpointID     types.String `tfsdk:"endpoint_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	IdentityPolicy types.String `tfsdk:"identity_policy"`
-	AccessKeyID    types.String `tfsdk:"access_key_id"`
-	Secret         types.String `tfsdk:"secret"`
-	ProjectID      types.String `tfsdk:"project_id"`
-	CreationTime   types.String `tfsdk:"creation_time"`
+	ID               types.String      `tfsdk:"id"`
+	EndpointID       types.String      `tfsdk:"endpoint_id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	IdentityPolicy   types.String      `tfsdk:"identity_policy"`
+	AccessKeyID      types.String      `tfsdk:"access_key_id"`
+	Secret           types.String      `tfsdk:"secret"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 // NewObjectStorageAccessKeyModel maps a read-shape API response into the
@@ -50,13 +52,16 @@ type ObjectStorageAccessKeyModel struct {
 // zero value: those are caller-managed (see the resource's Create/Read).
 func NewObjectStorageAccessKeyModel(source *storageapi.ObjectStorageAccessKeyRead) ObjectStorageAccessKeyModel {
 	return ObjectStorageAccessKeyModel{
-		ID:             types.StringValue(source.Metadata.Id),
-		Name:           types.StringValue(source.Metadata.Name),
-		Description:    types.StringPointerValue(source.Metadata.Description),
-		IdentityPolicy: types.StringValue(source.Spec.IdentityPolicy),
-		AccessKeyID:    types.StringPointerValue(source.Spec.AccessKeyId),
-		ProjectID:      types.StringValue(source.Metadata.ProjectId),
-		CreationTime:   types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		IdentityPolicy:   types.StringValue(source.Spec.IdentityPolicy),
+		AccessKeyID:      types.StringPointerValue(source.Spec.AccessKeyId),
+		ProjectID:        types.StringValue(source.Metadata.ProjectId),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
 
@@ -67,14 +72,17 @@ func NewObjectStorageAccessKeyModelFromCreate(
 	source *storageapi.ObjectStorageAccessKeyCreateResponseBody,
 ) ObjectStorageAccessKeyModel {
 	return ObjectStorageAccessKeyModel{
-		ID:             types.StringValue(source.Metadata.Id),
-		Name:           types.StringValue(source.Metadata.Name),
-		Description:    types.StringPointerValue(source.Metadata.Description),
-		IdentityPolicy: types.StringValue(source.Spec.IdentityPolicy),
-		AccessKeyID:    types.StringValue(source.Spec.AccessKeyId),
-		Secret:         types.StringValue(source.Spec.Secret),
-		ProjectID:      types.StringValue(source.Metadata.ProjectId),
-		CreationTime:   types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		IdentityPolicy:   types.StringValue(source.Spec.IdentityPolicy),
+		AccessKeyID:      types.StringValue(source.Spec.AccessKeyId),
+		Secret:           types.StringValue(source.Spec.Secret),
+		ProjectID:        types.StringValue(source.Metadata.ProjectId),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
 
@@ -19,10 +19,10 @@ package objectstorage
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,13 +32,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	storageapi "github.com/nscaledev/nscale-sdk-go/storage"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
 var (
 	_ resource.ResourceWithConfigure   = &ObjectStorageAccessKeyResource{}
 	_ resource.ResourceWithImportState = &ObjectStorageAccessKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &ObjectStorageAccessKeyResource{}
 )
 
 type ObjectStorageAccessKeyResourceModel struct {
@@ -77,6 +80,8 @@ func (r *ObjectStorageAccessKeyResource) Configure(
 	}
 
 	r.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.StorageAPIV1, "Object storage access key")...)
 }
 
 func (r *ObjectStorageAccessKeyResource) Metadata(
@@ -141,7 +146,7 @@ func (r *ObjectStorageAccessKeyResource) Schema(
 				},
 			},
 			"secret": schema.StringAttribute{
-				MarkdownDescription: "The S3 secret access key. Returned only when the access key is created and never re-read from the API. Treat as write-once: store it in a secret manager, or replace the resource (`terraform apply -replace=...`) to obtain a new value.",
+				MarkdownDescription: "The S3 secret access key. Returned only when the access key is created and never re-read from the API. Treat as write-once: store it in a secret manager, or replace the resource (`terraform apply -replace=...`) to obtain a new value. Null when the provider is configured with `disallow_sensitive_in_state`, which also fails plans that create an access key.",
 				Computed:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
@@ -159,11 +164,28 @@ func (r *ObjectStorageAccessKeyResource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the access key was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the access key.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the access key.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the access key was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
@@ -174,6 +196,30 @@ func (r *ObjectStorageAccessKeyResource) Schema(
 	}
 }
 
+// ModifyPlan fails the plan of a new access key when the provider disallows
+// sensitive values in state: the API returns the secret only at creation, so
+// the key would be unusable without the copy Terraform keeps.
+func (r *ObjectStorageAccessKeyResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if r.client == nil || !r.client.DisallowSensitiveInState {
+		return
+	}
+
+	if request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		nscale.AddSensitiveInStateError(&response.Diagnostics, "the secret of a new object storage access key", "")
+	}
+}
+
+// setAccessKey sets the attributes read from the access key, keeping the prior
+// form of an empty description.
+func (m *ObjectStorageAccessKeyResourceModel) setAccessKey(model ObjectStorageAccessKeyModel) {
+	model.Description = tftypes.StringWithPriorEmpty(model.Description, m.Description)
+	m.ObjectStorageAccessKeyModel = model
+}
+
 func (r *ObjectStorageAccessKeyResource) setDefaultIDs(data *ObjectStorageAccessKeyResourceModel) {
 	if data.ProjectID.ValueString() == "" {
 		data.ProjectID = types.StringValue(r.client.ProjectID)
@@ -185,6 +231,8 @@ func (r *ObjectStorageAccessKeyResource) Create(
 	request resource.CreateRequest,
 	response *resource.CreateResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.Plan.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageAccessKeyResourceModel](
 		ctx,
 		request.Plan.Get,
@@ -204,10 +252,7 @@ func (r *ObjectStorageAccessKeyResource) Create(
 		params,
 	)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Create Object Storage Access Key",
-			fmt.Sprintf("An error occurred while creating the access key: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Object Storage Access Key", "access key", err)
 		return
 	}
 	defer createResponse.Body.Close()
@@ -215,10 +260,7 @@ func (r *ObjectStorageAccessKeyResource) Create(
 	created, err := nscale.ReadJSONResponsePointer[storageapi.ObjectStorageAccessKeyCreateResponseBody](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Create Object Storage Access Key",
-			fmt.Sprintf("An error occurred while creating the access key: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Object Storage Access Key", "access key", err)
 		return
 	}
 
@@ -234,7 +276,7 @@ func (r *ObjectStorageAccessKeyResource) Create(
 
 	// Persist what we have to state immediately, so a watcher failure later
 	// doesn't strand the secret.
-	data.ObjectStorageAccessKeyModel = NewObjectStorageAccessKeyModelFromCreate(created)
+	data.setAccessKey(NewObjectStorageAccessKeyModelFromCreate(created))
 	data.EndpointID = types.StringValue(endpointID)
 	if diagnostics = response.State.Set(ctx, data); diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -258,7 +300,7 @@ func (r *ObjectStorageAccessKeyResource) Create(
 	// not include it. EndpointID is also a Terraform-only attribute and not
 	// in the Read response, so we re-attach both.
 	preservedSecret := data.Secret
-	data.ObjectStorageAccessKeyModel = NewObjectStorageAccessKeyModel(settled)
+	data.setAccessKey(NewObjectStorageAccessKeyModel(settled))
 	data.Secret = preservedSecret
 	data.EndpointID = types.StringValue(endpointID)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
@@ -269,6 +311,8 @@ func (r *ObjectStorageAccessKeyResource) Read(
 	request resource.ReadRequest,
 	response *resource.ReadResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageAccessKeyResourceModel](
 		ctx,
 		request.State.Get,
@@ -296,8 +340,8 @@ func (r *ObjectStorageAccessKeyResource) Read(
 		return
 	}
 
-	data.ObjectStorageAccessKeyModel = NewObjectStorageAccessKeyModel(accessKey)
-	data.Secret = preservedSecret
+	data.setAccessKey(NewObjectStorageAccessKeyModel(accessKey))
+	data.Secret = r.client.SensitiveValue(preservedSecret)
 	data.EndpointID = preservedEndpointID
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
@@ -322,6 +366,8 @@ func (r *ObjectStorageAccessKeyResource) Delete(
 	request resource.DeleteRequest,
 	response *resource.DeleteResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageAccessKeyResourceModel](
 		ctx,
 		request.State.Get,
@@ -341,21 +387,15 @@ func (r *ObjectStorageAccessKeyResource) Delete(
 		id,
 	)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Delete Object Storage Access Key",
-			fmt.Sprintf("An error occurred while deleting the access key: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Delete, "Object Storage Access Key", "access key", err)
 		return
 	}
 	defer deleteResponse.Body.Close()
 
 	if err = nscale.ReadEmptyResponse(deleteResponse); err != nil {
-		if e, ok := nscale.AsAPIError(err); ok && e.StatusCode != http.StatusNotFound {
+		if !nscale.IsAPIErrorNotFound(err) {
 			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-			response.Diagnostics.AddError(
-				"Failed to Delete Object Storage Access Key",
-				fmt.Sprintf("An error occurred while deleting the access key: %s", err),
-			)
+			apidiag.Add(&response.Diagnostics, apidiag.Delete, "Object Storage Access Key", "access key", err)
 			return
 		}
 	}
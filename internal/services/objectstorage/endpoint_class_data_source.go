@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -60,6 +61,8 @@ func (s *ObjectStorageEndpointClassDataSource) Configure(
 	}
 
 	s.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.StorageAPIV1, "Object storage endpoint class")...)
 }
 
 func (s *ObjectStorageEndpointClassDataSource) Metadata(
@@ -102,6 +105,20 @@ func (s *ObjectStorageEndpointClassDataSource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the endpoint class was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the endpoint class.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the endpoint class.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the endpoint class was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
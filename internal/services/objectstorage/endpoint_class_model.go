@@ -17,20 +17,22 @@ limitations under the License.
 package objectstorage
 
 import (
-	"time"
-
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	storageapi "github.com/nscaledev/nscale-sdk-go/storage"
 )
 
 type ObjectStorageEndpointClassModel struct {
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	Description            types.String `tfsdk:"description"`
-	RegionID               types.String `tfsdk:"region_id"`
-	SupportedEndpointTypes types.List   `tfsdk:"supported_endpoint_types"`
-	CreationTime           types.String `tfsdk:"creation_time"`
+	ID                     types.String      `tfsdk:"id"`
+	Name                   types.String      `tfsdk:"name"`
+	Description            types.String      `tfsdk:"description"`
+	RegionID               types.String      `tfsdk:"region_id"`
+	SupportedEndpointTypes types.List        `tfsdk:"supported_endpoint_types"`
+	CreationTime           timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy              types.String      `tfsdk:"created_by"`
+	ModifiedBy             types.String      `tfsdk:"modified_by"`
+	LastModifiedTime       timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 func NewObjectStorageEndpointClassModel(
@@ -47,6 +49,9 @@ func NewObjectStorageEndpointClassModel(
 		Description:            types.StringPointerValue(source.Metadata.Description),
 		RegionID:               types.StringValue(source.Spec.RegionId),
 		SupportedEndpointTypes: types.ListValueMust(types.StringType, supported),
-		CreationTime:           types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		CreationTime:           timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:              types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:             types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:       timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
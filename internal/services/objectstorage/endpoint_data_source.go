@@ -20,10 +20,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
 
@@ -59,6 +61,8 @@ func (s *ObjectStorageEndpointDataSource) Configure(
 	}
 
 	s.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.StorageAPIV1, "Object storage endpoint")...)
 }
 
 func (s *ObjectStorageEndpointDataSource) Metadata(
@@ -140,6 +144,20 @@ func (s *ObjectStorageEndpointDataSource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the object storage endpoint was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the object storage endpoint.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the object storage endpoint.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the object storage endpoint was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
@@ -151,6 +169,8 @@ func (s *ObjectStorageEndpointDataSource) Read(
 	request datasource.ReadRequest,
 	response *datasource.ReadResponse,
 ) {
+	ctx = s.client.WithProjectIDFrom(ctx, request.Config.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageEndpointModel](ctx, request.Config.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
@@ -160,10 +180,7 @@ func (s *ObjectStorageEndpointDataSource) Read(
 	endpoint, _, err := getObjectStorageEndpoint(ctx, data.ID.ValueString(), s.client)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Read Object Storage Endpoint",
-			fmt.Sprintf("An error occurred while retrieving the object storage endpoint: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Object Storage Endpoint", "object storage endpoint", err)
 		return
 	}
 
@@ -20,8 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -33,16 +33,19 @@ import (
 )
 
 type ObjectStorageEndpointModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	EndpointClassID  types.String `tfsdk:"endpoint_class_id"`
-	IdentityPolicies types.List   `tfsdk:"identity_policies"`
-	Exposure         types.Object `tfsdk:"exposure"`
-	Tags             types.Map    `tfsdk:"tags"`
-	ProjectID        types.String `tfsdk:"project_id"`
-	RegionID         types.String `tfsdk:"region_id"`
-	CreationTime     types.String `tfsdk:"creation_time"`
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	EndpointClassID  types.String      `tfsdk:"endpoint_class_id"`
+	IdentityPolicies types.List        `tfsdk:"identity_policies"`
+	Exposure         types.Object      `tfsdk:"exposure"`
+	Tags             types.Map         `tfsdk:"tags"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	RegionID         types.String      `tfsdk:"region_id"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 // ObjectStorageEndpointIdentityPolicyAttributeType describes the shape of a
@@ -101,7 +104,10 @@ func NewObjectStorageEndpointModel(
 		Tags:             tftypes.TagMapValueMust(tags),
 		ProjectID:        types.StringValue(source.Metadata.ProjectId),
 		RegionID:         types.StringValue(source.Status.RegionId),
-		CreationTime:     types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}, diagnostics
 }
 
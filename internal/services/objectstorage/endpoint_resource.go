@@ -20,10 +20,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"reflect"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,13 +35,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	storageapi "github.com/nscaledev/nscale-sdk-go/storage"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
 var (
 	_ resource.ResourceWithConfigure   = &ObjectStorageEndpointResource{}
 	_ resource.ResourceWithImportState = &ObjectStorageEndpointResource{}
+	_ resource.ResourceWithModifyPlan  = &ObjectStorageEndpointResource{}
 )
 
 type ObjectStorageEndpointResourceModel struct {
@@ -80,6 +83,8 @@ func (r *ObjectStorageEndpointResource) Configure(
 	}
 
 	r.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.StorageAPIV1, "Object storage endpoint")...)
 }
 
 func (r *ObjectStorageEndpointResource) ImportState(
@@ -199,11 +204,28 @@ func (r *ObjectStorageEndpointResource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the object storage endpoint was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the object storage endpoint.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the object storage endpoint.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the object storage endpoint was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
@@ -215,6 +237,13 @@ func (r *ObjectStorageEndpointResource) Schema(
 	}
 }
 
+// setEndpoint sets the attributes read from the endpoint, keeping the prior
+// form of an empty description.
+func (m *ObjectStorageEndpointResourceModel) setEndpoint(model ObjectStorageEndpointModel) {
+	model.Description = tftypes.StringWithPriorEmpty(model.Description, m.Description)
+	m.ObjectStorageEndpointModel = model
+}
+
 // setDefaultIDs fills the region ID from the provider configuration when the plan
 // leaves it empty. The project ID is resolved separately at create (see Create)
 // because an unresolved project ID must raise an error rather than silently default.
@@ -224,11 +253,21 @@ func (r *ObjectStorageEndpointResource) setDefaultIDs(data *ObjectStorageEndpoin
 	}
 }
 
+func (r *ObjectStorageEndpointResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	nscale.EnforceRequiredTags(ctx, r.client, request, response)
+}
+
 func (r *ObjectStorageEndpointResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
 	response *resource.CreateResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.Plan.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageEndpointResourceModel](
 		ctx,
 		request.Plan.Get,
@@ -254,10 +293,7 @@ func (r *ObjectStorageEndpointResource) Create(
 
 	createResponse, err := r.client.Storage.PostApiV1Objectstorageendpoints(ctx, params)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Create Object Storage Endpoint",
-			fmt.Sprintf("An error occurred while creating the object storage endpoint: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Object Storage Endpoint", "object storage endpoint", err)
 		return
 	}
 	defer createResponse.Body.Close()
@@ -265,10 +301,7 @@ func (r *ObjectStorageEndpointResource) Create(
 	endpoint, err := nscale.ReadJSONResponsePointer[storageapi.ObjectStorageEndpointRead](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Create Object Storage Endpoint",
-			fmt.Sprintf("An error occurred while creating the object storage endpoint: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Object Storage Endpoint", "object storage endpoint", err)
 		return
 	}
 
@@ -277,7 +310,7 @@ func (r *ObjectStorageEndpointResource) Create(
 		response.Diagnostics.Append(modelDiags...)
 		return
 	}
-	data.ObjectStorageEndpointModel = endpointModel
+	data.setEndpoint(endpointModel)
 	if diagnostics = response.State.Set(ctx, data); diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
@@ -301,7 +334,7 @@ func (r *ObjectStorageEndpointResource) Create(
 		response.Diagnostics.Append(modelDiags...)
 		return
 	}
-	data.ObjectStorageEndpointModel = settledModel
+	data.setEndpoint(settledModel)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -310,6 +343,8 @@ func (r *ObjectStorageEndpointResource) Read(
 	request resource.ReadRequest,
 	response *resource.ReadResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageEndpointResourceModel](
 		ctx,
 		request.State.Get,
@@ -338,7 +373,7 @@ func (r *ObjectStorageEndpointResource) Read(
 		response.Diagnostics.Append(modelDiags...)
 		return
 	}
-	data.ObjectStorageEndpointModel = endpointModel
+	data.setEndpoint(endpointModel)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -347,6 +382,10 @@ func (r *ObjectStorageEndpointResource) Update(
 	request resource.UpdateRequest,
 	response *resource.UpdateResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.Plan.GetAttribute)
+
+	defer nscale.ReconcileFailedUpdate(ctx, r.Read, response)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageEndpointResourceModel](
 		ctx,
 		request.Plan.Get,
@@ -368,10 +407,7 @@ func (r *ObjectStorageEndpointResource) Update(
 
 	updateResponse, err := r.client.Storage.PutApiV1ObjectstorageendpointsObjectStorageEndpointID(ctx, id, params)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Update Object Storage Endpoint",
-			fmt.Sprintf("An error occurred while updating the object storage endpoint: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Update, "Object Storage Endpoint", "object storage endpoint", err)
 		return
 	}
 	defer updateResponse.Body.Close()
@@ -380,10 +416,7 @@ func (r *ObjectStorageEndpointResource) Update(
 		updateResponse,
 	); readErr != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, readErr)
-		response.Diagnostics.AddError(
-			"Failed to Update Object Storage Endpoint",
-			fmt.Sprintf("An error occurred while updating the object storage endpoint: %s", readErr),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Update, "Object Storage Endpoint", "object storage endpoint", readErr)
 		return
 	}
 
@@ -405,7 +438,7 @@ func (r *ObjectStorageEndpointResource) Update(
 		response.Diagnostics.Append(modelDiags...)
 		return
 	}
-	data.ObjectStorageEndpointModel = settledModel
+	data.setEndpoint(settledModel)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -414,6 +447,8 @@ func (r *ObjectStorageEndpointResource) Delete(
 	request resource.DeleteRequest,
 	response *resource.DeleteResponse,
 ) {
+	ctx = r.client.WithProjectIDFrom(ctx, request.State.GetAttribute)
+
 	data, diagnostics := nscale.ReadTerraformState[ObjectStorageEndpointResourceModel](
 		ctx,
 		request.State.Get,
@@ -428,21 +463,15 @@ func (r *ObjectStorageEndpointResource) Delete(
 
 	deleteResponse, err := r.client.Storage.DeleteApiV1ObjectstorageendpointsObjectStorageEndpointID(ctx, id)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Delete Object Storage Endpoint",
-			fmt.Sprintf("An error occurred while deleting the object storage endpoint: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Delete, "Object Storage Endpoint", "object storage endpoint", err)
 		return
 	}
 	defer deleteResponse.Body.Close()
 
 	if err = nscale.ReadEmptyResponse(deleteResponse); err != nil {
-		if e, ok := nscale.AsAPIError(err); ok && e.StatusCode != http.StatusNotFound {
+		if !nscale.IsAPIErrorNotFound(err) {
 			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-			response.Diagnostics.AddError(
-				"Failed to Delete Object Storage Endpoint",
-				fmt.Sprintf("An error occurred while deleting the object storage endpoint: %s", err),
-			)
+			apidiag.Add(&response.Diagnostics, apidiag.Delete, "Object Storage Endpoint", "object storage endpoint", err)
 			return
 		}
 	}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerinfo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/version"
+)
+
+var _ datasource.DataSourceWithConfigure = &ProviderInfoDataSource{}
+
+// ProviderInfoDataSource reports the versions the provider was built with
+// and the versions of the services it talks to, so that an incompatibility
+// between a provider release and an Nscale environment can be diagnosed.
+type ProviderInfoDataSource struct {
+	client *nscale.Client
+}
+
+func NewProviderInfoDataSource() datasource.DataSource {
+	return &ProviderInfoDataSource{}
+}
+
+func (s *ProviderInfoDataSource) Configure(
+	ctx context.Context,
+	request datasource.ConfigureRequest,
+	response *datasource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	s.client = client
+}
+
+func (s *ProviderInfoDataSource) Metadata(
+	ctx context.Context,
+	request datasource.MetadataRequest,
+	response *datasource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_provider_info"
+}
+
+func (s *ProviderInfoDataSource) Schema(
+	ctx context.Context,
+	request datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Provider Info",
+		Attributes: map[string]schema.Attribute{
+			"provider_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the provider, or `dev` for a build that is not a release.",
+				Computed:            true,
+			},
+			"sdk_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Nscale API client the provider is built with.",
+				Computed:            true,
+			},
+			"api_versions": schema.MapAttribute{
+				MarkdownDescription: "The versions of the service APIs the provider's API client was generated from, by service, such as `region` and `compute`. These are the API versions the provider supports.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"service_versions": schema.MapAttribute{
+				MarkdownDescription: "The release versions of the services deployed in the environment the provider is configured for, by service, for the services that report one, currently `identity` and `region`. A service that does not report its version, or could not be reached, is left out.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (s *ProviderInfoDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	serviceVersions := map[string]string{}
+
+	readVersion := func(service string, get func(context.Context) (*http.Response, error)) {
+		serviceVersion, diagnostics := readServiceVersion(ctx, service, get)
+		response.Diagnostics.Append(diagnostics...)
+		if serviceVersion != "" {
+			serviceVersions[service] = serviceVersion
+		}
+	}
+
+	readVersion("identity", func(ctx context.Context) (*http.Response, error) {
+		return s.client.Identity.GetApiVersion(ctx)
+	})
+	readVersion("region", func(ctx context.Context) (*http.Response, error) {
+		return s.client.Region.GetApiVersion(ctx)
+	})
+
+	data := NewProviderInfoModel(version.ProviderVersion, version.SDKVersion, version.APIVersions, serviceVersions)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// readServiceVersion reads the deployed version of a service with get. It
+// returns an empty version when the service does not report one, as older
+// releases do not, and warns when the version could not be read otherwise,
+// since the rest of the provider's information is still of use.
+func readServiceVersion(
+	ctx context.Context,
+	service string,
+	get func(context.Context) (*http.Response, error),
+) (string, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	response, err := get(ctx)
+	if err == nil {
+		defer response.Body.Close()
+
+		var serviceVersion *coreapi.ServiceVersionRead
+		serviceVersion, err = nscale.ReadJSONResponsePointer[coreapi.ServiceVersionRead](response)
+		if err == nil {
+			return serviceVersion.Version, diagnostics
+		}
+	}
+
+	if nscale.IsAPIErrorNotFound(err) {
+		return "", diagnostics
+	}
+
+	nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+	diagnostics.AddWarning(
+		"Unable to Read Service Version",
+		fmt.Sprintf("The version of the %s service could not be read, so it is left out of service_versions: %s", service, err),
+	)
+
+	return "", diagnostics
+}
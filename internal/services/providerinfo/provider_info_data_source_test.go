@@ -0,0 +1,98 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerinfo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func jsonResponse(status int, body string) func(context.Context) (*http.Response, error) {
+	return func(context.Context) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}
+}
+
+func TestReadServiceVersion(t *testing.T) {
+	testCases := []struct {
+		name        string
+		get         func(context.Context) (*http.Response, error)
+		wantVersion string
+		wantWarning bool
+	}{
+		{
+			name:        "reported",
+			get:         jsonResponse(http.StatusOK, `{"name":"region","version":"v1.17.4"}`),
+			wantVersion: "v1.17.4",
+		},
+		{
+			name: "not reported by an older release",
+			get:  jsonResponse(http.StatusNotFound, `{"error":"not_found","error_description":"no route"}`),
+		},
+		{
+			name:        "server error",
+			get:         jsonResponse(http.StatusInternalServerError, `{"error":"server_error","error_description":"boom"}`),
+			wantWarning: true,
+		},
+		{
+			name: "unreachable",
+			get: func(context.Context) (*http.Response, error) {
+				return nil, errors.New("connection refused")
+			},
+			wantWarning: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, diagnostics := readServiceVersion(t.Context(), "region", testCase.get)
+
+			if got != testCase.wantVersion {
+				t.Errorf("readServiceVersion() = %q, want %q", got, testCase.wantVersion)
+			}
+			if diagnostics.HasError() {
+				t.Errorf("readServiceVersion() error: %v", diagnostics)
+			}
+			if gotWarning := diagnostics.WarningsCount() > 0; gotWarning != testCase.wantWarning {
+				t.Errorf("readServiceVersion() warned = %t, want %t: %v", gotWarning, testCase.wantWarning, diagnostics)
+			}
+		})
+	}
+}
+
+func TestNewProviderInfoModel(t *testing.T) {
+	model := NewProviderInfoModel("1.5.0", "v0.0.4", map[string]string{"region": "1.13.0"}, map[string]string{})
+
+	if model.ProviderVersion.ValueString() != "1.5.0" {
+		t.Errorf("ProviderVersion = %q, want %q", model.ProviderVersion.ValueString(), "1.5.0")
+	}
+	if got := model.APIVersions.Elements()["region"].String(); got != `"1.13.0"` {
+		t.Errorf("APIVersions[region] = %s, want %q", got, "1.13.0")
+	}
+	// No service reporting its version is an empty map, not null.
+	if model.ServiceVersions.IsNull() || len(model.ServiceVersions.Elements()) != 0 {
+		t.Errorf("ServiceVersions = %v, want an empty map", model.ServiceVersions)
+	}
+}
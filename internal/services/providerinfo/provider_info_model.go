@@ -0,0 +1,52 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerinfo
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ProviderInfoModel struct {
+	ProviderVersion types.String `tfsdk:"provider_version"`
+	SDKVersion      types.String `tfsdk:"sdk_version"`
+	APIVersions     types.Map    `tfsdk:"api_versions"`
+	ServiceVersions types.Map    `tfsdk:"service_versions"`
+}
+
+// NewProviderInfoModel returns the model of the provider's build and of the
+// versions of the services deployed, by service.
+func NewProviderInfoModel(
+	providerVersion, sdkVersion string,
+	apiVersions, serviceVersions map[string]string,
+) ProviderInfoModel {
+	return ProviderInfoModel{
+		ProviderVersion: types.StringValue(providerVersion),
+		SDKVersion:      types.StringValue(sdkVersion),
+		APIVersions:     stringMap(apiVersions),
+		ServiceVersions: stringMap(serviceVersions),
+	}
+}
+
+func stringMap(values map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
@@ -19,6 +19,7 @@ package region
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -26,6 +27,7 @@ import (
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 	identityids "github.com/unikorn-cloud/identity/pkg/ids"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
 
@@ -92,6 +94,19 @@ func (s *RegionDataSource) Schema(
 				MarkdownDescription: "The description of the region.",
 				Computed:            true,
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of cloud provider behind the region, such as `openstack` or `kubernetes`.",
+				Computed:            true,
+			},
+			"physical_networks": schema.BoolAttribute{
+				MarkdownDescription: "Whether the region provisions physical networks, as it does when it offers bare-metal machines.",
+				Computed:            true,
+			},
+			"gpu_models": schema.SetAttribute{
+				MarkdownDescription: "The GPU models offered by the flavors available in the region.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -125,35 +140,46 @@ func (s *RegionDataSource) Read(
 
 	regionListResponse, err := s.client.Region.GetApiV1OrganizationsOrganizationIDRegions(ctx, organizationID)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Read Region",
-			fmt.Sprintf("An error occurred while retrieving the region: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Region", "region", err)
 		return
 	}
 
 	regions, err := nscale.ReadJSONResponseValue[[]regionapi.RegionRead](regionListResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Read Region",
-			fmt.Sprintf("An error occurred while retrieving the region: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Region", "region", err)
 		return
 	}
 
 	id := data.ID.ValueString()
 
-	for _, region := range regions {
-		if region.Metadata.Id == id {
-			data = NewRegionModel(&region)
-			response.Diagnostics.Append(response.State.Set(ctx, data)...)
-			return
-		}
+	index := slices.IndexFunc(regions, func(region regionapi.RegionRead) bool { return region.Metadata.Id == id })
+	if index < 0 {
+		response.Diagnostics.AddError(
+			"Region Not Found",
+			fmt.Sprintf("The region with ID %s was not found on the server.", id),
+		)
+		return
 	}
 
-	response.Diagnostics.AddError(
-		"Region Not Found",
-		fmt.Sprintf("The region with ID %s was not found on the server.", id),
+	flavorListResponse, err := s.client.Compute.GetApiV1OrganizationsOrganizationIDRegionsRegionIDFlavors(
+		ctx,
+		s.client.OrganizationID,
+		id,
 	)
+	if err != nil {
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Region", "flavors of the region", err)
+		return
+	}
+	defer flavorListResponse.Body.Close()
+
+	flavors, err := nscale.ReadJSONResponseValue[[]regionapi.Flavor](flavorListResponse)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(&response.Diagnostics, apidiag.Read, "Region", "flavors of the region", err)
+		return
+	}
+
+	data = NewRegionModel(&regions[index], flavors)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
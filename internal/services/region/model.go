@@ -17,20 +17,44 @@ limitations under the License.
 package region
 
 import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 )
 
 type RegionModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Type             types.String `tfsdk:"type"`
+	PhysicalNetworks types.Bool   `tfsdk:"physical_networks"`
+	GPUModels        types.Set    `tfsdk:"gpu_models"`
 }
 
-func NewRegionModel(source *regionapi.RegionRead) RegionModel {
+// NewRegionModel maps a region and the flavors it offers, from which the GPU
+// models available in the region are taken.
+func NewRegionModel(source *regionapi.RegionRead, flavors []regionapi.Flavor) RegionModel {
+	var models []string
+	for _, flavor := range flavors {
+		if flavor.Spec.Gpu != nil && !slices.Contains(models, flavor.Spec.Gpu.Model) {
+			models = append(models, flavor.Spec.Gpu.Model)
+		}
+	}
+	slices.Sort(models)
+
+	gpuModels := make([]attr.Value, 0, len(models))
+	for _, model := range models {
+		gpuModels = append(gpuModels, types.StringValue(model))
+	}
+
 	return RegionModel{
-		ID:          types.StringValue(source.Metadata.Id),
-		Name:        types.StringValue(source.Metadata.Name),
-		Description: types.StringPointerValue(source.Metadata.Description),
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		Type:             types.StringValue(string(source.Spec.Type)),
+		PhysicalNetworks: types.BoolValue(source.Spec.Features.PhysicalNetworks),
+		GPUModels:        types.SetValueMust(types.StringType, gpuModels),
 	}
 }
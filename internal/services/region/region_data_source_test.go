@@ -36,6 +36,9 @@ func TestAccRegionDataSource_byID(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.nscale_region.test", "id", regionID),
 					resource.TestCheckResourceAttrSet("data.nscale_region.test", "name"),
+					resource.TestCheckResourceAttrSet("data.nscale_region.test", "type"),
+					resource.TestCheckResourceAttrSet("data.nscale_region.test", "physical_networks"),
+					resource.TestCheckResourceAttrSet("data.nscale_region.test", "gpu_models.#"),
 				),
 			},
 		},
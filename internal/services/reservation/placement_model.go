@@ -20,8 +20,8 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -35,20 +35,23 @@ import (
 )
 
 type PlacementModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	Tags               types.Map    `tfsdk:"tags"`
-	ReservationID      types.String `tfsdk:"reservation_id"`
-	NetworkID          types.String `tfsdk:"network_id"`
-	HostCount          types.Int64  `tfsdk:"host_count"`
-	Constraints        types.Object `tfsdk:"constraints"`
-	ServerSpec         types.Object `tfsdk:"server_spec"`
-	RegionID           types.String `tfsdk:"region_id"`
-	ReadyHostCount     types.Int64  `tfsdk:"ready_host_count"`
-	ProjectID          types.String `tfsdk:"project_id"`
-	CreationTime       types.String `tfsdk:"creation_time"`
-	ProvisioningStatus types.String `tfsdk:"provisioning_status"`
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Description        types.String      `tfsdk:"description"`
+	Tags               types.Map         `tfsdk:"tags"`
+	ReservationID      types.String      `tfsdk:"reservation_id"`
+	NetworkID          types.String      `tfsdk:"network_id"`
+	HostCount          types.Int64       `tfsdk:"host_count"`
+	Constraints        types.Object      `tfsdk:"constraints"`
+	ServerSpec         types.Object      `tfsdk:"server_spec"`
+	RegionID           types.String      `tfsdk:"region_id"`
+	ReadyHostCount     types.Int64       `tfsdk:"ready_host_count"`
+	ProjectID          types.String      `tfsdk:"project_id"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy          types.String      `tfsdk:"created_by"`
+	ModifiedBy         types.String      `tfsdk:"modified_by"`
+	LastModifiedTime   timetypes.RFC3339 `tfsdk:"last_modified_time"`
+	ProvisioningStatus types.String      `tfsdk:"provisioning_status"`
 }
 
 // PlacementConstraintsModelAttributeType describes the constraints sub-object.
@@ -122,7 +125,10 @@ func NewPlacementModel(source *reservationapi.PlacementV2Read) PlacementModel {
 		RegionID:           types.StringValue(source.Status.RegionId),
 		ReadyHostCount:     readyHostCount,
 		ProjectID:          types.StringValue(source.Metadata.ProjectId),
-		CreationTime:       types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		CreationTime:       timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:          types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:         types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:   timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 		ProvisioningStatus: types.StringValue(string(source.Metadata.ProvisioningStatus)),
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -41,7 +42,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	reservationapi "github.com/nscaledev/nscale-sdk-go/reservation"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -49,6 +52,7 @@ var (
 	_ resource.Resource                   = &PlacementResource{}
 	_ resource.ResourceWithConfigure      = &PlacementResource{}
 	_ resource.ResourceWithImportState    = &PlacementResource{}
+	_ resource.ResourceWithModifyPlan     = &PlacementResource{}
 	_ resource.ResourceWithValidateConfig = &PlacementResource{}
 )
 
@@ -88,10 +92,13 @@ func placementAdapter() nscale.ResourceAdapter[PlacementResourceModel, reservati
 			return getPlacementStatus(ctx, id, client)
 		},
 		ToModel: func(api *reservationapi.PlacementV2Read, dst *PlacementResourceModel) {
+			priorDescription := dst.Description
 			dst.PlacementModel = NewPlacementModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
 		},
 		IDFromModel:       func(m PlacementResourceModel) string { return m.ID.ValueString() },
 		TimeoutsFromModel: func(m PlacementResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:            true,
 	}
 }
 
@@ -294,11 +301,28 @@ func (r *PlacementResource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the placement was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the placement.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the placement.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the placement was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 			"provisioning_status": schema.StringAttribute{
 				MarkdownDescription: "The provisioning status of the placement.",
 				Computed:            true,
@@ -408,10 +432,7 @@ func placementCreate(
 
 	createResponse, err := client.Reservation.CreatePlacement(ctx, params)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Create Placement",
-			fmt.Sprintf("An error occurred while creating the placement: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Placement", "placement", err)
 		return nil, diagnostics
 	}
 	defer createResponse.Body.Close()
@@ -419,10 +440,7 @@ func placementCreate(
 	placement, err := nscale.ReadJSONResponsePointer[reservationapi.PlacementV2Read](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Create Placement",
-			fmt.Sprintf("An error occurred while creating the placement: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Placement", "placement", err)
 		return nil, diagnostics
 	}
 
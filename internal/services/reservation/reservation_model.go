@@ -17,8 +17,7 @@ limitations under the License.
 package reservation
 
 import (
-	"time"
-
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	coreapi "github.com/nscaledev/nscale-sdk-go/common"
@@ -29,21 +28,24 @@ import (
 )
 
 type ReservationModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Description        types.String `tfsdk:"description"`
-	Tags               types.Map    `tfsdk:"tags"`
-	RegionID           types.String `tfsdk:"region_id"`
-	ProjectID          types.String `tfsdk:"project_id"`
-	Accelerator        types.String `tfsdk:"accelerator"`
-	Unit               types.String `tfsdk:"unit"`
-	UnitCount          types.Int64  `tfsdk:"unit_count"`
-	MachineFlavorID    types.String `tfsdk:"machine_flavor_id"`
-	ClaimedUnitCount   types.Int64  `tfsdk:"claimed_unit_count"`
-	TopologyHash       types.String `tfsdk:"topology_hash"`
-	TopologyObservedAt types.String `tfsdk:"topology_observed_at"`
-	CreationTime       types.String `tfsdk:"creation_time"`
-	ProvisioningStatus types.String `tfsdk:"provisioning_status"`
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Description        types.String      `tfsdk:"description"`
+	Tags               types.Map         `tfsdk:"tags"`
+	RegionID           types.String      `tfsdk:"region_id"`
+	ProjectID          types.String      `tfsdk:"project_id"`
+	Accelerator        types.String      `tfsdk:"accelerator"`
+	Unit               types.String      `tfsdk:"unit"`
+	UnitCount          types.Int64       `tfsdk:"unit_count"`
+	MachineFlavorID    types.String      `tfsdk:"machine_flavor_id"`
+	ClaimedUnitCount   types.Int64       `tfsdk:"claimed_unit_count"`
+	TopologyHash       types.String      `tfsdk:"topology_hash"`
+	TopologyObservedAt timetypes.RFC3339 `tfsdk:"topology_observed_at"`
+	CreationTime       timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy          types.String      `tfsdk:"created_by"`
+	ModifiedBy         types.String      `tfsdk:"modified_by"`
+	LastModifiedTime   timetypes.RFC3339 `tfsdk:"last_modified_time"`
+	ProvisioningStatus types.String      `tfsdk:"provisioning_status"`
 }
 
 func NewReservationModel(source *reservationapi.ReservationV2Read) ReservationModel {
@@ -54,11 +56,6 @@ func NewReservationModel(source *reservationapi.ReservationV2Read) ReservationMo
 		topologyHash = types.StringValue(*source.Status.TopologyHash)
 	}
 
-	topologyObservedAt := types.StringNull()
-	if source.Status.TopologyObservedAt != nil {
-		topologyObservedAt = types.StringValue(source.Status.TopologyObservedAt.Format(time.RFC3339))
-	}
-
 	return ReservationModel{
 		ID:                 types.StringValue(source.Metadata.Id),
 		Name:               types.StringValue(source.Metadata.Name),
@@ -72,8 +69,11 @@ func NewReservationModel(source *reservationapi.ReservationV2Read) ReservationMo
 		MachineFlavorID:    types.StringValue(source.Status.MachineFlavorId),
 		ClaimedUnitCount:   types.Int64Value(int64(source.Status.ClaimedUnitCount)),
 		TopologyHash:       topologyHash,
-		TopologyObservedAt: topologyObservedAt,
-		CreationTime:       types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		TopologyObservedAt: timetypes.NewRFC3339TimePointerValue(source.Status.TopologyObservedAt),
+		CreationTime:       timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:          types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:         types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime:   timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 		ProvisioningStatus: types.StringValue(string(source.Metadata.ProvisioningStatus)),
 	}
 }
@@ -18,9 +18,9 @@ package reservation
 
 import (
 	"context"
-	"fmt"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -34,7 +34,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	reservationapi "github.com/nscaledev/nscale-sdk-go/reservation"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -42,6 +44,7 @@ var (
 	_ resource.Resource                = &ReservationResource{}
 	_ resource.ResourceWithConfigure   = &ReservationResource{}
 	_ resource.ResourceWithImportState = &ReservationResource{}
+	_ resource.ResourceWithModifyPlan  = &ReservationResource{}
 )
 
 type ReservationResourceModel struct {
@@ -81,10 +84,13 @@ func reservationAdapter() nscale.ResourceAdapter[ReservationResourceModel, reser
 			return getReservationStatus(ctx, id, client)
 		},
 		ToModel: func(api *reservationapi.ReservationV2Read, dst *ReservationResourceModel) {
+			priorDescription := dst.Description
 			dst.ReservationModel = NewReservationModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
 		},
 		IDFromModel:       func(m ReservationResourceModel) string { return m.ID.ValueString() },
 		TimeoutsFromModel: func(m ReservationResourceModel) tftimeouts.Value { return m.Timeouts },
+		Tagged:            true,
 	}
 }
 
@@ -194,15 +200,33 @@ func (r *ReservationResource) Schema(
 			},
 			"topology_observed_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the claimed topology projection was last observed.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the reservation was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the reservation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the reservation.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the reservation was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 			"provisioning_status": schema.StringAttribute{
 				MarkdownDescription: "The provisioning status of the reservation.",
 				Computed:            true,
@@ -255,10 +279,7 @@ func reservationCreate(
 
 	createResponse, err := client.Reservation.CreateReservation(ctx, params)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Create Reservation",
-			fmt.Sprintf("An error occurred while creating the reservation: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Reservation", "reservation", err)
 		return nil, diagnostics
 	}
 	defer createResponse.Body.Close()
@@ -266,10 +287,7 @@ func reservationCreate(
 	reservation, err := nscale.ReadJSONResponsePointer[reservationapi.ReservationV2Read](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Create Reservation",
-			fmt.Sprintf("An error occurred while creating the reservation: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "Reservation", "reservation", err)
 		return nil, diagnostics
 	}
 
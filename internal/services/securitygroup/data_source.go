@@ -19,6 +19,7 @@ package securitygroup
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,9 +40,10 @@ func NewSecurityGroupDataSource() datasource.DataSource {
 	return &SecurityGroupDataSource{
 		GenericDataSource: nscale.NewGenericDataSource(
 			nscale.DataSourceAdapter[SecurityGroupModel, regionapi.SecurityGroupV2Read]{
-				TypeNameSuffix: "_security_group",
-				Title:          "Security Group",
-				Name:           "security group",
+				TypeNameSuffix:  "_security_group",
+				Title:           "Security Group",
+				Name:            "security group",
+				RequiredFeature: nscale.RegionAPIV2,
 				Get: func(ctx context.Context, client *nscale.Client, id string) (*regionapi.SecurityGroupV2Read, error) {
 					sg, _, err := getSecurityGroup(ctx, id, client)
 					return sg, err
@@ -110,12 +112,34 @@ func (s *SecurityGroupDataSource) Schema(
 				ElementType:         types.StringType,
 				Computed:            true,
 			},
+			"managed_by": schema.StringAttribute{
+				MarkdownDescription: "What manages the security group: `terraform` when Terraform created or last updated it, the value of its `managed-by` tag when set, such as `console`, and `api` otherwise.",
+				Computed:            true,
+			},
 			"region_id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the region where the security group is provisioned.",
 				Computed:            true,
 			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("security group"),
+				Computed:            true,
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the security group was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the security group.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the security group.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the security group was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
@@ -26,6 +26,19 @@ import (
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
 )
 
+// withSecurityGroupProjectID scopes ctx to the project of the security group
+// with the given ID; see nscale.Client.WithProjectIDOf.
+func withSecurityGroupProjectID(ctx context.Context, client *nscale.Client, id string) context.Context {
+	return client.WithProjectIDOf(ctx, func(ctx context.Context) (string, error) {
+		_, metadata, err := getSecurityGroup(ctx, id, client)
+		if err != nil {
+			return "", err
+		}
+
+		return metadata.ProjectId, nil
+	})
+}
+
 func getSecurityGroup(
 	ctx context.Context,
 	id string,
@@ -48,3 +61,35 @@ func getSecurityGroup(
 
 	return securityGroup, &securityGroup.Metadata, nil
 }
+
+// findSecurityGroupByName returns the security group called name on the given
+// network, or nil when there is none.
+func findSecurityGroupByName(
+	ctx context.Context,
+	client *nscale.Client,
+	networkID, name string,
+) (*regionapi.SecurityGroupV2Read, error) {
+	params := &regionapi.GetApiV2SecuritygroupsParams{
+		OrganizationID: &regionapi.OrganizationIDQueryParameter{client.OrganizationID},
+		NetworkID:      &regionapi.NetworkIDQueryParameter{networkID},
+	}
+
+	securityGroupListResponse, err := client.Region.GetApiV2Securitygroups(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	defer securityGroupListResponse.Body.Close()
+
+	securityGroups, err := nscale.ReadJSONResponseValue[regionapi.SecurityGroupsV2Read](securityGroupListResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range securityGroups {
+		if securityGroups[i].Metadata.Name == name {
+			return &securityGroups[i], nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil // no match is an expected outcome, not an error
+}
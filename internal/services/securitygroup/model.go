@@ -18,8 +18,10 @@ package securitygroup
 
 import (
 	"context"
-	"time"
+	"net/netip"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -32,31 +34,53 @@ import (
 )
 
 type SecurityGroupModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	Rules        types.List   `tfsdk:"rules"`
-	NetworkID    types.String `tfsdk:"network_id"`
-	Tags         types.Map    `tfsdk:"tags"`
-	RegionID     types.String `tfsdk:"region_id"`
-	CreationTime types.String `tfsdk:"creation_time"`
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	Rules            types.List        `tfsdk:"rules"`
+	NetworkID        types.String      `tfsdk:"network_id"`
+	Tags             types.Map         `tfsdk:"tags"`
+	ManagedBy        types.String      `tfsdk:"managed_by"`
+	RegionID         types.String      `tfsdk:"region_id"`
+	SpecFingerprint  types.String      `tfsdk:"spec_fingerprint"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 func NewSecurityGroupModel(source *regionapi.SecurityGroupV2Read) SecurityGroupModel {
 	tags := nscale.RemoveOperationTags(source.Metadata.Tags)
 
 	return SecurityGroupModel{
-		ID:           types.StringValue(source.Metadata.Id),
-		Name:         types.StringValue(source.Metadata.Name),
-		Description:  types.StringPointerValue(source.Metadata.Description),
-		Rules:        NewSecurityGroupRuleModels(source.Spec.Rules),
-		NetworkID:    types.StringValue(source.Status.NetworkId),
-		Tags:         tftypes.TagMapValueMust(tags),
-		RegionID:     types.StringValue(source.Status.RegionId),
-		CreationTime: types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		Rules:            NewSecurityGroupRuleModels(source.Spec.Rules),
+		NetworkID:        types.StringValue(source.Status.NetworkId),
+		Tags:             tftypes.TagMapValueMust(tags),
+		ManagedBy:        types.StringValue(nscale.ManagedBy(source.Metadata.Tags)),
+		RegionID:         types.StringValue(source.Status.RegionId),
+		SpecFingerprint:  nscale.SpecFingerprint(source.Spec),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
 
+// DefaultCIDRBlock is the prefix of a rule that does not set one, which matches
+// any IPv4 address.
+const DefaultCIDRBlock = "0.0.0.0/0"
+
+const (
+	// icmpEchoRequestType and icmpEchoRequestCode identify an ICMP echo request,
+	// as sent by ping. ICMP rules carry the type in their port and the code in
+	// their maximum port.
+	icmpEchoRequestType = 8
+	icmpEchoRequestCode = 0
+)
+
 var SecurityGroupRuleModelAttributeType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"type":       types.StringType,
@@ -88,7 +112,8 @@ func NewSecurityGroupRuleModel(source regionapi.SecurityGroupRuleV2) attr.Value
 		toPort = types.Int32Value(v)
 	}
 
-	cidrBlock := types.StringNull()
+	// The API treats a rule without a prefix as matching any address.
+	cidrBlock := types.StringValue(DefaultCIDRBlock)
 	if source.Prefix != nil {
 		cidrBlock = types.StringValue(*source.Prefix)
 	}
@@ -105,6 +130,68 @@ func NewSecurityGroupRuleModel(source regionapi.SecurityGroupRuleV2) attr.Value
 	)
 }
 
+// KeepEquivalentCIDRBlocks returns rules with the cidr_block of each rule taken
+// from the rule at the same position in prior when both denote the same network,
+// so that a prefix the API normalizes, such as 10.0.0.1/8 to 10.0.0.0/8, does
+// not show as a change on every plan.
+func KeepEquivalentCIDRBlocks(rules, prior types.List) types.List {
+	if rules.IsNull() || rules.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return rules
+	}
+
+	elements := rules.Elements()
+	priorElements := prior.Elements()
+
+	kept := make([]attr.Value, len(elements))
+	for i, element := range elements {
+		kept[i] = element
+
+		if i >= len(priorElements) {
+			continue
+		}
+
+		rule, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+
+		priorRule, ok := priorElements[i].(types.Object)
+		if !ok {
+			continue
+		}
+
+		cidrBlock, _ := rule.Attributes()["cidr_block"].(types.String)
+		priorCIDRBlock, _ := priorRule.Attributes()["cidr_block"].(types.String)
+		if !equivalentCIDRBlocks(cidrBlock, priorCIDRBlock) {
+			continue
+		}
+
+		attributes := rule.Attributes()
+		attributes["cidr_block"] = priorCIDRBlock
+		kept[i] = types.ObjectValueMust(SecurityGroupRuleModelAttributeType.AttrTypes, attributes)
+	}
+
+	return types.ListValueMust(SecurityGroupRuleModelAttributeType, kept)
+}
+
+func equivalentCIDRBlocks(a, b types.String) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+
+	prefixA, err := netip.ParsePrefix(a.ValueString())
+	if err != nil {
+		return false
+	}
+
+	prefixB, err := netip.ParsePrefix(b.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return prefixA.Masked() == prefixB.Masked()
+}
+
 func (m *SecurityGroupModel) NscaleSecurityGroupCreateParams() (regionapi.SecurityGroupV2Create, diag.Diagnostics) {
 	tags, diagnostics := tftypes.ValueTagListPointer(m.Tags)
 	if diagnostics.HasError() {
@@ -175,6 +262,43 @@ func (m *SecurityGroupModel) NscaleSecurityGroupUpdateParams() (regionapi.Securi
 	return securityGroup, nil
 }
 
+// icmpEchoRule returns the rule allow_icmp_echo expands to, which lets ICMP echo
+// requests in from any address.
+func icmpEchoRule() regionapi.SecurityGroupRuleV2 {
+	port, portMax, prefix := icmpEchoRequestType, icmpEchoRequestCode, DefaultCIDRBlock
+
+	return regionapi.SecurityGroupRuleV2{
+		Direction: regionapi.NetworkDirectionIngress,
+		Protocol:  regionapi.NetworkProtocolIcmp,
+		Port:      &port,
+		PortMax:   &portMax,
+		Prefix:    &prefix,
+	}
+}
+
+// withoutICMPEchoRule returns rules without the rule allow_icmp_echo expands
+// to, and whether it was present.
+func withoutICMPEchoRule(rules []regionapi.SecurityGroupRuleV2) ([]regionapi.SecurityGroupRuleV2, bool) {
+	echo := icmpEchoRule()
+
+	index := slices.IndexFunc(rules, func(rule regionapi.SecurityGroupRuleV2) bool {
+		return rule.Direction == echo.Direction &&
+			rule.Protocol == echo.Protocol &&
+			equalIntPointers(rule.Port, echo.Port) &&
+			equalIntPointers(rule.PortMax, echo.PortMax) &&
+			(rule.Prefix == nil || *rule.Prefix == *echo.Prefix)
+	})
+	if index < 0 {
+		return rules, false
+	}
+
+	return slices.Delete(slices.Clone(rules), index, index+1), true
+}
+
+func equalIntPointers(a, b *int) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
+
 func NewSecurityGroupRuleModels(source []regionapi.SecurityGroupRuleV2) types.List {
 	rules := make([]attr.Value, 0, len(source))
 	for _, data := range source {
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+)
+
+func testRules(cidrBlocks ...*string) types.List {
+	rules := make([]attr.Value, len(cidrBlocks))
+	for i, cidrBlock := range cidrBlocks {
+		rules[i] = NewSecurityGroupRuleModel(regionapi.SecurityGroupRuleV2{
+			Direction: regionapi.NetworkDirectionIngress,
+			Protocol:  regionapi.NetworkProtocolTcp,
+			Prefix:    cidrBlock,
+		})
+	}
+
+	return types.ListValueMust(SecurityGroupRuleModelAttributeType, rules)
+}
+
+func cidrBlocks(t *testing.T, rules types.List) []string {
+	t.Helper()
+
+	var models []SecurityGroupRuleModel
+	if diagnostics := rules.ElementsAs(t.Context(), &models, false); diagnostics.HasError() {
+		t.Fatalf("failed to read rules: %v", diagnostics)
+	}
+
+	values := make([]string, len(models))
+	for i, model := range models {
+		values[i] = model.CIDRBlock.ValueString()
+	}
+
+	return values
+}
+
+func TestKeepEquivalentCIDRBlocks(t *testing.T) {
+	hostBits := "10.0.0.1/8"
+	network := "10.0.0.0/8"
+	other := "192.168.0.0/16"
+
+	testCases := []struct {
+		name  string
+		rules types.List
+		prior types.List
+		want  []string
+	}{
+		{
+			name:  "normalized prefix keeps the configured value",
+			rules: testRules(&network),
+			prior: testRules(&hostBits),
+			want:  []string{hostBits},
+		},
+		{
+			name:  "changed prefix is reported",
+			rules: testRules(&other),
+			prior: testRules(&hostBits),
+			want:  []string{other},
+		},
+		{
+			name:  "missing prefix reads as the default",
+			rules: testRules(nil),
+			prior: types.ListNull(SecurityGroupRuleModelAttributeType),
+			want:  []string{DefaultCIDRBlock},
+		},
+		{
+			name:  "added rule",
+			rules: testRules(&network, &other),
+			prior: testRules(&hostBits),
+			want:  []string{hostBits, other},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := cidrBlocks(t, KeepEquivalentCIDRBlocks(testCase.rules, testCase.prior))
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("cidr_block values = %v, want %v", got, testCase.want)
+			}
+			for i := range got {
+				if got[i] != testCase.want[i] {
+					t.Fatalf("cidr_block values = %v, want %v", got, testCase.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSetSecurityGroupHidesICMPEchoRule(t *testing.T) {
+	testCases := []struct {
+		name          string
+		allowICMPEcho bool
+		rules         []regionapi.SecurityGroupRuleV2
+		wantAllow     bool
+		wantRules     int
+	}{
+		{
+			name:          "echo rule only",
+			allowICMPEcho: true,
+			rules:         []regionapi.SecurityGroupRuleV2{icmpEchoRule()},
+			wantAllow:     true,
+		},
+		{
+			name:          "echo rule removed outside Terraform",
+			allowICMPEcho: true,
+		},
+		{
+			name:      "echo rule written as a rule",
+			rules:     []regionapi.SecurityGroupRuleV2{icmpEchoRule()},
+			wantRules: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			source := &regionapi.SecurityGroupV2Read{}
+			source.Spec.Rules = testCase.rules
+
+			model := SecurityGroupResourceModel{AllowICMPEcho: types.BoolValue(testCase.allowICMPEcho)}
+			model.Rules = types.ListNull(SecurityGroupRuleModelAttributeType)
+			if testCase.wantRules > 0 {
+				model.Rules = testRules(nil)
+			}
+
+			model.setSecurityGroup(source)
+
+			if got := model.AllowICMPEcho.ValueBool(); got != testCase.wantAllow {
+				t.Fatalf("allow_icmp_echo = %v, want %v", got, testCase.wantAllow)
+			}
+
+			if got := len(model.Rules.Elements()); got != testCase.wantRules {
+				t.Fatalf("got %d rules, want %d", got, testCase.wantRules)
+			}
+
+			if testCase.wantRules == 0 && !model.Rules.IsNull() {
+				t.Fatalf("rules = %v, want null as configured", model.Rules)
+			}
+
+			if len(source.Spec.Rules) != len(testCase.rules) {
+				t.Fatalf("setSecurityGroup() modified the API response")
+			}
+		})
+	}
+}
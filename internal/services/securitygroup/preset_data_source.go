@@ -0,0 +1,123 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+var _ datasource.DataSource = &SecurityGroupPresetDataSource{}
+
+// SecurityGroupPresetDataSource returns the rules of common security group
+// configurations, for the rules of nscale_security_group. It is computed by
+// the provider alone and makes no API requests.
+type SecurityGroupPresetDataSource struct{}
+
+func NewSecurityGroupPresetDataSource() datasource.DataSource {
+	return &SecurityGroupPresetDataSource{}
+}
+
+func (s *SecurityGroupPresetDataSource) Metadata(
+	ctx context.Context,
+	request datasource.MetadataRequest,
+	response *datasource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_security_group_preset"
+}
+
+func (s *SecurityGroupPresetDataSource) Schema(
+	ctx context.Context,
+	request datasource.SchemaRequest,
+	response *datasource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Security Group Preset",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the preset. `ssh-only` allows SSH. `k8s-nodes` allows the kubelet API and the TCP and UDP NodePort range of Kubernetes worker nodes. `nfs-clients` allows NFS and the portmapper over TCP and UDP, for machines serving NFS to the clients in `cidr_block`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(securityGroupPresetNames()...),
+				},
+			},
+			"cidr_block": schema.StringAttribute{
+				MarkdownDescription: "The CIDR block the rules of the preset allow traffic from. Default is `0.0.0.0/0`, which allows traffic from any IP address.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.CIDRValidator{},
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "What the rules of the preset allow.",
+				Computed:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The ingress rules of the preset, in the shape of the `rules` of `nscale_security_group`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the security group rule.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "The protocol for the security group rule.",
+							Computed:            true,
+						},
+						"from_port": schema.Int32Attribute{
+							MarkdownDescription: "The starting port of the port range for the security group rule.",
+							Computed:            true,
+						},
+						"to_port": schema.Int32Attribute{
+							MarkdownDescription: "The ending port of the port range for the security group rule.",
+							Computed:            true,
+						},
+						"cidr_block": schema.StringAttribute{
+							MarkdownDescription: "The CIDR block for the security group rule.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *SecurityGroupPresetDataSource) Read(
+	ctx context.Context,
+	request datasource.ReadRequest,
+	response *datasource.ReadResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[SecurityGroupPresetModel](ctx, request.Config.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	data.setPreset()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
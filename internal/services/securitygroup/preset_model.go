@@ -0,0 +1,111 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SecurityGroupPresetModel struct {
+	Name        types.String `tfsdk:"name"`
+	CIDRBlock   types.String `tfsdk:"cidr_block"`
+	Description types.String `tfsdk:"description"`
+	Rules       types.List   `tfsdk:"rules"`
+}
+
+// presetRule is a rule of a security group preset, which applies to the
+// preset's CIDR block.
+type presetRule struct {
+	protocol string
+	fromPort int32
+	toPort   int32
+}
+
+// securityGroupPreset is a common set of ingress rules.
+type securityGroupPreset struct {
+	description string
+	rules       []presetRule
+}
+
+// securityGroupPresets are the presets of the nscale_security_group_preset
+// data source by name.
+//
+//nolint:gochecknoglobals // constant lookup table.
+var securityGroupPresets = map[string]securityGroupPreset{
+	"ssh-only": {
+		description: "SSH from the CIDR block.",
+		rules: []presetRule{
+			{protocol: "tcp", fromPort: 22, toPort: 22},
+		},
+	},
+	"k8s-nodes": {
+		description: "The kubelet API and the TCP and UDP NodePort range of Kubernetes worker nodes from the CIDR block.",
+		rules: []presetRule{
+			{protocol: "tcp", fromPort: 10250, toPort: 10250},
+			{protocol: "tcp", fromPort: 30000, toPort: 32767},
+			{protocol: "udp", fromPort: 30000, toPort: 32767},
+		},
+	},
+	"nfs-clients": {
+		description: "NFS and the portmapper over TCP and UDP from the NFS clients in the CIDR block.",
+		rules: []presetRule{
+			{protocol: "tcp", fromPort: 111, toPort: 111},
+			{protocol: "udp", fromPort: 111, toPort: 111},
+			{protocol: "tcp", fromPort: 2049, toPort: 2049},
+			{protocol: "udp", fromPort: 2049, toPort: 2049},
+		},
+	},
+}
+
+// securityGroupPresetNames returns the names of the presets in order.
+func securityGroupPresetNames() []string {
+	names := make([]string, 0, len(securityGroupPresets))
+	for name := range securityGroupPresets {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// setPreset sets the description and rules of the preset named by the model,
+// each rule applying to the model's CIDR block.
+func (m *SecurityGroupPresetModel) setPreset() {
+	preset := securityGroupPresets[m.Name.ValueString()]
+
+	if m.CIDRBlock.IsNull() {
+		m.CIDRBlock = types.StringValue(DefaultCIDRBlock)
+	}
+
+	rules := make([]attr.Value, 0, len(preset.rules))
+	for _, rule := range preset.rules {
+		rules = append(rules, types.ObjectValueMust(SecurityGroupRuleModelAttributeType.AttrTypes, map[string]attr.Value{
+			"type":       types.StringValue("ingress"),
+			"protocol":   types.StringValue(rule.protocol),
+			"from_port":  types.Int32Value(rule.fromPort),
+			"to_port":    types.Int32Value(rule.toPort),
+			"cidr_block": m.CIDRBlock,
+		}))
+	}
+
+	m.Description = types.StringValue(preset.description)
+	m.Rules = types.ListValueMust(SecurityGroupRuleModelAttributeType, rules)
+}
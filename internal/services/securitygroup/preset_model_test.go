@@ -0,0 +1,65 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSecurityGroupPresetRulesRoundTrip(t *testing.T) {
+	for _, name := range securityGroupPresetNames() {
+		t.Run(name, func(t *testing.T) {
+			model := SecurityGroupPresetModel{
+				Name:      types.StringValue(name),
+				CIDRBlock: types.StringValue("10.0.0.0/8"),
+			}
+			model.setPreset()
+
+			var rules []SecurityGroupRuleModel
+			if diagnostics := model.Rules.ElementsAs(context.Background(), &rules, false); diagnostics.HasError() {
+				t.Fatalf("failed to decode rules: %v", diagnostics)
+			}
+
+			if len(rules) == 0 {
+				t.Fatal("preset has no rules")
+			}
+
+			// The rules must read back unchanged once applied by
+			// nscale_security_group, or they would show as a change.
+			for i, rule := range rules {
+				if got := NewSecurityGroupRuleModel(rule.NscaleSecurityGroupRule()); !got.Equal(model.Rules.Elements()[i]) {
+					t.Fatalf("rule %d reads back as %v, want %v", i, got, model.Rules.Elements()[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSecurityGroupPresetDefaultCIDRBlock(t *testing.T) {
+	model := SecurityGroupPresetModel{
+		Name:      types.StringValue("ssh-only"),
+		CIDRBlock: types.StringNull(),
+	}
+	model.setPreset()
+
+	if got := model.CIDRBlock.ValueString(); got != DefaultCIDRBlock {
+		t.Fatalf("cidr_block = %q, want %q", got, DefaultCIDRBlock)
+	}
+}
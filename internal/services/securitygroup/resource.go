@@ -22,20 +22,26 @@ import (
 	"time"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 	regionids "github.com/unikorn-cloud/region/pkg/ids"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -44,12 +50,55 @@ const defaultDeleteTimeout = 30 * time.Minute
 var (
 	_ resource.ResourceWithConfigure   = &SecurityGroupResource{}
 	_ resource.ResourceWithImportState = &SecurityGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &SecurityGroupResource{}
 )
 
 type SecurityGroupResourceModel struct {
 	SecurityGroupModel
 
-	Timeouts tftimeouts.Value `tfsdk:"timeouts"`
+	AllowICMPEcho types.Bool       `tfsdk:"allow_icmp_echo"`
+	AdoptExisting types.Bool       `tfsdk:"adopt_existing"`
+	Timeouts      tftimeouts.Value `tfsdk:"timeouts"`
+}
+
+// setSecurityGroup replaces the model with source, keeping the rule prefixes as
+// they were written wherever the API returned the same network in its own
+// canonical form. Rules managed by nscale_security_group_rule resources are
+// left out. While allow_icmp_echo is set, the rule it expands to is left out of
+// the rules, and its absence clears the flag.
+func (m *SecurityGroupResourceModel) setSecurityGroup(source *regionapi.SecurityGroupV2Read) {
+	source = withoutDetachedRules(source)
+
+	prior := m.Rules
+	priorDescription := m.Description
+	allowICMPEcho := m.AllowICMPEcho.ValueBool()
+
+	if allowICMPEcho {
+		stripped := *source
+		stripped.Spec.Rules, allowICMPEcho = withoutICMPEchoRule(source.Spec.Rules)
+		source = &stripped
+	}
+
+	m.SecurityGroupModel = NewSecurityGroupModel(source)
+	m.Description = tftypes.StringWithPriorEmpty(m.Description, priorDescription)
+	m.Rules = KeepEquivalentCIDRBlocks(m.Rules, prior)
+	m.AllowICMPEcho = types.BoolValue(allowICMPEcho)
+
+	if prior.IsNull() && len(m.Rules.Elements()) == 0 {
+		m.Rules = prior
+	}
+}
+
+// nscaleRules returns the rules to send to the API, including the rule that
+// allow_icmp_echo expands to.
+func (m *SecurityGroupResourceModel) nscaleRules(
+	rules []regionapi.SecurityGroupRuleV2,
+) []regionapi.SecurityGroupRuleV2 {
+	if m.AllowICMPEcho.ValueBool() {
+		rules = append(rules, icmpEchoRule())
+	}
+
+	return rules
 }
 
 type SecurityGroupResource struct {
@@ -82,6 +131,8 @@ func (r *SecurityGroupResource) Configure(
 	}
 
 	r.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.RegionAPIV2, "Security group")...)
 }
 
 func (r *SecurityGroupResource) ImportState(
@@ -146,16 +197,18 @@ func (r *SecurityGroupResource) Schema(
 							},
 						},
 						"from_port": schema.Int32Attribute{
-							MarkdownDescription: "The starting port of the port range for the security group rule.",
+							MarkdownDescription: "The starting port of the port range for the security group rule. For the `icmp` protocol, this is the ICMP type, such as `8` for echo requests.",
 							Optional:            true,
 						},
 						"to_port": schema.Int32Attribute{
-							MarkdownDescription: "The ending port of the port range for the security group rule.",
+							MarkdownDescription: "The ending port of the port range for the security group rule. For the `icmp` protocol, this is the ICMP code, such as `0` for echo requests.",
 							Optional:            true,
 						},
 						"cidr_block": schema.StringAttribute{
 							MarkdownDescription: "The CIDR block for the security group rule. Default is `0.0.0.0/0`, which allows traffic from any IP address.",
 							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(DefaultCIDRBlock),
 							Validators: []validator.String{
 								validators.CIDRValidator{},
 							},
@@ -166,6 +219,12 @@ func (r *SecurityGroupResource) Schema(
 					listvalidator.SizeAtLeast(1),
 				},
 			},
+			"allow_icmp_echo": schema.BoolAttribute{
+				MarkdownDescription: "Whether to allow ICMP echo requests, as sent by `ping`, from any address. This adds the matching `icmp` rule, which is not listed in `rules`. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"network_id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the network to which the security group is attached.",
 				Required:            true,
@@ -182,6 +241,10 @@ func (r *SecurityGroupResource) Schema(
 					mapvalidator.KeysAre(validators.NoReservedPrefix(nscale.TerraformOperationTagPrefix)),
 				},
 			},
+			"managed_by": schema.StringAttribute{
+				MarkdownDescription: "What manages the security group: `terraform` when Terraform created or last updated it, the value of its `managed-by` tag when set, such as `console`, and `api` otherwise.",
+				Computed:            true,
+			},
 			"region_id": schema.StringAttribute{
 				MarkdownDescription: "The identifier of the region where the security group is provisioned.",
 				Computed:            true,
@@ -189,13 +252,41 @@ func (r *SecurityGroupResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to adopt an existing security group with the same name on the network instead of creating a new one. The adopted security group is updated to match this configuration and, like any managed security group, is deleted on destroy. Only consulted at create time, so changing it later does not update the security group.",
+				Optional:            true,
+			},
+			"spec_fingerprint": schema.StringAttribute{
+				MarkdownDescription: nscale.SpecFingerprintDescription("security group"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					nscale.SpecFingerprintPlanModifier("rules", "allow_icmp_echo"),
+				},
+			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the security group was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the security group.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the security group.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the security group was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
@@ -207,6 +298,14 @@ func (r *SecurityGroupResource) Schema(
 	}
 }
 
+func (r *SecurityGroupResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	nscale.EnforceRequiredTags(ctx, r.client, request, response)
+}
+
 func (r *SecurityGroupResource) Create(
 	ctx context.Context,
 	request resource.CreateRequest,
@@ -218,32 +317,49 @@ func (r *SecurityGroupResource) Create(
 		return
 	}
 
+	// A security group belongs to the project of its network.
+	ctx = r.client.WithNetworkProjectID(ctx, data.NetworkID.ValueString())
+
+	if data.AdoptExisting.ValueBool() {
+		existing, err := findSecurityGroupByName(ctx, r.client, data.NetworkID.ValueString(), data.Name.ValueString())
+		if err != nil {
+			nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+			response.Diagnostics.AddError(
+				"Failed to Adopt Security Group",
+				fmt.Sprintf("An error occurred while looking up an existing security group to adopt: %s", err),
+			)
+			return
+		}
+
+		if existing != nil {
+			r.adopt(ctx, existing.Metadata.Id, data, response)
+			return
+		}
+	}
+
 	params, diagnostics := data.NscaleSecurityGroupCreateParams()
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
 
+	params.Spec.Rules = data.nscaleRules(params.Spec.Rules)
+	nscale.WriteManagedByTag(&params.Metadata)
+
 	securityGroupCreateResponse, err := r.client.Region.PostApiV2Securitygroups(ctx, params)
 	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Create Security Group",
-			fmt.Sprintf("An error occurred while creating the security group: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Security Group", "security group", err)
 		return
 	}
 
 	securityGroup, err := nscale.ReadJSONResponsePointer[regionapi.SecurityGroupV2Read](securityGroupCreateResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		response.Diagnostics.AddError(
-			"Failed to Create Security Group",
-			fmt.Sprintf("An error occurred while creating the security group: %s", err),
-		)
+		apidiag.Add(&response.Diagnostics, apidiag.Create, "Security Group", "security group", err)
 		return
 	}
 
-	data.SecurityGroupModel = NewSecurityGroupModel(securityGroup)
+	data.setSecurityGroup(securityGroup)
 	if diagnostics = response.State.Set(ctx, data); diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
@@ -263,7 +379,7 @@ func (r *SecurityGroupResource) Create(
 		return
 	}
 
-	data.SecurityGroupModel = NewSecurityGroupModel(securityGroup)
+	data.setSecurityGroup(securityGroup)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -278,6 +394,8 @@ func (r *SecurityGroupResource) Read(
 		return
 	}
 
+	ctx = withSecurityGroupProjectID(ctx, r.client, data.ID.ValueString())
+
 	resourceReader := nscale.ResourceReader[regionapi.SecurityGroupV2Read]{
 		ResourceTitle: "Security Group",
 		ResourceName:  "security group",
@@ -291,7 +409,7 @@ func (r *SecurityGroupResource) Read(
 		return
 	}
 
-	data.SecurityGroupModel = NewSecurityGroupModel(securityGroup)
+	data.setSecurityGroup(securityGroup)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -300,48 +418,27 @@ func (r *SecurityGroupResource) Update(
 	request resource.UpdateRequest,
 	response *resource.UpdateResponse,
 ) {
-	data, diagnostics := nscale.ReadTerraformState[SecurityGroupResourceModel](ctx, request.Plan.Get)
-	if diagnostics.HasError() {
-		response.Diagnostics.Append(diagnostics...)
+	if nscale.RecordCreateOnlyChanges(request, response, "adopt_existing") {
 		return
 	}
 
-	params, diagnostics := data.NscaleSecurityGroupUpdateParams()
+	defer nscale.ReconcileFailedUpdate(ctx, r.Read, response)
+
+	data, diagnostics := nscale.ReadTerraformState[SecurityGroupResourceModel](ctx, request.Plan.Get)
 	if diagnostics.HasError() {
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
 
-	id := data.ID.ValueString()
+	ctx = withSecurityGroupProjectID(ctx, r.client, data.ID.ValueString())
 
-	securityGroupID, ok := nscale.ParseID(id, "Security Group", regionids.ParseSecurityGroupID, &response.Diagnostics)
-	if !ok {
-		return
-	}
+	id := data.ID.ValueString()
 
-	operationTagKey := nscale.WriteOperationTag(&params.Metadata)
+	unlock := securityGroupLocks.Lock(id)
+	defer unlock()
 
-	securityGroupUpdateResponse, err := r.client.Region.PutApiV2SecuritygroupsSecurityGroupID(
-		ctx,
-		securityGroupID,
-		params,
-	)
-	if err != nil {
-		response.Diagnostics.AddError(
-			"Failed to Update Security Group",
-			fmt.Sprintf("An error occurred while updating the security group: %s", err),
-		)
-		return
-	}
-
-	if _, readErr := nscale.ReadJSONResponsePointer[regionapi.SecurityGroupV2Read](
-		securityGroupUpdateResponse,
-	); readErr != nil {
-		nscale.TerraformDebugLogAPIResponseBody(ctx, readErr)
-		response.Diagnostics.AddError(
-			"Failed to Update Security Group",
-			fmt.Sprintf("An error occurred while updating the security group: %s", readErr),
-		)
+	operationTagKey, ok := r.update(ctx, id, data, &response.Diagnostics)
+	if !ok {
 		return
 	}
 
@@ -358,7 +455,7 @@ func (r *SecurityGroupResource) Update(
 		return
 	}
 
-	data.SecurityGroupModel = NewSecurityGroupModel(securityGroup)
+	data.setSecurityGroup(securityGroup)
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
 }
 
@@ -373,6 +470,8 @@ func (r *SecurityGroupResource) Delete(
 		return
 	}
 
+	ctx = withSecurityGroupProjectID(ctx, r.client, data.ID.ValueString())
+
 	id := data.ID.ValueString()
 
 	securityGroupID, ok := nscale.ParseID(id, "Security Group", regionids.ParseSecurityGroupID, &response.Diagnostics)
@@ -420,3 +519,102 @@ func (r *SecurityGroupResource) Delete(
 
 	stateWatcher.Wait(ctx, data.Timeouts, response)
 }
+
+// adopt takes over an existing security group in place of a create by applying
+// the plan to it. State is only written once the update has landed, so a
+// failure never leaves a security group the user did not create tainted (and
+// destroyed on the next apply).
+func (r *SecurityGroupResource) adopt(
+	ctx context.Context,
+	id string,
+	data SecurityGroupResourceModel,
+	response *resource.CreateResponse,
+) {
+	unlock := securityGroupLocks.Lock(id)
+	defer unlock()
+
+	operationTagKey, ok := r.update(ctx, id, data, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	stateWatcher := nscale.UpdateStateWatcher[regionapi.SecurityGroupV2Read]{
+		ResourceTitle: "Security Group",
+		ResourceName:  "security group",
+		GetFunc: func(ctx context.Context) (*regionapi.SecurityGroupV2Read, nscale.ResourceStatus, error) {
+			return nscale.AdaptProjectScoped(getSecurityGroup(ctx, id, r.client))
+		},
+	}
+
+	securityGroup, ok := stateWatcher.WaitAdopted(ctx, operationTagKey, data.Timeouts, response)
+	if !ok {
+		return
+	}
+
+	data.setSecurityGroup(securityGroup)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// update issues the PUT for the planned security group and returns the
+// operation tag the update watcher waits for. The caller holds the security
+// group's lock.
+func (r *SecurityGroupResource) update(
+	ctx context.Context,
+	id string,
+	data SecurityGroupResourceModel,
+	diagnostics *diag.Diagnostics,
+) (string, bool) {
+	params, paramsDiagnostics := data.NscaleSecurityGroupUpdateParams()
+	if paramsDiagnostics.HasError() {
+		diagnostics.Append(paramsDiagnostics...)
+		return "", false
+	}
+
+	params.Spec.Rules = data.nscaleRules(params.Spec.Rules)
+	nscale.WriteManagedByTag(&params.Metadata)
+
+	securityGroupID, ok := nscale.ParseID(id, "Security Group", regionids.ParseSecurityGroupID, diagnostics)
+	if !ok {
+		return "", false
+	}
+
+	current, _, err := getSecurityGroup(ctx, id, r.client)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(diagnostics, apidiag.Read, "Security Group", "security group", err)
+		return "", false
+	}
+
+	// Rules owned by nscale_security_group_rule resources are not in the plan;
+	// carry them over rather than deleting them.
+	if err = preserveDetachedRules(&params, current); err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("rules"),
+			"Failed to Update Security Group",
+			fmt.Sprintf("An error occurred while updating the security group: %s", err),
+		)
+		return "", false
+	}
+
+	operationTagKey := nscale.WriteOperationTag(&params.Metadata)
+
+	securityGroupUpdateResponse, err := r.client.Region.PutApiV2SecuritygroupsSecurityGroupID(
+		ctx,
+		securityGroupID,
+		params,
+	)
+	if err != nil {
+		apidiag.Add(diagnostics, apidiag.Update, "Security Group", "security group", err)
+		return "", false
+	}
+
+	if _, readErr := nscale.ReadJSONResponsePointer[regionapi.SecurityGroupV2Read](
+		securityGroupUpdateResponse,
+	); readErr != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, readErr)
+		apidiag.Add(diagnostics, apidiag.Update, "Security Group", "security group", readErr)
+		return "", false
+	}
+
+	return operationTagKey, true
+}
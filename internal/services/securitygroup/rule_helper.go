@@ -0,0 +1,250 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+// Rules have no identity of their own, so a rule managed by a standalone
+// nscale_security_group_rule is recorded as a tag on its security group, named
+// after a hash of the rule. The tag sits under the reserved prefix, so it never
+// surfaces in the security group's tags attribute and users cannot set it
+// themselves.
+const (
+	detachedRuleTagPrefix = nscale.TerraformOperationTagPrefix + "security-group-rule/"
+	detachedRuleTagValue  = "nscale_security_group_rule"
+
+	// detachedRuleHashLength is the length of the hex-encoded rule hash in
+	// the tag name, long enough that the rules of a security group never
+	// collide.
+	detachedRuleHashLength = 16
+)
+
+// securityGroupLocks serializes the read-modify-write of a security group's
+// rules between the security group and rule resources of a single Terraform
+// run.
+//
+//nolint:gochecknoglobals // shared by every resource instance in the provider process.
+var securityGroupLocks nscale.KeyedLocks
+
+// untaggedRulePrivateKey flags, in the private state of a rule resource, a rule
+// that its security group does not mark as managed by a rule resource, as after
+// an import. ModifyPlan then plans an update, which marks it.
+const untaggedRulePrivateKey = "untagged_rule"
+
+// ruleKey returns the canonical form of a rule, in which rules that match the
+// same traffic are equal: a missing prefix is the default one and prefixes are
+// masked, as the API normalizes them.
+func ruleKey(rule regionapi.SecurityGroupRuleV2) string {
+	prefix := DefaultCIDRBlock
+	if rule.Prefix != nil {
+		prefix = *rule.Prefix
+	}
+
+	if parsed, err := netip.ParsePrefix(prefix); err == nil {
+		prefix = parsed.Masked().String()
+	}
+
+	return strings.Join([]string{
+		string(rule.Direction),
+		string(rule.Protocol),
+		formatPort(rule.Port),
+		formatPort(rule.PortMax),
+		prefix,
+	}, "/")
+}
+
+func formatPort(port *int) string {
+	if port == nil {
+		return ""
+	}
+
+	return strconv.Itoa(*port)
+}
+
+func sameRule(a, b regionapi.SecurityGroupRuleV2) bool {
+	return ruleKey(a) == ruleKey(b)
+}
+
+func detachedRuleTagName(rule regionapi.SecurityGroupRuleV2) string {
+	sum := sha256.Sum256([]byte(ruleKey(rule)))
+	return detachedRuleTagPrefix + hex.EncodeToString(sum[:])[:detachedRuleHashLength]
+}
+
+// isDetachedRule reports whether a standalone rule resource manages rule,
+// given the tags of its security group.
+func isDetachedRule(tags *[]coreapi.Tag, rule regionapi.SecurityGroupRuleV2) bool {
+	if tags == nil {
+		return false
+	}
+
+	name := detachedRuleTagName(rule)
+	return slices.ContainsFunc(*tags, func(tag coreapi.Tag) bool { return tag.Name == name })
+}
+
+// withoutDetachedRules returns a copy of the security group whose spec omits
+// the rules managed by standalone rule resources, which the security group
+// resource must not report as drift.
+func withoutDetachedRules(source *regionapi.SecurityGroupV2Read) *regionapi.SecurityGroupV2Read {
+	securityGroup := *source
+	securityGroup.Spec.Rules = slices.DeleteFunc(
+		slices.Clone(source.Spec.Rules),
+		func(rule regionapi.SecurityGroupRuleV2) bool { return isDetachedRule(source.Metadata.Tags, rule) },
+	)
+
+	return &securityGroup
+}
+
+// preserveDetachedRules carries the rules managed by standalone rule resources,
+// and the tags that mark them, from the current security group into an update
+// built from the security group resource's plan, so the full-spec PUT does not
+// delete them. It fails if the plan declares one of those rules itself.
+func preserveDetachedRules(
+	request *regionapi.SecurityGroupV2Update,
+	current *regionapi.SecurityGroupV2Read,
+) error {
+	for _, rule := range request.Spec.Rules {
+		if isDetachedRule(current.Metadata.Tags, rule) {
+			return fmt.Errorf(
+				"the %s rule is managed by an nscale_security_group_rule resource; remove it from rules",
+				ruleKey(rule),
+			)
+		}
+	}
+
+	for _, rule := range current.Spec.Rules {
+		if isDetachedRule(current.Metadata.Tags, rule) {
+			request.Spec.Rules = append(request.Spec.Rules, rule)
+		}
+	}
+
+	if current.Metadata.Tags == nil {
+		return nil
+	}
+
+	tags := []coreapi.Tag{}
+	if request.Metadata.Tags != nil {
+		tags = *request.Metadata.Tags
+	}
+
+	for _, tag := range *current.Metadata.Tags {
+		if strings.HasPrefix(tag.Name, detachedRuleTagPrefix) {
+			tags = append(tags, tag)
+		}
+	}
+
+	request.Metadata.Tags = &tags
+
+	return nil
+}
+
+// securityGroupUpdateFromRead builds an update that leaves the security group
+// as it is, for the rule resources to modify. Stale operation tags are
+// dropped, but the tags marking detached rules, and the security group as
+// managed by Terraform, are kept.
+func securityGroupUpdateFromRead(source *regionapi.SecurityGroupV2Read) regionapi.SecurityGroupV2Update {
+	var tags *[]coreapi.Tag
+	if source.Metadata.Tags != nil {
+		retained := []coreapi.Tag{}
+		for _, tag := range *source.Metadata.Tags {
+			if !strings.HasPrefix(tag.Name, nscale.TerraformOperationTagPrefix) ||
+				strings.HasPrefix(tag.Name, detachedRuleTagPrefix) ||
+				tag.Name == nscale.ManagedByTagName {
+				retained = append(retained, tag)
+			}
+		}
+		tags = &retained
+	}
+
+	return regionapi.SecurityGroupV2Update{
+		Metadata: coreapi.ResourceWriteMetadata{
+			Name:        source.Metadata.Name,
+			Description: source.Metadata.Description,
+			Tags:        tags,
+		},
+		Spec: regionapi.SecurityGroupV2Spec{
+			Rules: slices.Clone(source.Spec.Rules),
+		},
+	}
+}
+
+// putDetachedRule adds the rule to an update and marks it as managed by a
+// standalone rule resource. It fails if the security group already has the
+// rule, whether a rule resource or the security group itself declares it.
+func putDetachedRule(request *regionapi.SecurityGroupV2Update, rule regionapi.SecurityGroupRuleV2) error {
+	if slices.ContainsFunc(request.Spec.Rules, func(r regionapi.SecurityGroupRuleV2) bool { return sameRule(r, rule) }) {
+		return fmt.Errorf("the security group already has the %s rule", ruleKey(rule))
+	}
+
+	request.Spec.Rules = append(request.Spec.Rules, rule)
+	tagDetachedRule(request, rule)
+
+	return nil
+}
+
+// adoptDetachedRule marks a rule the security group already has, such as an
+// imported one, as managed by a standalone rule resource, adding the rule if it
+// has gone.
+func adoptDetachedRule(request *regionapi.SecurityGroupV2Update, rule regionapi.SecurityGroupRuleV2) error {
+	if !slices.ContainsFunc(request.Spec.Rules, func(r regionapi.SecurityGroupRuleV2) bool { return sameRule(r, rule) }) {
+		return putDetachedRule(request, rule)
+	}
+
+	if !isDetachedRule(request.Metadata.Tags, rule) {
+		tagDetachedRule(request, rule)
+	}
+
+	return nil
+}
+
+// tagDetachedRule adds the tag marking rule as managed by a standalone rule
+// resource to an update.
+func tagDetachedRule(request *regionapi.SecurityGroupV2Update, rule regionapi.SecurityGroupRuleV2) {
+	tags := []coreapi.Tag{}
+	if request.Metadata.Tags != nil {
+		tags = *request.Metadata.Tags
+	}
+
+	tags = append(tags, coreapi.Tag{Name: detachedRuleTagName(rule), Value: detachedRuleTagValue})
+	request.Metadata.Tags = &tags
+}
+
+// removeDetachedRule removes the rule, and the tag marking it, from an update.
+func removeDetachedRule(request *regionapi.SecurityGroupV2Update, rule regionapi.SecurityGroupRuleV2) {
+	request.Spec.Rules = slices.DeleteFunc(
+		request.Spec.Rules,
+		func(r regionapi.SecurityGroupRuleV2) bool { return sameRule(r, rule) },
+	)
+
+	if request.Metadata.Tags != nil {
+		name := detachedRuleTagName(rule)
+		tags := slices.DeleteFunc(*request.Metadata.Tags, func(tag coreapi.Tag) bool { return tag.Name == name })
+		request.Metadata.Tags = &tags
+	}
+}
@@ -0,0 +1,390 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+)
+
+func testRule(port int, prefix string) regionapi.SecurityGroupRuleV2 {
+	return regionapi.SecurityGroupRuleV2{
+		Direction: regionapi.NetworkDirectionIngress,
+		Protocol:  regionapi.NetworkProtocolTcp,
+		Port:      &port,
+		PortMax:   &port,
+		Prefix:    &prefix,
+	}
+}
+
+func testSecurityGroupWithRules(
+	tags []coreapi.Tag,
+	rules ...regionapi.SecurityGroupRuleV2,
+) *regionapi.SecurityGroupV2Read {
+	securityGroup := &regionapi.SecurityGroupV2Read{}
+	securityGroup.Metadata.Tags = &tags
+	securityGroup.Spec.Rules = rules
+	return securityGroup
+}
+
+func rulePorts(rules []regionapi.SecurityGroupRuleV2) []int {
+	ports := make([]int, 0, len(rules))
+	for _, rule := range rules {
+		ports = append(ports, *rule.Port)
+	}
+	return ports
+}
+
+func ruleTagNames(tags *[]coreapi.Tag) []string {
+	if tags == nil {
+		return nil
+	}
+	names := make([]string, 0, len(*tags))
+	for _, tag := range *tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func TestRuleKeyMatchesEquivalentRules(t *testing.T) {
+	a := testRule(22, "10.0.0.1/8")
+	b := testRule(22, "10.0.0.0/8")
+	if !sameRule(a, b) {
+		t.Errorf("sameRule(%s, %s) = false, want true", ruleKey(a), ruleKey(b))
+	}
+	if detachedRuleTagName(a) != detachedRuleTagName(b) {
+		t.Errorf("detachedRuleTagName() differs for equivalent prefixes")
+	}
+
+	withoutPrefix := regionapi.SecurityGroupRuleV2{Direction: regionapi.NetworkDirectionEgress, Protocol: "any"}
+	withDefault := withoutPrefix
+	withDefault.Prefix = new(DefaultCIDRBlock)
+	if !sameRule(withoutPrefix, withDefault) {
+		t.Errorf("a rule without a prefix does not match the default prefix")
+	}
+
+	if sameRule(testRule(22, "10.0.0.0/8"), testRule(443, "10.0.0.0/8")) {
+		t.Errorf("rules with different ports match")
+	}
+}
+
+func TestWithoutDetachedRules(t *testing.T) {
+	ssh, https := testRule(22, DefaultCIDRBlock), testRule(443, DefaultCIDRBlock)
+	securityGroup := testSecurityGroupWithRules(
+		[]coreapi.Tag{{Name: detachedRuleTagName(https), Value: detachedRuleTagValue}},
+		ssh, https,
+	)
+
+	got := withoutDetachedRules(securityGroup)
+	if ports := rulePorts(got.Spec.Rules); !slices.Equal(ports, []int{22}) {
+		t.Fatalf("withoutDetachedRules() ports = %v, want [22]", ports)
+	}
+	if ports := rulePorts(securityGroup.Spec.Rules); !slices.Equal(ports, []int{22, 443}) {
+		t.Fatalf("withoutDetachedRules() modified its input: ports = %v", ports)
+	}
+}
+
+func TestPreserveDetachedRules(t *testing.T) {
+	ssh, https := testRule(22, DefaultCIDRBlock), testRule(443, DefaultCIDRBlock)
+	current := testSecurityGroupWithRules(
+		[]coreapi.Tag{
+			{Name: "team", Value: "platform"},
+			{Name: detachedRuleTagName(https), Value: detachedRuleTagValue},
+		},
+		ssh, https,
+	)
+
+	t.Run("carries detached rules and their tags", func(t *testing.T) {
+		request := regionapi.SecurityGroupV2Update{
+			Metadata: coreapi.ResourceWriteMetadata{Tags: &[]coreapi.Tag{{Name: "team", Value: "platform"}}},
+			Spec:     regionapi.SecurityGroupV2Spec{Rules: []regionapi.SecurityGroupRuleV2{ssh}},
+		}
+
+		if err := preserveDetachedRules(&request, current); err != nil {
+			t.Fatalf("preserveDetachedRules() error = %v", err)
+		}
+
+		if ports := rulePorts(request.Spec.Rules); !slices.Equal(ports, []int{22, 443}) {
+			t.Fatalf("preserveDetachedRules() ports = %v, want [22 443]", ports)
+		}
+
+		wantTags := []string{"team", detachedRuleTagName(https)}
+		if names := ruleTagNames(request.Metadata.Tags); !slices.Equal(names, wantTags) {
+			t.Fatalf("preserveDetachedRules() tags = %v, want %v", names, wantTags)
+		}
+	})
+
+	t.Run("rejects a detached rule declared by the security group", func(t *testing.T) {
+		request := regionapi.SecurityGroupV2Update{
+			Spec: regionapi.SecurityGroupV2Spec{Rules: []regionapi.SecurityGroupRuleV2{ssh, https}},
+		}
+
+		if err := preserveDetachedRules(&request, current); err == nil {
+			t.Fatal("preserveDetachedRules() error = nil, want an error")
+		}
+	})
+}
+
+func TestPutAndRemoveDetachedRule(t *testing.T) {
+	ssh, https := testRule(22, DefaultCIDRBlock), testRule(443, DefaultCIDRBlock)
+	request := securityGroupUpdateFromRead(testSecurityGroupWithRules(
+		[]coreapi.Tag{{Name: "terraform.nscale.com/stale-operation", Value: "2026-01-01T00:00:00Z"}},
+		ssh,
+	))
+
+	if names := ruleTagNames(request.Metadata.Tags); len(names) != 0 {
+		t.Fatalf("securityGroupUpdateFromRead() kept operation tags %v", names)
+	}
+
+	if err := putDetachedRule(&request, https); err != nil {
+		t.Fatalf("putDetachedRule() error = %v", err)
+	}
+	if err := putDetachedRule(&request, testRule(22, "0.0.0.0/0")); err == nil {
+		t.Fatal("putDetachedRule() of an existing rule error = nil, want an error")
+	}
+
+	if ports := rulePorts(request.Spec.Rules); !slices.Equal(ports, []int{22, 443}) {
+		t.Fatalf("putDetachedRule() ports = %v, want [22 443]", ports)
+	}
+	if names := ruleTagNames(request.Metadata.Tags); !slices.Equal(names, []string{detachedRuleTagName(https)}) {
+		t.Fatalf("putDetachedRule() tags = %v", names)
+	}
+
+	removeDetachedRule(&request, https)
+
+	if ports := rulePorts(request.Spec.Rules); !slices.Equal(ports, []int{22}) {
+		t.Fatalf("removeDetachedRule() ports = %v, want [22]", ports)
+	}
+	if names := ruleTagNames(request.Metadata.Tags); len(names) != 0 {
+		t.Fatalf("removeDetachedRule() tags = %v, want none", names)
+	}
+}
+
+func TestSecurityGroupUpdateFromReadKeepsManagedBy(t *testing.T) {
+	var written coreapi.ResourceWriteMetadata
+	nscale.WriteManagedByTag(&written)
+
+	request := securityGroupUpdateFromRead(testSecurityGroupWithRules(*written.Tags))
+
+	if got := nscale.ManagedBy(request.Metadata.Tags); got != nscale.ManagedByTerraform {
+		t.Fatalf("securityGroupUpdateFromRead() managed_by = %q, want %q", got, nscale.ManagedByTerraform)
+	}
+}
+
+func TestSecurityGroupRuleIDRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fromPort types.Int32
+		toPort   types.Int32
+		want     string
+	}{
+		{
+			name:     "port range",
+			fromPort: types.Int32Value(8000),
+			toPort:   types.Int32Value(8080),
+			want:     "sg-1/ingress/tcp/8000/8080/10.0.0.0/8",
+		},
+		{
+			name:     "no ports",
+			fromPort: types.Int32Null(),
+			toPort:   types.Int32Null(),
+			want:     "sg-1/ingress/tcp///10.0.0.0/8",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			model := SecurityGroupRuleResourceModel{
+				SecurityGroupRuleModel: SecurityGroupRuleModel{
+					Type:      types.StringValue("ingress"),
+					Protocol:  types.StringValue("tcp"),
+					FromPort:  testCase.fromPort,
+					ToPort:    testCase.toPort,
+					CIDRBlock: types.StringValue("10.0.0.0/8"),
+				},
+				SecurityGroupID: types.StringValue("sg-1"),
+			}
+
+			id := model.ruleID()
+			if id != testCase.want {
+				t.Fatalf("ruleID() = %q, want %q", id, testCase.want)
+			}
+
+			parsed, err := parseSecurityGroupRuleID(id)
+			if err != nil {
+				t.Fatalf("parseSecurityGroupRuleID() error = %v", err)
+			}
+			if !parsed.SecurityGroupRuleModel.FromPort.Equal(model.FromPort) ||
+				!parsed.SecurityGroupRuleModel.ToPort.Equal(model.ToPort) ||
+				!parsed.SecurityGroupID.Equal(model.SecurityGroupID) ||
+				!parsed.CIDRBlock.Equal(model.CIDRBlock) {
+				t.Fatalf("parseSecurityGroupRuleID() = %+v, want %+v", parsed, model)
+			}
+		})
+	}
+
+	if _, err := parseSecurityGroupRuleID("sg-1/ingress/tcp"); err == nil {
+		t.Fatal("parseSecurityGroupRuleID() of a short ID error = nil, want an error")
+	}
+}
+
+// fakeRegionClient serves a single security group, applying the writes to it.
+type fakeRegionClient struct {
+	regionapi.ClientInterface
+
+	securityGroup regionapi.SecurityGroupV2Read
+	writes        int
+	t             *testing.T
+}
+
+func (c *fakeRegionClient) respond(status int, body any) *http.Response {
+	c.t.Helper()
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		c.t.Fatalf("failed to encode response: %v", err)
+	}
+
+	return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(data))}
+}
+
+func (c *fakeRegionClient) GetApiV2SecuritygroupsSecurityGroupID(
+	_ context.Context,
+	_ regionapi.SecurityGroupIDParameter,
+	_ ...regionapi.RequestEditorFn,
+) (*http.Response, error) {
+	return c.respond(http.StatusOK, c.securityGroup), nil
+}
+
+func (c *fakeRegionClient) PutApiV2SecuritygroupsSecurityGroupID(
+	_ context.Context,
+	_ regionapi.SecurityGroupIDParameter,
+	body regionapi.PutApiV2SecuritygroupsSecurityGroupIDJSONRequestBody,
+	_ ...regionapi.RequestEditorFn,
+) (*http.Response, error) {
+	c.writes++
+	c.securityGroup.Metadata.Tags = body.Metadata.Tags
+	c.securityGroup.Spec = body.Spec
+
+	return c.respond(http.StatusOK, c.securityGroup), nil
+}
+
+func withPrivateState(requestOrResponse any) {
+	field := reflect.ValueOf(requestOrResponse).Elem().FieldByName("Private")
+	field.Set(reflect.New(field.Type().Elem()))
+}
+
+func TestSecurityGroupRuleImportHandover(t *testing.T) {
+	ctx := context.Background()
+
+	const ruleID = "7d1f4a4e-2b8c-4f4e-9a55-0c6d3c9d1e2f/ingress/tcp/22/22/" + DefaultCIDRBlock
+
+	// The rule is declared by the security group resource, so it is not
+	// tagged as managed by a rule resource.
+	ssh := testRule(22, DefaultCIDRBlock)
+	region := &fakeRegionClient{securityGroup: *testSecurityGroupWithRules(nil, ssh), t: t}
+	ruleResource := &SecurityGroupRuleResource{client: &nscale.Client{OrganizationID: "organization", Region: region}}
+
+	var schemaResponse resource.SchemaResponse
+	ruleResource.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	ruleSchema := schemaResponse.Schema
+	nullState := tftypes.NewValue(ruleSchema.Type().TerraformType(ctx), nil)
+
+	importResponse := resource.ImportStateResponse{State: tfsdk.State{Schema: ruleSchema, Raw: nullState}}
+	withPrivateState(&importResponse)
+	ruleResource.ImportState(ctx, resource.ImportStateRequest{ID: ruleID}, &importResponse)
+	if importResponse.Diagnostics.HasError() {
+		t.Fatalf("ImportState() error: %v", importResponse.Diagnostics)
+	}
+
+	readResponse := resource.ReadResponse{State: importResponse.State, Private: importResponse.Private}
+	ruleResource.Read(ctx, resource.ReadRequest{State: importResponse.State}, &readResponse)
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("Read() error: %v", readResponse.Diagnostics)
+	}
+
+	if untagged, _ := nscale.PrivateFlag(ctx, readResponse.Private, untaggedRulePrivateKey); !untagged {
+		t.Fatal("Read() did not flag the imported rule as untagged")
+	}
+
+	planRequest := resource.ModifyPlanRequest{
+		State:   readResponse.State,
+		Plan:    tfsdk.Plan{Schema: ruleSchema, Raw: readResponse.State.Raw},
+		Private: readResponse.Private,
+	}
+	planResponse := resource.ModifyPlanResponse{Plan: planRequest.Plan, Private: planRequest.Private}
+	ruleResource.ModifyPlan(ctx, planRequest, &planResponse)
+	if planResponse.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() error: %v", planResponse.Diagnostics)
+	}
+
+	var id types.String
+	planResponse.Plan.GetAttribute(ctx, path.Root("id"), &id)
+	if !id.IsUnknown() {
+		t.Fatalf("planned id = %v, want unknown so that the apply tags the rule", id)
+	}
+
+	updateResponse := resource.UpdateResponse{
+		State:   tfsdk.State{Schema: ruleSchema, Raw: planResponse.Plan.Raw},
+		Private: planResponse.Private,
+	}
+	ruleResource.Update(
+		ctx,
+		resource.UpdateRequest{State: readResponse.State, Plan: planResponse.Plan, Private: planResponse.Private},
+		&updateResponse,
+	)
+	if updateResponse.Diagnostics.HasError() {
+		t.Fatalf("Update() error: %v", updateResponse.Diagnostics)
+	}
+
+	if region.writes != 1 || len(region.securityGroup.Spec.Rules) != 1 ||
+		!isDetachedRule(region.securityGroup.Metadata.Tags, ssh) {
+		t.Fatalf("Update() wrote the security group %d times, leaving rules %v and tags %v, want the rule tagged once",
+			region.writes, rulePorts(region.securityGroup.Spec.Rules), ruleTagNames(region.securityGroup.Metadata.Tags))
+	}
+
+	if untagged, _ := nscale.PrivateFlag(ctx, updateResponse.Private, untaggedRulePrivateKey); untagged {
+		t.Fatal("Update() left the rule flagged as untagged")
+	}
+
+	updateResponse.State.GetAttribute(ctx, path.Root("id"), &id)
+	if id.ValueString() != ruleID {
+		t.Fatalf("id after Update() = %v, want %q", id, ruleID)
+	}
+
+	finalRead := resource.ReadResponse{State: updateResponse.State, Private: updateResponse.Private}
+	ruleResource.Read(ctx, resource.ReadRequest{State: updateResponse.State}, &finalRead)
+	if untagged, _ := nscale.PrivateFlag(ctx, finalRead.Private, untaggedRulePrivateKey); untagged {
+		t.Fatal("Read() flagged a tagged rule as untagged")
+	}
+}
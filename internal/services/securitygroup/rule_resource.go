@@ -0,0 +1,542 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	coreapi "github.com/nscaledev/nscale-sdk-go/common"
+	regionapi "github.com/nscaledev/nscale-sdk-go/region"
+	regionids "github.com/unikorn-cloud/region/pkg/ids"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
+	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
+)
+
+const defaultSecurityGroupRuleTimeout = 30 * time.Minute
+
+var (
+	_ resource.ResourceWithConfigure   = &SecurityGroupRuleResource{}
+	_ resource.ResourceWithImportState = &SecurityGroupRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &SecurityGroupRuleResource{}
+)
+
+type SecurityGroupRuleResourceModel struct {
+	SecurityGroupRuleModel
+
+	ID              types.String     `tfsdk:"id"`
+	SecurityGroupID types.String     `tfsdk:"security_group_id"`
+	Timeouts        tftimeouts.Value `tfsdk:"timeouts"`
+}
+
+// ruleID returns the identifier of the rule resource, of the form
+// "<security_group_id>/<type>/<protocol>/<from_port>/<to_port>/<cidr_block>",
+// with an empty segment for a port that is not set.
+func (m *SecurityGroupRuleResourceModel) ruleID() string {
+	return strings.Join([]string{
+		m.SecurityGroupID.ValueString(),
+		m.Type.ValueString(),
+		m.Protocol.ValueString(),
+		formatPort(int32PointerToInt(m.FromPort)),
+		formatPort(int32PointerToInt(m.ToPort)),
+		m.CIDRBlock.ValueString(),
+	}, "/")
+}
+
+func int32PointerToInt(value types.Int32) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	v := int(value.ValueInt32())
+	return &v
+}
+
+// SecurityGroupRuleResource manages a single rule of a security group, so the
+// rules of a shared security group can be managed from separate
+// configurations. The API has no endpoint for rules, so every write is a
+// read-modify-write of the security group, serialized per security group
+// through securityGroupLocks.
+type SecurityGroupRuleResource struct {
+	client *nscale.Client
+}
+
+func NewSecurityGroupRuleResource() resource.Resource {
+	return &SecurityGroupRuleResource{}
+}
+
+func (r *SecurityGroupRuleResource) Configure(
+	ctx context.Context,
+	request resource.ConfigureRequest,
+	response *resource.ConfigureResponse,
+) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*nscale.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configuration Type",
+			fmt.Sprintf(
+				"Expected *nscale.Client, got: %T. Please contact the Nscale team for support.",
+				request.ProviderData,
+			),
+		)
+		return
+	}
+
+	r.client = client
+
+	response.Diagnostics.Append(client.RequireFeature(ctx, nscale.RegionAPIV2, "Security group rule")...)
+}
+
+// ImportState parses the resource's composite ID, since rules are identified
+// by their fields within the security group. Import only reads, as Terraform
+// imports while planning: the rule is marked as managed by a rule resource by
+// the first apply after the import; see untaggedRulePrivateKey.
+func (r *SecurityGroupRuleResource) ImportState(
+	ctx context.Context,
+	request resource.ImportStateRequest,
+	response *resource.ImportStateResponse,
+) {
+	data, err := parseSecurityGroupRuleID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf(
+				"Import ID must be of the form '<security_group_id>/<type>/<protocol>/<from_port>/<to_port>/<cidr_block>', "+
+					"with empty ports when they are not set: %s.",
+				err,
+			),
+		)
+		return
+	}
+
+	attributes := map[string]attr.Value{
+		"id":                data.ID,
+		"security_group_id": data.SecurityGroupID,
+		"type":              data.Type,
+		"protocol":          data.Protocol,
+		"from_port":         data.FromPort,
+		"to_port":           data.ToPort,
+		"cidr_block":        data.CIDRBlock,
+	}
+	for name, value := range attributes {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// parseSecurityGroupRuleID parses an identifier returned by ruleID.
+func parseSecurityGroupRuleID(id string) (SecurityGroupRuleResourceModel, error) {
+	const idParts = 6
+
+	parts := strings.SplitN(id, "/", idParts)
+	if len(parts) != idParts || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[5] == "" {
+		return SecurityGroupRuleResourceModel{}, fmt.Errorf("expected %d segments", idParts)
+	}
+
+	ports := make([]types.Int32, 0, 2)
+	for _, part := range parts[3:5] {
+		if part == "" {
+			ports = append(ports, types.Int32Null())
+			continue
+		}
+
+		port, err := strconv.ParseInt(part, 10, 32)
+		if err != nil {
+			return SecurityGroupRuleResourceModel{}, fmt.Errorf("invalid port %q", part)
+		}
+
+		ports = append(ports, types.Int32Value(int32(port)))
+	}
+
+	return SecurityGroupRuleResourceModel{
+		SecurityGroupRuleModel: SecurityGroupRuleModel{
+			Type:      types.StringValue(parts[1]),
+			Protocol:  types.StringValue(parts[2]),
+			FromPort:  ports[0],
+			ToPort:    ports[1],
+			CIDRBlock: types.StringValue(parts[5]),
+		},
+		ID:              types.StringValue(id),
+		SecurityGroupID: types.StringValue(parts[0]),
+	}, nil
+}
+
+func (r *SecurityGroupRuleResource) Metadata(
+	ctx context.Context,
+	request resource.MetadataRequest,
+	response *resource.MetadataResponse,
+) {
+	response.TypeName = request.ProviderTypeName + "_security_group_rule"
+}
+
+func (r *SecurityGroupRuleResource) Schema(
+	ctx context.Context,
+	request resource.SchemaRequest,
+	response *resource.SchemaResponse,
+) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Nscale Security Group Rule",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A unique identifier for the rule, of the form `<security_group_id>/<type>/<protocol>/<from_port>/<to_port>/<cidr_block>`, with empty ports when they are not set.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the security group the rule belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the security group rule. Valid values are `ingress` or `egress`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ingress", "egress"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "The protocol for the security group rule. Valid values are `any`, `tcp`, `udp`, `icmp`, or `vrrp`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("any", "tcp", "udp", "icmp", "vrrp"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_port": schema.Int32Attribute{
+				MarkdownDescription: "The starting port of the port range for the security group rule. For the `icmp` protocol, this is the ICMP type, such as `8` for echo requests.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"to_port": schema.Int32Attribute{
+				MarkdownDescription: "The ending port of the port range for the security group rule. For the `icmp` protocol, this is the ICMP code, such as `0` for echo requests.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"cidr_block": schema.StringAttribute{
+				MarkdownDescription: "The CIDR block for the security group rule. Default is `0.0.0.0/0`, which allows traffic from any IP address.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(DefaultCIDRBlock),
+				Validators: []validator.String{
+					validators.CIDRValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SecurityGroupRuleResource) Create(
+	ctx context.Context,
+	request resource.CreateRequest,
+	response *resource.CreateResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[SecurityGroupRuleResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	ctx = withSecurityGroupProjectID(ctx, r.client, data.SecurityGroupID.ValueString())
+
+	timeout, diagnostics := data.Timeouts.Create(ctx, defaultSecurityGroupRuleTimeout)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	rule := data.NscaleSecurityGroupRule()
+
+	_, ok := r.modifySecurityGroup(ctx, data.SecurityGroupID.ValueString(), timeout, &response.Diagnostics,
+		func(request *regionapi.SecurityGroupV2Update) error {
+			return putDetachedRule(request, rule)
+		},
+	)
+	if !ok {
+		return
+	}
+
+	data.ID = types.StringValue(data.ruleID())
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *SecurityGroupRuleResource) Read(
+	ctx context.Context,
+	request resource.ReadRequest,
+	response *resource.ReadResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[SecurityGroupRuleResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	ctx = withSecurityGroupProjectID(ctx, r.client, data.SecurityGroupID.ValueString())
+
+	rule := data.NscaleSecurityGroupRule()
+
+	resourceReader := nscale.ResourceReader[regionapi.SecurityGroupV2Read]{
+		ResourceTitle: "Security Group Rule",
+		ResourceName:  "security group rule",
+		GetFunc: func(ctx context.Context, _ string) (*regionapi.SecurityGroupV2Read, nscale.ResourceStatus, error) {
+			return nscale.AdaptProjectScoped(getSecurityGroupRule(ctx, r.client, data.SecurityGroupID.ValueString(), rule))
+		},
+	}
+
+	securityGroup, ok := resourceReader.Read(ctx, data.ID.ValueString(), response)
+	if !ok {
+		return
+	}
+
+	untagged := !isDetachedRule(securityGroup.Metadata.Tags, rule)
+	response.Diagnostics.Append(nscale.SetPrivateFlag(ctx, response.Private, untaggedRulePrivateKey, untagged)...)
+
+	// The rule is found by the traffic it matches, so the fields are kept as
+	// they were written rather than in the API's canonical form.
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// ModifyPlan plans an update of a rule its security group does not mark as
+// managed by a rule resource, so that Update marks it.
+func (r *SecurityGroupRuleResource) ModifyPlan(
+	ctx context.Context,
+	request resource.ModifyPlanRequest,
+	response *resource.ModifyPlanResponse,
+) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	untagged, diagnostics := nscale.PrivateFlag(ctx, request.Private, untaggedRulePrivateKey)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	if untagged {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+}
+
+// Update is only called to mark a rule as managed by a rule resource, as
+// ModifyPlan plans after an import, since every attribute but the timeouts
+// forces replacement.
+func (r *SecurityGroupRuleResource) Update(
+	ctx context.Context,
+	request resource.UpdateRequest,
+	response *resource.UpdateResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[SecurityGroupRuleResourceModel](ctx, request.Plan.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	ctx = withSecurityGroupProjectID(ctx, r.client, data.SecurityGroupID.ValueString())
+
+	// Marking the rule makes it the rule resource's, so the create timeout
+	// applies.
+	timeout, diagnostics := data.Timeouts.Create(ctx, defaultSecurityGroupRuleTimeout)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	rule := data.NscaleSecurityGroupRule()
+	data.ID = types.StringValue(data.ruleID())
+
+	_, ok := r.modifySecurityGroup(ctx, data.SecurityGroupID.ValueString(), timeout, &response.Diagnostics,
+		func(request *regionapi.SecurityGroupV2Update) error {
+			return adoptDetachedRule(request, rule)
+		},
+	)
+	if !ok {
+		// The rule is as it was, and stays flagged, so the next apply retries.
+		response.Diagnostics.Append(response.State.Set(ctx, data)...)
+		return
+	}
+
+	response.Diagnostics.Append(nscale.SetPrivateFlag(ctx, response.Private, untaggedRulePrivateKey, false)...)
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *SecurityGroupRuleResource) Delete(
+	ctx context.Context,
+	request resource.DeleteRequest,
+	response *resource.DeleteResponse,
+) {
+	data, diagnostics := nscale.ReadTerraformState[SecurityGroupRuleResourceModel](ctx, request.State.Get)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	ctx = withSecurityGroupProjectID(ctx, r.client, data.SecurityGroupID.ValueString())
+
+	timeout, diagnostics := data.Timeouts.Delete(ctx, defaultSecurityGroupRuleTimeout)
+	if diagnostics.HasError() {
+		response.Diagnostics.Append(diagnostics...)
+		return
+	}
+
+	// A rule whose security group is already gone has been deleted along with
+	// it.
+	securityGroupID := data.SecurityGroupID.ValueString()
+	if _, _, err := getSecurityGroup(ctx, securityGroupID, r.client); nscale.IsAPIErrorNotFound(err) {
+		return
+	}
+
+	rule := data.NscaleSecurityGroupRule()
+
+	r.modifySecurityGroup(ctx, securityGroupID, timeout, &response.Diagnostics,
+		func(request *regionapi.SecurityGroupV2Update) error {
+			removeDetachedRule(request, rule)
+			return nil
+		},
+	)
+}
+
+// modifySecurityGroup applies mutate to the current security group and writes
+// it back, holding the security group's lock until the write is observed, so
+// the next rule resource to modify the security group reads it with this
+// change applied.
+func (r *SecurityGroupRuleResource) modifySecurityGroup(
+	ctx context.Context,
+	id string,
+	timeout time.Duration,
+	diagnostics *diag.Diagnostics,
+	mutate func(request *regionapi.SecurityGroupV2Update) error,
+) (*regionapi.SecurityGroupV2Read, bool) {
+	securityGroupID, ok := nscale.ParseID(id, "Security Group", regionids.ParseSecurityGroupID, diagnostics)
+	if !ok {
+		return nil, false
+	}
+
+	unlock := securityGroupLocks.Lock(id)
+	defer unlock()
+
+	securityGroup, _, err := getSecurityGroup(ctx, id, r.client)
+	if err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(diagnostics, apidiag.Read, "Security Group", "security group", err)
+		return nil, false
+	}
+
+	params := securityGroupUpdateFromRead(securityGroup)
+	if err = mutate(&params); err != nil {
+		apidiag.Add(diagnostics, apidiag.Update, "Security Group Rule", "security group rule", err)
+		return nil, false
+	}
+
+	operationTagKey := nscale.WriteOperationTag(&params.Metadata)
+
+	updateResponse, err := r.client.Region.PutApiV2SecuritygroupsSecurityGroupID(ctx, securityGroupID, params)
+	if err != nil {
+		apidiag.Add(diagnostics, apidiag.Update, "Security Group Rule", "security group rule", err)
+		return nil, false
+	}
+	defer updateResponse.Body.Close()
+
+	if _, err = nscale.ReadJSONResponsePointer[regionapi.SecurityGroupV2Read](updateResponse); err != nil {
+		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
+		apidiag.Add(diagnostics, apidiag.Update, "Security Group Rule", "security group rule", err)
+		return nil, false
+	}
+
+	stateWatcher := nscale.UpdateStateWatcher[regionapi.SecurityGroupV2Read]{
+		ResourceTitle: "Security Group",
+		ResourceName:  "security group",
+		GetFunc: func(ctx context.Context) (*regionapi.SecurityGroupV2Read, nscale.ResourceStatus, error) {
+			return nscale.AdaptProjectScoped(getSecurityGroup(ctx, id, r.client))
+		},
+	}
+
+	return stateWatcher.WaitFor(ctx, operationTagKey, timeout, diagnostics)
+}
+
+// getSecurityGroupRule fetches the security group that holds the rule,
+// reporting a missing rule as not found so the shared reader drops it from
+// state.
+func getSecurityGroupRule(
+	ctx context.Context,
+	client *nscale.Client,
+	securityGroupID string,
+	rule regionapi.SecurityGroupRuleV2,
+) (*regionapi.SecurityGroupV2Read, *coreapi.ProjectScopedResourceReadMetadata, error) {
+	securityGroup, metadata, err := getSecurityGroup(ctx, securityGroupID, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := slices.ContainsFunc(securityGroup.Spec.Rules, func(r regionapi.SecurityGroupRuleV2) bool {
+		return sameRule(r, rule)
+	})
+	if !found {
+		err = &nscale.APIError{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("failed to find the %s rule in security group '%s'", ruleKey(rule), securityGroupID),
+		}
+
+		return nil, nil, err
+	}
+
+	return securityGroup, metadata, nil
+}
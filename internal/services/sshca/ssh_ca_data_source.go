@@ -19,6 +19,7 @@ package sshca
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
@@ -38,9 +39,10 @@ func NewSSHCertificateAuthorityDataSource() datasource.DataSource {
 	return &SSHCertificateAuthorityDataSource{
 		GenericDataSource: nscale.NewGenericDataSource(
 			nscale.DataSourceAdapter[SSHCertificateAuthorityModel, regionapi.SshCertificateAuthorityV2Read]{
-				TypeNameSuffix: "_ssh_certificate_authority",
-				Title:          "SSH Certificate Authority",
-				Name:           "ssh_certificate_authority",
+				TypeNameSuffix:  "_ssh_certificate_authority",
+				Title:           "SSH Certificate Authority",
+				Name:            "ssh_certificate_authority",
+				RequiredFeature: nscale.RegionAPIV2,
 				Get: func(ctx context.Context, client *nscale.Client, id string) (*regionapi.SshCertificateAuthorityV2Read, error) {
 					sshCA, _, err := getSSHCA(ctx, id, client)
 					return sshCA, err
@@ -82,6 +84,20 @@ func (s *SSHCertificateAuthorityDataSource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the SSH certificate authority was created.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the SSH certificate authority.",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the SSH certificate authority.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the SSH certificate authority was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 			},
 		},
@@ -18,30 +18,36 @@ package sshca
 
 import (
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	coreapi "github.com/nscaledev/nscale-sdk-go/common"
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 )
 
 type SSHCertificateAuthorityModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	PublicKey    types.String `tfsdk:"public_key"`
-	ProjectID    types.String `tfsdk:"project_id"`
-	CreationTime types.String `tfsdk:"creation_time"`
+	ID               types.String      `tfsdk:"id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	PublicKey        types.String      `tfsdk:"public_key"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	CreationTime     timetypes.RFC3339 `tfsdk:"creation_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	ModifiedBy       types.String      `tfsdk:"modified_by"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
 }
 
 func NewSSHCertificateAuthorityModel(source *regionapi.SshCertificateAuthorityV2Read) SSHCertificateAuthorityModel {
 	return SSHCertificateAuthorityModel{
-		ID:           types.StringValue(source.Metadata.Id),
-		Name:         types.StringValue(source.Metadata.Name),
-		Description:  types.StringPointerValue(source.Metadata.Description),
-		PublicKey:    types.StringValue(strings.TrimSpace(source.Spec.PublicKey)),
-		ProjectID:    types.StringValue(source.Metadata.ProjectId),
-		CreationTime: types.StringValue(source.Metadata.CreationTime.Format(time.RFC3339)),
+		ID:               types.StringValue(source.Metadata.Id),
+		Name:             types.StringValue(source.Metadata.Name),
+		Description:      types.StringPointerValue(source.Metadata.Description),
+		PublicKey:        types.StringValue(strings.TrimSpace(source.Spec.PublicKey)),
+		ProjectID:        types.StringValue(source.Metadata.ProjectId),
+		CreationTime:     timetypes.NewRFC3339TimeValue(source.Metadata.CreationTime),
+		CreatedBy:        types.StringPointerValue(source.Metadata.CreatedBy),
+		ModifiedBy:       types.StringPointerValue(source.Metadata.ModifiedBy),
+		LastModifiedTime: timetypes.NewRFC3339TimePointerValue(source.Metadata.ModifiedTime),
 	}
 }
 
@@ -18,10 +18,10 @@ package sshca
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	tftimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -32,7 +32,9 @@ import (
 	regionapi "github.com/nscaledev/nscale-sdk-go/region"
 	regionids "github.com/unikorn-cloud/region/pkg/ids"
 
+	"github.com/nscaledev/terraform-provider-nscale/internal/apidiag"
 	"github.com/nscaledev/terraform-provider-nscale/internal/nscale"
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 	"github.com/nscaledev/terraform-provider-nscale/internal/validators"
 )
 
@@ -64,10 +66,11 @@ func NewSSHCertificateAuthorityResource() resource.Resource {
 // generic resource skeleton.
 func sshCAAdapter() nscale.ResourceAdapter[SSHCertificateAuthorityResourceModel, regionapi.SshCertificateAuthorityV2Read] {
 	return nscale.ResourceAdapter[SSHCertificateAuthorityResourceModel, regionapi.SshCertificateAuthorityV2Read]{
-		TypeNameSuffix: "_ssh_certificate_authority",
-		Title:          "SSH Certificate Authority",
-		Name:           "ssh_certificate_authority",
-		Create:         sshCACreate,
+		TypeNameSuffix:  "_ssh_certificate_authority",
+		Title:           "SSH Certificate Authority",
+		Name:            "ssh_certificate_authority",
+		RequiredFeature: nscale.RegionAPIV2,
+		Create:          sshCACreate,
 		// SSH certificate authorities are immutable: a nil Update tells the base
 		// to reject in-place updates so every change forces a replacement.
 		Update: nil,
@@ -80,7 +83,9 @@ func sshCAAdapter() nscale.ResourceAdapter[SSHCertificateAuthorityResourceModel,
 			return nscale.AdaptProjectScoped(getSSHCA(ctx, id, client))
 		},
 		ToModel: func(api *regionapi.SshCertificateAuthorityV2Read, dst *SSHCertificateAuthorityResourceModel) {
+			priorDescription := dst.Description
 			dst.SSHCertificateAuthorityModel = NewSSHCertificateAuthorityModel(api)
+			dst.Description = tftypes.StringWithPriorEmpty(dst.Description, priorDescription)
 		},
 		IDFromModel:       func(m SSHCertificateAuthorityResourceModel) string { return m.ID.ValueString() },
 		TimeoutsFromModel: func(m SSHCertificateAuthorityResourceModel) tftimeouts.Value { return m.Timeouts },
@@ -138,11 +143,28 @@ func (r *SSHCertificateAuthorityResource) Schema(
 			},
 			"creation_time": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the SSH certificate authority was created.",
+				CustomType:          timetypes.RFC3339Type{},
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who created the SSH certificate authority.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "The identity of the user who last modified the SSH certificate authority.",
+				Computed:            true,
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the SSH certificate authority was last modified.",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": tftimeouts.Block(ctx, tftimeouts.Opts{
@@ -170,10 +192,7 @@ func sshCACreate(
 
 	createResponse, err := client.Region.PostApiV2Sshcertificateauthorities(ctx, params)
 	if err != nil {
-		diagnostics.AddError(
-			"Failed to Create SSH Certificate Authority",
-			fmt.Sprintf("An error occurred while creating the SSH certificate authority: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "SSH Certificate Authority", "SSH certificate authority", err)
 		return nil, diagnostics
 	}
 	defer createResponse.Body.Close()
@@ -181,10 +200,7 @@ func sshCACreate(
 	sshCA, err := nscale.ReadJSONResponsePointer[regionapi.SshCertificateAuthorityV2Read](createResponse)
 	if err != nil {
 		nscale.TerraformDebugLogAPIResponseBody(ctx, err)
-		diagnostics.AddError(
-			"Failed to Create SSH Certificate Authority",
-			fmt.Sprintf("An error occurred while creating the SSH certificate authority: %s", err),
-		)
+		apidiag.Add(&diagnostics, apidiag.Create, "SSH Certificate Authority", "SSH certificate authority", err)
 		return nil, diagnostics
 	}
 
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tftypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	terraformtypes "github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = Base64StringType{}
+	_ basetypes.StringValuableWithSemanticEquals = Base64StringValue{}
+)
+
+// DecodeBase64 decodes standard base64, tolerating missing padding and the
+// line breaks and trailing newlines that heredocs and files leave in.
+func DecodeBase64(value string) ([]byte, error) {
+	value = strings.Join(strings.Fields(value), "")
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(value, "="))
+}
+
+// Base64StringType is the type of a string holding base64-encoded data, such
+// as user_data. Its values are equal when they decode to the same bytes, so a
+// different padding or trailing newline does not show as a change.
+type Base64StringType struct {
+	basetypes.StringType
+}
+
+func (t Base64StringType) Equal(o attr.Type) bool {
+	other, ok := o.(Base64StringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t Base64StringType) String() string {
+	return "tftypes.Base64StringType"
+}
+
+func (t Base64StringType) ValueFromString(
+	_ context.Context,
+	in basetypes.StringValue,
+) (basetypes.StringValuable, diag.Diagnostics) {
+	return Base64StringValue{StringValue: in}, nil
+}
+
+func (t Base64StringType) ValueFromTerraform(ctx context.Context, in terraformtypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return Base64StringValue{StringValue: stringValue}, nil
+}
+
+func (t Base64StringType) ValueType(_ context.Context) attr.Value {
+	return Base64StringValue{}
+}
+
+// Base64StringValue is a value of Base64StringType.
+type Base64StringValue struct {
+	basetypes.StringValue
+}
+
+func NewBase64StringNull() Base64StringValue {
+	return Base64StringValue{StringValue: basetypes.NewStringNull()}
+}
+
+func NewBase64StringValue(value string) Base64StringValue {
+	return Base64StringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v Base64StringValue) Equal(o attr.Value) bool {
+	other, ok := o.(Base64StringValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// CanonicalValueString returns the value as padded standard base64 without
+// whitespace, the form the APIs and cloud-init expect, or the value as it is
+// when it is not valid base64.
+func (v Base64StringValue) CanonicalValueString() string {
+	data, err := DecodeBase64(v.ValueString())
+	if err != nil {
+		return v.ValueString()
+	}
+
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func (v Base64StringValue) Type(_ context.Context) attr.Type {
+	return Base64StringType{}
+}
+
+// StringSemanticEquals reports whether both values decode to the same bytes.
+// Values that are not valid base64 are only equal to themselves.
+func (v Base64StringValue) StringSemanticEquals(
+	_ context.Context,
+	newValuable basetypes.StringValuable,
+) (bool, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	newValue, ok := newValuable.(Base64StringValue)
+	if !ok {
+		diagnostics.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please contact the Nscale team for support.", v, newValuable),
+		)
+		return false, diagnostics
+	}
+
+	prior, err := DecodeBase64(v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	next, err := DecodeBase64(newValue.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	return bytes.Equal(prior, next), nil
+}
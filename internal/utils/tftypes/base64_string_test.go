@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tftypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestBase64StringSemanticEquals(t *testing.T) {
+	testCases := []struct {
+		name  string
+		prior string
+		next  string
+		want  bool
+	}{
+		{name: "identical", prior: "aGVsbG8gd29ybGQ=", next: "aGVsbG8gd29ybGQ=", want: true},
+		{name: "padding dropped", prior: "aGVsbG8gd29ybGQ=", next: "aGVsbG8gd29ybGQ", want: true},
+		{name: "trailing newline", prior: "aGVsbG8gd29ybGQ=", next: "aGVsbG8gd29ybGQ=\n", want: true},
+		{name: "wrapped lines", prior: "aGVsbG8gd29ybGQ=", next: "aGVsbG8g\nd29ybGQ=", want: true},
+		{name: "different data", prior: "aGVsbG8gd29ybGQ=", next: "aGVsbG8gdGhlcmU=", want: false},
+		{name: "invalid base64", prior: "not base64!", next: "not base64!", want: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			equal, diagnostics := NewBase64StringValue(testCase.prior).StringSemanticEquals(
+				context.Background(),
+				NewBase64StringValue(testCase.next),
+			)
+			if diagnostics.HasError() {
+				t.Fatalf("StringSemanticEquals() diagnostics = %v", diagnostics)
+			}
+
+			if equal != testCase.want {
+				t.Fatalf("StringSemanticEquals(%q, %q) = %v, want %v", testCase.prior, testCase.next, equal, testCase.want)
+			}
+		})
+	}
+
+	t.Run("other value type", func(t *testing.T) {
+		_, diagnostics := NewBase64StringValue("").StringSemanticEquals(
+			context.Background(),
+			basetypes.NewStringValue(""),
+		)
+		if !diagnostics.HasError() {
+			t.Fatal("StringSemanticEquals() accepted a plain string value")
+		}
+	})
+}
+
+func TestBase64StringCanonicalValueString(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "canonical", value: "aGVsbG8gd29ybGQ=", want: "aGVsbG8gd29ybGQ="},
+		{name: "padding dropped", value: "aGVsbG8gd29ybGQ", want: "aGVsbG8gd29ybGQ="},
+		{name: "wrapped lines", value: "aGVsbG8g\nd29ybGQ=\n", want: "aGVsbG8gd29ybGQ="},
+		{name: "invalid base64", value: "not base64!", want: "not base64!"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := NewBase64StringValue(testCase.value).CanonicalValueString(); got != testCase.want {
+				t.Fatalf("CanonicalValueString(%q) = %q, want %q", testCase.value, got, testCase.want)
+			}
+		})
+	}
+}
@@ -77,3 +77,16 @@ func ValueTagListPointer(tagMap basetypes.MapValue) (*[]coreapi.Tag, diag.Diagno
 
 	return &tags, nil
 }
+
+// StringWithPriorEmpty returns value, as read from an API, or prior when both
+// are empty and differ only in one being null and the other "". The APIs do
+// not tell a missing description from an empty one, so either can come back
+// for the other, and keeping the form in the configuration or state avoids
+// perpetual diffs and inconsistent results after apply.
+func StringWithPriorEmpty(value, prior basetypes.StringValue) basetypes.StringValue {
+	if value.ValueString() == "" && !value.IsUnknown() && prior.ValueString() == "" && !prior.IsUnknown() {
+		return prior
+	}
+
+	return value
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tftypes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestStringWithPriorEmpty(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value basetypes.StringValue
+		prior basetypes.StringValue
+		want  basetypes.StringValue
+	}{
+		{name: "null read for empty", value: types.StringNull(), prior: types.StringValue(""), want: types.StringValue("")},
+		{name: "empty read for null", value: types.StringValue(""), prior: types.StringNull(), want: types.StringNull()},
+		{name: "set", value: types.StringValue("web"), prior: types.StringValue(""), want: types.StringValue("web")},
+		{name: "cleared", value: types.StringNull(), prior: types.StringValue("web"), want: types.StringNull()},
+		{name: "unknown prior", value: types.StringNull(), prior: types.StringUnknown(), want: types.StringNull()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := StringWithPriorEmpty(testCase.value, testCase.prior); !got.Equal(testCase.want) {
+				t.Fatalf("StringWithPriorEmpty(%v, %v) = %v, want %v", testCase.value, testCase.prior, got, testCase.want)
+			}
+		})
+	}
+}
@@ -18,10 +18,11 @@ package validators
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/nscaledev/terraform-provider-nscale/internal/utils/tftypes"
 )
 
 type Base64Validator struct{}
@@ -45,7 +46,7 @@ func (v Base64Validator) ValidateString(
 
 	value := request.ConfigValue.ValueString()
 
-	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+	if _, err := tftypes.DecodeBase64(value); err != nil {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
 			"Invalid Base64 Encoded String",
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ExtraSpecValidator checks that an extra_spec_json value is a JSON object
+// that does not set any of ManagedFields, the dot-separated paths of the
+// request fields the provider sets from other attributes. An extra spec may
+// add fields inside an object the provider manages, such as a new field of
+// "machine", but not replace the object or a managed field in it.
+type ExtraSpecValidator struct {
+	ManagedFields []string
+}
+
+func (v ExtraSpecValidator) Description(ctx context.Context) string {
+	return "must be a JSON object that does not set fields managed by other attributes"
+}
+
+func (v ExtraSpecValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ExtraSpecValidator) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var extra map[string]any
+	if err := json.Unmarshal([]byte(request.ConfigValue.ValueString()), &extra); err != nil || extra == nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Extra Spec",
+			fmt.Sprintf("Attribute %s %s, got: %s", request.Path, v.Description(ctx), request.ConfigValue.ValueString()),
+		)
+		return
+	}
+
+	if conflicts := v.conflicts(extra, ""); len(conflicts) > 0 {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Extra Spec",
+			fmt.Sprintf(
+				"Attribute %s %s, but sets %s. Use the provider's attributes for these fields instead.",
+				request.Path, v.Description(ctx), strings.Join(conflicts, ", "),
+			),
+		)
+	}
+}
+
+// conflicts returns the paths in extra, an object found at prefix, that set a
+// managed field or replace an object containing one.
+func (v ExtraSpecValidator) conflicts(extra map[string]any, prefix string) []string {
+	var conflicts []string
+
+	for _, key := range slices.Sorted(maps.Keys(extra)) {
+		field := prefix + key
+
+		if slices.Contains(v.ManagedFields, field) {
+			conflicts = append(conflicts, field)
+			continue
+		}
+
+		if !slices.ContainsFunc(v.ManagedFields, func(managed string) bool {
+			return strings.HasPrefix(managed, field+".")
+		}) {
+			continue
+		}
+
+		object, ok := extra[key].(map[string]any)
+		if !ok {
+			conflicts = append(conflicts, field)
+			continue
+		}
+
+		conflicts = append(conflicts, v.conflicts(object, field+".")...)
+	}
+
+	return conflicts
+}
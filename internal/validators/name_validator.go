@@ -18,14 +18,58 @@ package validators
 
 import (
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// maxNameLength is the longest name the Nscale APIs accept.
+const maxNameLength = 63
+
+// nameRegexp matches the names the Nscale APIs accept.
+var nameRegexp = regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
 func NameValidator() validator.String {
 	return stringvalidator.RegexMatches(
-		regexp.MustCompile(`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`),
+		nameRegexp,
 		"must start with a lowercase letter, contain only lowercase letters, digits or hyphens, end with a letter or digit, and be at most 63 characters long",
 	)
 }
+
+// IsValidName reports whether name is accepted by NameValidator.
+func IsValidName(name string) bool {
+	return nameRegexp.MatchString(name)
+}
+
+// NormalizeName derives a name NameValidator accepts from value by lowercasing
+// it, stripping the characters a name may not contain, dropping anything before
+// the first letter and truncating it to 63 characters without a trailing
+// hyphen. It returns false if value contains no letters to start the name with.
+func NormalizeName(value string) (string, bool) {
+	var name strings.Builder
+
+	for _, r := range strings.ToLower(value) {
+		switch {
+		case r >= 'a' && r <= 'z':
+		case (r >= '0' && r <= '9') || r == '-':
+			if name.Len() == 0 {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if name.Len() == maxNameLength {
+			break
+		}
+
+		name.WriteRune(r)
+	}
+
+	if name.Len() == 0 {
+		return "", false
+	}
+
+	return strings.TrimRight(name.String(), "-"), true
+}
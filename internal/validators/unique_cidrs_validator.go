@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Nscale
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UniqueCIDRsValidator checks a list or set of objects whose Attribute holds a
+// CIDR block, such as a network's routes, for the same block given more than
+// once, however it is written. Nested blocks are allowed, as a route to a more
+// specific destination takes precedence over a broader one. Values that are
+// not valid CIDR blocks are left to CIDRValidator.
+type UniqueCIDRsValidator struct {
+	Attribute string
+}
+
+var (
+	_ validator.List = UniqueCIDRsValidator{}
+	_ validator.Set  = UniqueCIDRsValidator{}
+)
+
+func (v UniqueCIDRsValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("the %s of each element must differ from that of every other element", v.Attribute)
+}
+
+func (v UniqueCIDRsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v UniqueCIDRsValidator) ValidateList(
+	ctx context.Context,
+	request validator.ListRequest,
+	response *validator.ListResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(v.validate(ctx, request.ConfigValue.Elements(), func(i int, _ attr.Value) path.Path {
+		return request.Path.AtListIndex(i).AtName(v.Attribute)
+	})...)
+}
+
+func (v UniqueCIDRsValidator) ValidateSet(
+	ctx context.Context,
+	request validator.SetRequest,
+	response *validator.SetResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(v.validate(ctx, request.ConfigValue.Elements(), func(_ int, element attr.Value) path.Path {
+		return request.Path.AtSetValue(element).AtName(v.Attribute)
+	})...)
+}
+
+// validate reports each element whose block is that of an earlier one.
+func (v UniqueCIDRsValidator) validate(
+	ctx context.Context,
+	elements []attr.Value,
+	elementPath func(int, attr.Value) path.Path,
+) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	type block struct {
+		prefix netip.Prefix
+		path   path.Path
+	}
+
+	var blocks []block
+
+	for i, element := range elements {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+
+		value, ok := object.Attributes()[v.Attribute].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(value.ValueString())
+		if err != nil {
+			continue
+		}
+
+		current := block{prefix: prefix.Masked(), path: elementPath(i, element)}
+
+		for _, earlier := range blocks {
+			if earlier.prefix == current.prefix {
+				diagnostics.AddAttributeError(
+					current.path,
+					"Duplicate CIDR Block",
+					fmt.Sprintf(
+						"Attribute %s %s is the same block as %s at %s; %s.",
+						current.path, value.ValueString(), earlier.prefix, earlier.path, v.Description(ctx),
+					),
+				)
+				break
+			}
+		}
+
+		blocks = append(blocks, current)
+	}
+
+	return diagnostics
+}
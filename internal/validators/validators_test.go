@@ -22,6 +22,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -50,6 +52,8 @@ func TestBase64Validator(t *testing.T) {
 		{"valid", types.StringValue(base64.StdEncoding.EncodeToString([]byte("hello world"))), false},
 		{"empty string is valid base64", types.StringValue(""), false},
 		{"invalid characters", types.StringValue("not valid base64!!!"), true},
+		{"unpadded", types.StringValue("aGVsbG8gd29ybGQ"), false},
+		{"wrapped with a trailing newline", types.StringValue("aGVsbG8g\nd29ybGQ=\n"), false},
 		{"null is skipped", types.StringNull(), false},
 		{"unknown is skipped", types.StringUnknown(), false},
 	}
@@ -157,6 +161,39 @@ func TestNameValidator(t *testing.T) {
 	}
 }
 
+func TestNormalizeName(t *testing.T) {
+	testCases := []struct {
+		name   string
+		value  string
+		want   string
+		wantOK bool
+	}{
+		{"already valid", "my-name-01", "my-name-01", true},
+		{"uppercase", "MyName", "myname", true},
+		{"invalid characters stripped", "my_app.prod env", "myappprodenv", true},
+		{"leading digits and hyphens dropped", "42-app", "app", true},
+		{"trailing hyphens trimmed", "app--", "app", true},
+		{"truncated to 63", strings.Repeat("a", 70), strings.Repeat("a", 63), true},
+		{"hyphen at the cut trimmed", strings.Repeat("a", 62) + "-b", strings.Repeat("a", 62), true},
+		{"non-ASCII letters stripped", "café", "caf", true},
+		{"no letters", "1234-_", "", false},
+		{"empty", "", "", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, ok := NormalizeName(testCase.value)
+			if got != testCase.want || ok != testCase.wantOK {
+				t.Fatalf("NormalizeName(%q) = %q, %v, want %q, %v", testCase.value, got, ok, testCase.want, testCase.wantOK)
+			}
+
+			if ok && !IsValidName(got) {
+				t.Fatalf("NormalizeName(%q) = %q, which is not a valid name", testCase.value, got)
+			}
+		})
+	}
+}
+
 func TestNoReservedPrefixValidator(t *testing.T) {
 	const prefix = "nscale-"
 
@@ -190,6 +227,135 @@ func TestNoReservedPrefixValidator(t *testing.T) {
 
 // TestDescriptions exercises the Description / MarkdownDescription methods on
 // the hand-written validators so the human-facing copy stays covered.
+func TestExtraSpecValidator(t *testing.T) {
+	v := ExtraSpecValidator{ManagedFields: []string{"name", "machine.flavorId", "machine.image"}}
+
+	testCases := []struct {
+		name          string
+		value         types.String
+		wantConflicts []string
+	}{
+		{name: "new top-level field", value: types.StringValue(`{"priority": 10}`)},
+		{name: "new field in a managed object", value: types.StringValue(`{"machine": {"disk": {"size": 100}}}`)},
+		{name: "managed field", value: types.StringValue(`{"name": "other"}`), wantConflicts: []string{"name"}},
+		{
+			name:          "nested managed field",
+			value:         types.StringValue(`{"machine": {"image": {"id": "x"}, "flavorId": "y"}}`),
+			wantConflicts: []string{"machine.flavorId", "machine.image"},
+		},
+		{
+			name:          "replaces a managed object",
+			value:         types.StringValue(`{"machine": null}`),
+			wantConflicts: []string{"machine"},
+		},
+		{name: "not an object", value: types.StringValue(`[1, 2]`), wantConflicts: []string{}},
+		{name: "null is skipped", value: types.StringNull()},
+		{name: "unknown is skipped", value: types.StringUnknown()},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := runStringValidator(v, testCase.value)
+
+			wantErr := testCase.wantConflicts != nil
+			if got := response.Diagnostics.HasError(); got != wantErr {
+				t.Fatalf("HasError() = %v, want %v (diags: %v)", got, wantErr, response.Diagnostics)
+			}
+
+			if len(testCase.wantConflicts) > 0 {
+				detail := response.Diagnostics[0].Detail()
+				if !strings.Contains(detail, "sets "+strings.Join(testCase.wantConflicts, ", ")+".") {
+					t.Errorf("detail = %q, want it to name %v", detail, testCase.wantConflicts)
+				}
+			}
+		})
+	}
+}
+
+func TestUniqueCIDRsValidator(t *testing.T) {
+	v := UniqueCIDRsValidator{Attribute: "destination"}
+	routeType := types.ObjectType{AttrTypes: map[string]attr.Type{"destination": types.StringType}}
+
+	routes := func(destinations ...types.String) []attr.Value {
+		elements := make([]attr.Value, 0, len(destinations))
+		for _, destination := range destinations {
+			elements = append(elements, types.ObjectValueMust(
+				routeType.AttrTypes,
+				map[string]attr.Value{"destination": destination},
+			))
+		}
+		return elements
+	}
+
+	testCases := []struct {
+		name     string
+		elements []attr.Value
+		wantPath string
+	}{
+		{
+			name:     "disjoint",
+			elements: routes(types.StringValue("10.0.0.0/24"), types.StringValue("10.0.1.0/24")),
+		},
+		{
+			name:     "contained",
+			elements: routes(types.StringValue("10.0.0.0/16"), types.StringValue("10.0.4.0/24")),
+		},
+		{
+			name:     "default route and a more specific one",
+			elements: routes(types.StringValue("0.0.0.0/0"), types.StringValue("10.0.0.0/8")),
+		},
+		{
+			name:     "same block",
+			elements: routes(types.StringValue("10.0.0.0/24"), types.StringValue("10.0.0.0/24")),
+			wantPath: "test[1].destination",
+		},
+		{
+			name:     "same block written differently",
+			elements: routes(types.StringValue("10.0.0.0/24"), types.StringValue("10.0.0.7/24")),
+			wantPath: "test[1].destination",
+		},
+		{
+			name: "unknown and invalid values are skipped",
+			elements: routes(
+				types.StringValue("10.0.0.0/24"),
+				types.StringUnknown(),
+				types.StringValue("not a cidr"),
+			),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			listResponse := validator.ListResponse{}
+			v.ValidateList(context.Background(), validator.ListRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.ListValueMust(routeType, testCase.elements),
+			}, &listResponse)
+
+			if got := listResponse.Diagnostics.HasError(); got != (testCase.wantPath != "") {
+				t.Fatalf("list HasError() = %v, want %v (diags: %v)", got, testCase.wantPath != "", listResponse.Diagnostics)
+			}
+
+			if testCase.wantPath != "" {
+				diagnostic, ok := listResponse.Diagnostics[0].(diag.DiagnosticWithPath)
+				if !ok || diagnostic.Path().String() != testCase.wantPath {
+					t.Fatalf("list diagnostic = %v, want it at %s", listResponse.Diagnostics[0], testCase.wantPath)
+				}
+			}
+
+			setResponse := validator.SetResponse{}
+			v.ValidateSet(context.Background(), validator.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetValueMust(routeType, testCase.elements),
+			}, &setResponse)
+
+			if got := setResponse.Diagnostics.HasError(); got != (testCase.wantPath != "") {
+				t.Fatalf("set HasError() = %v, want %v (diags: %v)", got, testCase.wantPath != "", setResponse.Diagnostics)
+			}
+		})
+	}
+}
+
 func TestDescriptions(t *testing.T) {
 	ctx := context.Background()
 
@@ -204,6 +370,8 @@ func TestDescriptions(t *testing.T) {
 		{"cidr", CIDRValidator{}},
 		{"ip", IPAddressValidator{}},
 		{"no_reserved_prefix", NoReservedPrefixValidator{Prefix: "nscale-"}},
+		{"extra_spec", ExtraSpecValidator{}},
+		{"unique_cidrs", UniqueCIDRsValidator{Attribute: "cidr"}},
 	}
 
 	for _, describable := range describables {
@@ -35,6 +35,10 @@ func main() {
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/nscale/nscale",
 		Debug:   debug,
+		// Protocol 6 is the only version the provider supports, as declared in
+		// terraform-registry-manifest.json. It is required for nested attributes,
+		// write-only attributes and the other features listed in CLAUDE.md.
+		ProtocolVersion: 6,
 	}
 
 	err := providerserver.Serve(context.Background(), provider.New, opts)
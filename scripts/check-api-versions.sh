@@ -0,0 +1,25 @@
+#!/usr/bin/env bash
+#
+# Regenerates version/api_versions.go and fails (non-zero exit) if the
+# committed copy differs from the result, that is if go.mod moved nscale-sdk-go
+# without the pinned API versions following it. Run by `make api-versions-check`
+# and in CI on every PR.
+#
+# If this fails, run `make api-versions` and commit the updated file.
+
+set -euo pipefail
+
+repo_root="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+
+"$repo_root/scripts/generate-api-versions.sh"
+
+changes="$(git -C "$repo_root" status --porcelain -- version/api_versions.go)"
+if [[ -n "$changes" ]]; then
+	git -C "$repo_root" --no-pager diff -- version/api_versions.go
+	echo >&2
+	echo "error: version/api_versions.go is out of date with nscale-sdk-go in go.mod." >&2
+	echo "       run \`make api-versions\` and commit the result." >&2
+	exit 1
+fi
+
+echo "version/api_versions.go is up to date" >&2
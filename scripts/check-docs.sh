@@ -0,0 +1,25 @@
+#!/usr/bin/env bash
+#
+# Regenerates docs/ from templates/, the examples and the provider schema, and
+# fails (non-zero exit) if the committed docs/ or examples/ differ from the
+# result. Run by `make docs-check` and in CI on every PR.
+#
+# If this fails, run `make generate` and commit the updated docs/.
+
+set -euo pipefail
+
+repo_root="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+
+make -C "$repo_root" generate >&2
+
+changes="$(git -C "$repo_root" status --porcelain -- docs examples)"
+if [[ -n "$changes" ]]; then
+	git -C "$repo_root" --no-pager diff -- docs examples
+	echo "$changes" >&2
+	echo >&2
+	echo "error: docs/ is out of date with templates/, examples/ or the schema." >&2
+	echo "       run \`make generate\` and commit the result." >&2
+	exit 1
+fi
+
+echo "docs/ is up to date" >&2